@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	appconfig "github.com/english-coach/backend/configs"
+	dictrepo "github.com/english-coach/backend/internal/modules/dictionary/infra/persistence/postgres"
+	dictexport "github.com/english-coach/backend/internal/modules/dictionary/usecase/export_words"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+func main() {
+	format := flag.String("format", dictexport.FormatJSONL, "Export format: jsonl (seed file lines), csv (one row per sense) or apkg (Anki deck)")
+	language := flag.String("language", "", "Only export words of this language code")
+	level := flag.String("level", "", "Only export words with a sense at this level code")
+	topic := flag.String("topic", "", "Only export words tagged with this topic code")
+	ids := flag.String("ids", "", "Only export these comma-separated word IDs")
+	idsFile := flag.String("ids-file", "", "Only export the word IDs listed in this file, one per line (e.g. a saved word list)")
+	out := flag.String("out", "", "Output file (default dictionary[-<language>].<format>)")
+	dsn := flag.String("dsn", "", "PostgreSQL DSN (or use env DATABASE_URL / app config)")
+	flag.Parse()
+
+	wordIDs, err := parseWordIDs(*ids, *idsFile)
+	if err != nil {
+		log.Fatalf("word IDs error: %v", err)
+	}
+
+	path := *out
+	if path == "" {
+		path = "dictionary"
+		if *language != "" {
+			path += "-" + *language
+		}
+		path += "." + *format
+	}
+
+	ctx := context.Background()
+
+	pool, err := connectDB(ctx, *dsn)
+	if err != nil {
+		log.Fatalf("database connection error: %v", err)
+	}
+	defer pool.Close()
+
+	appLogger, err := logger.NewLogger("production", "logs")
+	if err != nil {
+		log.Fatalf("logger error: %v", err)
+	}
+	defer appLogger.Sync()
+
+	repo := dictrepo.NewDictionaryRepository(pool)
+	uc := dictexport.NewHandler(
+		repo.WordExportRepository(),
+		repo.LanguageRepository(),
+		repo.LevelRepository(),
+		repo.TopicRepository(),
+		appLogger,
+	)
+
+	f, err := os.Create(path)
+	if err != nil {
+		log.Fatalf("create output file error: %v", err)
+	}
+	w := bufio.NewWriter(f)
+
+	output, err := uc.Execute(ctx, dictexport.ExportWordsInput{
+		Format:   *format,
+		Language: *language,
+		Level:    *level,
+		Topic:    *topic,
+		WordIDs:  wordIDs,
+	}, w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		log.Fatalf("export error: %v", err)
+	}
+
+	fmt.Printf("Exported %d words to %s.\n", output.WordCount, path)
+}
+
+// parseWordIDs reads word IDs from the -ids list and the -ids-file file. It returns nil when
+// neither is given, which exports every word.
+func parseWordIDs(list, path string) ([]int64, error) {
+	if list == "" && path == "" {
+		return nil, nil
+	}
+
+	var fields []string
+	if list != "" {
+		fields = append(fields, strings.Split(list, ",")...)
+	}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read ids file: %w", err)
+		}
+		fields = append(fields, strings.Fields(string(data))...)
+	}
+
+	ids := make([]int64, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(field, 10, 64)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid word ID %q", field)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func connectDB(ctx context.Context, cliDSN string) (*pgxpool.Pool, error) {
+	dsn := cliDSN
+	if dsn == "" {
+		// First try DATABASE_URL
+		dsn = os.Getenv("DATABASE_URL")
+	}
+
+	if dsn == "" {
+		// Fall back to app config
+		cfg, err := appconfig.Load()
+		if err != nil {
+			return nil, fmt.Errorf("load app config: %w", err)
+		}
+
+		dbCfg := cfg.Database
+		dsn = fmt.Sprintf(
+			"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+			dbCfg.Host,
+			dbCfg.Port,
+			dbCfg.User,
+			dbCfg.Password,
+			dbCfg.Database,
+			dbCfg.SSLMode,
+		)
+	}
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("create pgx pool: %w", err)
+	}
+
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("ping database: %w", err)
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+)
+
+// maxFeedbackProblems bounds the problems quoted back to the model when asking for a retry
+const maxFeedbackProblems = 10
+
+// invalidResponseError lists what is wrong with an answer
+type invalidResponseError struct {
+	problems []string
+}
+
+func (e *invalidResponseError) Error() string {
+	if len(e.problems) == 1 {
+		return e.problems[0]
+	}
+	return fmt.Sprintf("%s (and %d more problems)", e.problems[0], len(e.problems)-1)
+}
+
+// entryGenerator asks a provider for dictionary entries and checks the answers
+type entryGenerator struct {
+	prompt   *entryPrompt
+	provider provider
+	// retries is how many more times a lemma is asked for after an invalid answer
+	retries int
+}
+
+// generate returns the entry of lemma as one line of minified JSON and the attempts it took.
+// Invalid answers are sent back with their problems and the entry asked for again; provider
+// errors end the lemma at once.
+func (g *entryGenerator) generate(ctx context.Context, languageCode, lemma string) ([]byte, int, error) {
+	messages := g.prompt.render(languageCode, lemma)
+	for attempt := 1; ; attempt++ {
+		content, err := g.provider.Complete(ctx, completionRequest{
+			Language: languageCode,
+			Lemma:    lemma,
+			Attempt:  attempt,
+			Messages: messages,
+		})
+		if err != nil {
+			return nil, attempt, err
+		}
+
+		line, err := g.check(languageCode, lemma, content)
+		if err == nil {
+			return line, attempt, nil
+		}
+		var invalid *invalidResponseError
+		if !errors.As(err, &invalid) || attempt > g.retries {
+			return nil, attempt, err
+		}
+
+		problems := invalid.problems
+		if len(problems) > maxFeedbackProblems {
+			problems = problems[:maxFeedbackProblems]
+		}
+		messages = append(messages,
+			chatMessage{Role: "assistant", Content: content},
+			chatMessage{Role: "user", Content: "The response is invalid:\n- " + strings.Join(problems, "\n- ") +
+				"\nReturn the corrected entry as minified JSON only."},
+		)
+	}
+}
+
+// check validates an answer against the prompt's schema and the seeder's word format and
+// returns it as a single line. Code fences around the JSON are tolerated.
+func (g *entryGenerator) check(languageCode, lemma, content string) ([]byte, error) {
+	raw := []byte(stripCodeFence(content))
+	if len(raw) == 0 {
+		return nil, &invalidResponseError{problems: []string{"the response is empty"}}
+	}
+
+	var value any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&value); err != nil {
+		return nil, &invalidResponseError{problems: []string{"malformed JSON: " + err.Error()}}
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, &invalidResponseError{problems: []string{"malformed JSON: text after the JSON object"}}
+	}
+
+	problems := g.prompt.schema.validate("", value)
+
+	// The seeder drops fields it does not know, so they are rejected rather than lost
+	var w domain.WordJSON
+	strict := json.NewDecoder(bytes.NewReader(raw))
+	strict.DisallowUnknownFields()
+	if err := strict.Decode(&w); err != nil {
+		problems = append(problems, strings.TrimPrefix(err.Error(), "json: "))
+	} else {
+		if w.Language != languageCode {
+			problems = append(problems, fmt.Sprintf("language: is %q, expected %q", w.Language, languageCode))
+		}
+		if strings.TrimSpace(w.Lemma) != lemma {
+			problems = append(problems, fmt.Sprintf("lemma: is %q, expected %q", w.Lemma, lemma))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, &invalidResponseError{problems: problems}
+	}
+
+	var line bytes.Buffer
+	if err := json.Compact(&line, raw); err != nil {
+		return nil, &invalidResponseError{problems: []string{"malformed JSON: " + err.Error()}}
+	}
+	return line.Bytes(), nil
+}
+
+// stripCodeFence removes surrounding whitespace and a markdown code fence some models wrap
+// JSON answers in
+func stripCodeFence(content string) string {
+	content = strings.TrimSpace(content)
+	if !strings.HasPrefix(content, "```") {
+		return content
+	}
+	content = strings.TrimPrefix(content, "```")
+	if newline := strings.IndexByte(content, '\n'); newline >= 0 {
+		content = content[newline+1:] // language tag such as ```json
+	}
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(content), "```"))
+}
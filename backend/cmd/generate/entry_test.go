@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// requestLog records the requests passed on to another provider
+type requestLog struct {
+	next     provider
+	requests []completionRequest
+}
+
+func (p *requestLog) Complete(ctx context.Context, req completionRequest) (string, error) {
+	p.requests = append(p.requests, req)
+	return p.next.Complete(ctx, req)
+}
+
+func newTestGenerator(t *testing.T) (*entryGenerator, *requestLog) {
+	t.Helper()
+	prompt, err := loadPrompt("../../" + promptPath)
+	if err != nil {
+		t.Fatalf("load prompt: %v", err)
+	}
+	log := &requestLog{next: &replayProvider{dir: "testdata"}}
+	return &entryGenerator{prompt: prompt, provider: log, retries: 2}, log
+}
+
+func TestGenerateFirstTry(t *testing.T) {
+	gen, log := newTestGenerator(t)
+
+	line, attempts, err := gen.generate(context.Background(), "en", "home")
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if attempts != 1 || len(log.requests) != 1 {
+		t.Fatalf("attempts = %d with %d requests, want 1", attempts, len(log.requests))
+	}
+	if strings.Contains(string(line), "\n") {
+		t.Errorf("entry is not a single line: %q", line)
+	}
+	var entry struct {
+		Lemma string `json:"lemma"`
+	}
+	if err := json.Unmarshal(line, &entry); err != nil || entry.Lemma != "home" {
+		t.Errorf("entry lemma = %q (%v), want home", entry.Lemma, err)
+	}
+}
+
+func TestGenerateRetriesInvalidAnswer(t *testing.T) {
+	gen, log := newTestGenerator(t)
+
+	// The first answer is cut off; the second is valid but wrapped in a code fence
+	line, attempts, err := gen.generate(context.Background(), "en", "time")
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	if attempts != 2 || len(log.requests) != 2 {
+		t.Fatalf("attempts = %d with %d requests, want 2", attempts, len(log.requests))
+	}
+	if strings.HasPrefix(string(line), "```") || !json.Valid(line) {
+		t.Errorf("entry is not plain JSON: %.40q", line)
+	}
+
+	retry := log.requests[1]
+	if got, want := len(retry.Messages), len(log.requests[0].Messages)+2; got != want {
+		t.Fatalf("retry has %d messages, want %d", got, want)
+	}
+	feedback := retry.Messages[len(retry.Messages)-1]
+	if feedback.Role != "user" || !strings.Contains(feedback.Content, "malformed JSON") {
+		t.Errorf("retry feedback = %q, want the malformed JSON problem", feedback.Content)
+	}
+}
+
+func TestGenerateMissingRecording(t *testing.T) {
+	gen, _ := newTestGenerator(t)
+
+	_, attempts, err := gen.generate(context.Background(), "en", "day")
+	if err == nil {
+		t.Fatal("generate succeeded without a recorded response")
+	}
+	var invalid *invalidResponseError
+	if errors.As(err, &invalid) {
+		t.Errorf("missing recording reported as an invalid answer: %v", err)
+	}
+	if attempts != 1 || !strings.Contains(err.Error(), "no recorded response") {
+		t.Errorf("attempts = %d, err = %v; want 1 attempt and a missing recording error", attempts, err)
+	}
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+const promptPath = "db/migrations/data/dictionary-entry.prompt.txt"
+
+func main() {
+	language := flag.String("language", "", "Language code of the lemmas (required)")
+	lemmasList := flag.String("lemmas", "", "Comma-separated lemmas to generate (also read from the arguments)")
+	lemmasFile := flag.String("lemmas-file", "", "File listing lemmas to generate, one per line (# starts a comment)")
+	promptFile := flag.String("prompt", promptPath, "Generation prompt with the JSON schema of an entry")
+	providerName := flag.String("provider", "openai", "Where entries come from: openai (an OpenAI-compatible API) or replay (recorded responses)")
+	baseURL := flag.String("base-url", envOr("OPENAI_BASE_URL", "https://api.openai.com/v1"), "Base URL of the OpenAI-compatible API (or use env OPENAI_BASE_URL)")
+	model := flag.String("model", envOr("OPENAI_MODEL", "gpt-4o-mini"), "Model name (or use env OPENAI_MODEL); the API key is read from env OPENAI_API_KEY")
+	temperature := flag.Float64("temperature", 0.2, "Sampling temperature")
+	jsonMode := flag.Bool("json-mode", true, "Ask the API for a JSON object response (turn off for servers without response_format)")
+	timeout := flag.Duration("timeout", 2*time.Minute, "Timeout of one API request")
+	replayDir := flag.String("replay-dir", "", "Directory of recorded responses for -provider replay (en_home.json, en_home.2.json for a retry, ...)")
+	recordDir := flag.String("record", "", "Save every response in this directory so the run can be replayed")
+	retries := flag.Int("retries", 2, "Times a lemma is asked for again after an invalid response")
+	out := flag.String("out", "", "JSONL file the entries are appended to; lemmas it already holds are skipped (default word_<language>.jsonl)")
+	flag.Parse()
+
+	prompt, err := loadPrompt(*promptFile)
+	if err != nil {
+		log.Fatalf("prompt error: %v", err)
+	}
+	if !slices.Contains(prompt.languages(), *language) {
+		log.Fatalf("-language must be one of %s", strings.Join(prompt.languages(), ", "))
+	}
+
+	lemmas, err := readLemmas(*lemmasList, *lemmasFile, flag.Args())
+	if err != nil {
+		log.Fatalf("lemmas error: %v", err)
+	}
+	if len(lemmas) == 0 {
+		log.Fatal("no lemmas given: use -lemmas, -lemmas-file or arguments")
+	}
+
+	var p provider
+	switch *providerName {
+	case "openai":
+		p = &openAIProvider{
+			baseURL:     *baseURL,
+			apiKey:      os.Getenv("OPENAI_API_KEY"),
+			model:       *model,
+			temperature: *temperature,
+			jsonMode:    *jsonMode,
+			client:      &http.Client{Timeout: *timeout},
+		}
+	case "replay":
+		if *replayDir == "" {
+			log.Fatal("-provider replay needs -replay-dir")
+		}
+		p = &replayProvider{dir: *replayDir}
+	default:
+		log.Fatalf("unknown provider %q (use openai or replay)", *providerName)
+	}
+	if *recordDir != "" {
+		p = &recordingProvider{next: p, dir: *recordDir}
+	}
+
+	path := *out
+	if path == "" {
+		path = "word_" + *language + ".jsonl"
+	}
+	done, err := readGeneratedLemmas(path)
+	if err != nil {
+		log.Fatalf("output file error: %v", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		log.Fatalf("open output file error: %v", err)
+	}
+	defer f.Close()
+
+	ctx := context.Background()
+	gen := &entryGenerator{prompt: prompt, provider: p, retries: *retries}
+
+	written, skipped := 0, 0
+	var failed []string
+	for _, lemma := range lemmas {
+		if done[lemma] {
+			skipped++
+			continue
+		}
+
+		line, attempts, err := gen.generate(ctx, *language, lemma)
+		if err != nil {
+			fmt.Printf("  %s: failed after %d attempts: %v\n", lemma, attempts, err)
+			var invalid *invalidResponseError
+			if errors.As(err, &invalid) && len(invalid.problems) > 1 {
+				for _, problem := range invalid.problems {
+					fmt.Printf("      %s\n", problem)
+				}
+			}
+			failed = append(failed, lemma)
+			continue
+		}
+		// Each entry is written as soon as it is ready, so an interrupted run can be resumed
+		if _, err := f.Write(append(line, '\n')); err != nil {
+			log.Fatalf("write output file error: %v", err)
+		}
+		written++
+		if attempts > 1 {
+			fmt.Printf("  %s: ok after %d attempts\n", lemma, attempts)
+		} else {
+			fmt.Printf("  %s: ok\n", lemma)
+		}
+	}
+
+	fmt.Printf("Generated %d entries into %s (%d already there, %d failed).\n", written, path, skipped, len(failed))
+	if written > 0 {
+		fmt.Printf("Check them with: go run ./cmd/migration/data validate %s\n", path)
+	}
+	if len(failed) > 0 {
+		fmt.Printf("Failed lemmas: %s\n", strings.Join(failed, ", "))
+		os.Exit(1)
+	}
+}
+
+// readLemmas collects the lemmas of the -lemmas list, the -lemmas-file file and the arguments,
+// in order and without repeats
+func readLemmas(list, path string, args []string) ([]string, error) {
+	var fields []string
+	if list != "" {
+		fields = append(fields, strings.Split(list, ",")...)
+	}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read lemmas file: %w", err)
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			line, _, _ = strings.Cut(line, "#")
+			fields = append(fields, line)
+		}
+	}
+	fields = append(fields, args...)
+
+	seen := make(map[string]bool, len(fields))
+	lemmas := make([]string, 0, len(fields))
+	for _, field := range fields {
+		lemma := strings.TrimSpace(field)
+		if lemma == "" || seen[lemma] {
+			continue
+		}
+		seen[lemma] = true
+		lemmas = append(lemmas, lemma)
+	}
+	return lemmas, nil
+}
+
+// readGeneratedLemmas returns the lemmas already in an output file, none if it does not exist
+func readGeneratedLemmas(path string) (map[string]bool, error) {
+	lemmas := make(map[string]bool)
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return lemmas, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	const maxLineSize = 1024 * 1024 // 1MB
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var entry struct {
+			Lemma string `json:"lemma"`
+		}
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, lineNumber, err)
+		}
+		lemmas[entry.Lemma] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan %s: %w", path, err)
+	}
+	return lemmas, nil
+}
+
+// envOr returns the value of an environment variable, or fallback when it is unset
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// entryPrompt is the generation prompt (dictionary-entry.prompt.txt) split into its messages.
+// The user message ends with an INPUT section naming the language and lemma, which render
+// replaces; the JSON schema it embeds is what responses are validated against.
+type entryPrompt struct {
+	system string
+	// user is the user message up to its "Language:" input line
+	user   string
+	schema *schemaNode
+}
+
+// loadPrompt reads the prompt file and parses the schema out of it
+func loadPrompt(path string) (*entryPrompt, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read prompt: %w", err)
+	}
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+
+	system, user, ok := strings.Cut(text, "\nUSER:\n")
+	if !ok || !strings.HasPrefix(system, "SYSTEM:\n") {
+		return nil, fmt.Errorf("prompt %s: expected a SYSTEM: section followed by a USER: section", path)
+	}
+	system = strings.TrimSpace(strings.TrimPrefix(system, "SYSTEM:\n"))
+
+	// The input lines are the last part of the prompt and are rendered per lemma
+	input := strings.LastIndex(user, "\nLanguage:")
+	if input < 0 || !strings.Contains(user[input:], "\nLemma:") {
+		return nil, fmt.Errorf("prompt %s: expected Language: and Lemma: input lines at the end", path)
+	}
+	user = user[:input+1]
+
+	schema, err := parsePromptSchema(user)
+	if err != nil {
+		return nil, fmt.Errorf("prompt %s: %w", path, err)
+	}
+	return &entryPrompt{system: system, user: user, schema: schema}, nil
+}
+
+// parsePromptSchema decodes the JSON object that follows the JSON SCHEMA heading
+func parsePromptSchema(user string) (*schemaNode, error) {
+	heading := strings.Index(user, "JSON SCHEMA")
+	if heading < 0 {
+		return nil, fmt.Errorf("no JSON SCHEMA section")
+	}
+	start := strings.Index(user[heading:], "\n{")
+	if start < 0 {
+		return nil, fmt.Errorf("no schema object after the JSON SCHEMA heading")
+	}
+
+	// Decode reads exactly one value, so the rule sections after the schema are left alone
+	var schema schemaNode
+	dec := json.NewDecoder(strings.NewReader(user[heading+start:]))
+	if err := dec.Decode(&schema); err != nil {
+		return nil, fmt.Errorf("decode schema: %w", err)
+	}
+	return &schema, nil
+}
+
+// render returns the messages asking for the entry of lemma in languageCode
+func (p *entryPrompt) render(languageCode, lemma string) []chatMessage {
+	return []chatMessage{
+		{Role: "system", Content: p.system},
+		{Role: "user", Content: fmt.Sprintf("%sLanguage: %s\nLemma: %s\n", p.user, languageCode, lemma)},
+	}
+}
+
+// languages returns the language codes the schema allows
+func (p *entryPrompt) languages() []string {
+	var codes []string
+	if language := p.schema.Properties["language"]; language != nil {
+		for _, v := range language.Enum {
+			if code, ok := v.(string); ok {
+				codes = append(codes, code)
+			}
+		}
+	}
+	return codes
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// chatMessage is one message of a chat completion conversation
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// completionRequest asks a provider for the entry of one lemma. Attempt counts from 1; later
+// attempts carry the rejected responses and the problems found in them after the prompt.
+type completionRequest struct {
+	Language string
+	Lemma    string
+	Attempt  int
+	Messages []chatMessage
+}
+
+// provider returns the raw text a model answered to a request. Errors are failures to get an
+// answer at all; a malformed answer is returned as it is and rejected by the caller.
+type provider interface {
+	Complete(ctx context.Context, req completionRequest) (string, error)
+}
+
+// --------- OpenAI-compatible chat completions ----------
+
+// openAIMaxTries bounds the tries of one request that fails with a rate limit, a server error
+// or a network error
+const openAIMaxTries = 4
+
+// openAIProvider calls the chat completions endpoint of an OpenAI-compatible API
+type openAIProvider struct {
+	baseURL     string
+	apiKey      string
+	model       string
+	temperature float64
+	// jsonMode asks for response_format json_object, which some compatible servers reject
+	jsonMode bool
+	client   *http.Client
+}
+
+type openAIRequest struct {
+	Model          string              `json:"model"`
+	Messages       []chatMessage       `json:"messages"`
+	Temperature    float64             `json:"temperature"`
+	ResponseFormat *openAIResponseType `json:"response_format,omitempty"`
+}
+
+type openAIResponseType struct {
+	Type string `json:"type"`
+}
+
+type openAIResponse struct {
+	Choices []struct {
+		Message      chatMessage `json:"message"`
+		FinishReason string      `json:"finish_reason"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// openAIStatusError is a non-200 answer of the API
+type openAIStatusError struct {
+	status     int
+	message    string
+	retryAfter time.Duration
+}
+
+func (e *openAIStatusError) Error() string {
+	return fmt.Sprintf("chat completion: %d %s: %s", e.status, http.StatusText(e.status), e.message)
+}
+
+func (p *openAIProvider) Complete(ctx context.Context, req completionRequest) (string, error) {
+	body := openAIRequest{Model: p.model, Messages: req.Messages, Temperature: p.temperature}
+	if p.jsonMode {
+		body.ResponseFormat = &openAIResponseType{Type: "json_object"}
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("encode chat completion request: %w", err)
+	}
+
+	for try := 1; ; try++ {
+		content, err := p.post(ctx, payload)
+		if err == nil || try == openAIMaxTries || ctx.Err() != nil {
+			return content, err
+		}
+
+		// Rate limits, server errors and dropped connections are retried with backoff
+		wait := time.Duration(1<<try) * time.Second
+		var statusErr *openAIStatusError
+		if errors.As(err, &statusErr) {
+			if statusErr.status != http.StatusTooManyRequests && statusErr.status < 500 {
+				return "", err
+			}
+			if statusErr.retryAfter > wait {
+				wait = statusErr.retryAfter
+			}
+		}
+		fmt.Fprintf(os.Stderr, "  %s: %v; retrying in %s\n", req.Lemma, err, wait)
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// post sends one chat completion request and returns the content of the first choice
+func (p *openAIProvider) post(ctx context.Context, payload []byte) (string, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(p.baseURL, "/")+"/chat/completions", bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("create chat completion request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("chat completion: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("read chat completion response: %w", err)
+	}
+
+	var out openAIResponse
+	decodeErr := json.Unmarshal(data, &out)
+	if resp.StatusCode != http.StatusOK {
+		statusErr := &openAIStatusError{status: resp.StatusCode, message: strings.TrimSpace(string(data))}
+		if decodeErr == nil && out.Error != nil {
+			statusErr.message = out.Error.Message
+		}
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			statusErr.retryAfter = time.Duration(seconds) * time.Second
+		}
+		return "", statusErr
+	}
+	if decodeErr != nil {
+		return "", fmt.Errorf("decode chat completion response: %w", decodeErr)
+	}
+	if len(out.Choices) == 0 {
+		return "", fmt.Errorf("chat completion: response has no choices")
+	}
+	// A cut-off answer is still returned; it fails validation and is retried like any other
+	return out.Choices[0].Message.Content, nil
+}
+
+// --------- Recorded responses ----------
+
+// replayProvider answers from responses recorded in a directory (see responseFileName), so the
+// pipeline can run offline and reproducibly
+type replayProvider struct {
+	dir string
+}
+
+func (p *replayProvider) Complete(_ context.Context, req completionRequest) (string, error) {
+	path := filepath.Join(p.dir, responseFileName(req.Language, req.Lemma, req.Attempt))
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("no recorded response for %s %q attempt %d (%s)", req.Language, req.Lemma, req.Attempt, path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("read recorded response: %w", err)
+	}
+	return string(data), nil
+}
+
+// recordingProvider saves every answer of another provider where replayProvider finds it
+type recordingProvider struct {
+	next provider
+	dir  string
+}
+
+func (p *recordingProvider) Complete(ctx context.Context, req completionRequest) (string, error) {
+	content, err := p.next.Complete(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(p.dir, 0o755); err != nil {
+		return "", fmt.Errorf("create record directory: %w", err)
+	}
+	path := filepath.Join(p.dir, responseFileName(req.Language, req.Lemma, req.Attempt))
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return "", fmt.Errorf("record response: %w", err)
+	}
+	return content, nil
+}
+
+// responseFileName names the recorded answer of an attempt: en_home.json for the first,
+// en_home.2.json for the second and so on. Characters unsafe in file names become "_".
+func responseFileName(languageCode, lemma string, attempt int) string {
+	name := strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == ':' || unicode.IsSpace(r) || unicode.IsControl(r) {
+			return '_'
+		}
+		return r
+	}, lemma)
+	if attempt > 1 {
+		return fmt.Sprintf("%s_%s.%d.json", languageCode, name, attempt)
+	}
+	return fmt.Sprintf("%s_%s.json", languageCode, name)
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// schemaNode is the subset of JSON Schema the generation prompt uses: type, required,
+// properties, items, enum, minimum, maximum and minItems
+type schemaNode struct {
+	Type       schemaTypes            `json:"type"`
+	Required   []string               `json:"required"`
+	Properties map[string]*schemaNode `json:"properties"`
+	Items      *schemaNode            `json:"items"`
+	Enum       []any                  `json:"enum"`
+	Minimum    *float64               `json:"minimum"`
+	Maximum    *float64               `json:"maximum"`
+	MinItems   *int                   `json:"minItems"`
+}
+
+// schemaTypes is the "type" keyword, either a single type name or a list of them
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*t = schemaTypes{name}
+		return nil
+	}
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return fmt.Errorf("type must be a string or a list of strings")
+	}
+	*t = names
+	return nil
+}
+
+// validate checks a value decoded with json.Decoder.UseNumber and returns one message per
+// problem, prefixed with the path of the offending field
+func (s *schemaNode) validate(path string, v any) []string {
+	var problems []string
+	problemf := func(format string, args ...any) {
+		field := path
+		if field == "" {
+			field = "entry"
+		}
+		problems = append(problems, field+": "+fmt.Sprintf(format, args...))
+	}
+
+	if len(s.Type) > 0 && !slices.ContainsFunc(s.Type, func(name string) bool { return hasSchemaType(v, name) }) {
+		problemf("must be %s, got %s", strings.Join(s.Type, " or "), jsonTypeName(v))
+		return problems
+	}
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(e any) bool { return fmt.Sprint(e) == fmt.Sprint(v) }) {
+		problemf("%v is not one of the allowed values", v)
+	}
+
+	switch v := v.(type) {
+	case json.Number:
+		n, _ := v.Float64()
+		if s.Minimum != nil && n < *s.Minimum {
+			problemf("%s is below the minimum %v", v, *s.Minimum)
+		}
+		if s.Maximum != nil && n > *s.Maximum {
+			problemf("%s is above the maximum %v", v, *s.Maximum)
+		}
+	case []any:
+		if s.MinItems != nil && len(v) < *s.MinItems {
+			problemf("needs at least %d items, got %d", *s.MinItems, len(v))
+		}
+		if s.Items != nil {
+			for i, item := range v {
+				problems = append(problems, s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item)...)
+			}
+		}
+	case map[string]any:
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				problemf("missing required field %q", name)
+			}
+		}
+		// Sorted so retries and logs list problems in a stable order
+		names := make([]string, 0, len(s.Properties))
+		for name := range s.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if value, ok := v[name]; ok {
+				problems = append(problems, s.Properties[name].validate(joinField(path, name), value)...)
+			}
+		}
+	}
+	return problems
+}
+
+// hasSchemaType reports whether v is of the JSON Schema type name
+func hasSchemaType(v any, name string) bool {
+	switch name {
+	case "integer":
+		n, ok := v.(json.Number)
+		if !ok {
+			return false
+		}
+		f, err := n.Float64()
+		return err == nil && f == math.Trunc(f)
+	case "number":
+		_, ok := v.(json.Number)
+		return ok
+	default:
+		return jsonTypeName(v) == name
+	}
+}
+
+// jsonTypeName returns the JSON Schema type name of a decoded value
+func jsonTypeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number, float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+// joinField appends a property name to a field path
+func joinField(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
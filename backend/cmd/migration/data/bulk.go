@@ -0,0 +1,1079 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	dictrepo "github.com/english-coach/backend/internal/modules/dictionary/infra/persistence/postgres"
+)
+
+// --------- Bulk seeding ----------
+
+// bulkChunkSize is how many words a bulk worker stages and merges at a time
+const bulkChunkSize = 1000
+
+// seedFile is a word file and the language of its head words
+type seedFile struct {
+	languageCode string
+	path         string
+	lines        map[int]bool // line numbers to apply, nil for every line
+}
+
+// applies reports whether a line of the file is to be applied
+func (f seedFile) applies(lineNumber int) bool {
+	return f.lines == nil || f.lines[lineNumber]
+}
+
+// bulkSeedWords writes word files like upsertWordsFromJSONL, but stages each chunk of words with
+// COPY and merges it with set-based SQL instead of writing row by row. It runs in three steps:
+//
+//  1. every line is decoded and its codes resolved; failing lines are reported and nothing is written
+//  2. head words and translation/relation targets that do not exist yet are created in one transaction,
+//     so workers never race to create the same word
+//  3. files of different languages are merged in parallel, up to workers at a time, each file in its
+//     own transaction; files of the same language run one after the other
+//
+// The result matches the serial path: words are keyed by (language, lemma), child rows by the same
+// keys as WordUpserter, and a revision is recorded for each word the seed changed.
+func bulkSeedWords(ctx context.Context, db seedDB, files []seedFile, workers int) error {
+	codes, err := loadBulkCodes(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	newWords, total, err := checkBulkFiles(codes, files)
+	if err != nil {
+		return err
+	}
+
+	created, err := createBulkWords(ctx, db, newWords)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("  Checked %d words in %d files, created %d words up front\n", total, len(files), created)
+
+	// A dry run writes through a single transaction, which cannot be shared between workers
+	if _, ok := db.(pgx.Tx); ok || workers < 1 {
+		workers = 1
+	}
+
+	var groups [][]seedFile
+	groupIndex := make(map[string]int)
+	for _, f := range files {
+		i, ok := groupIndex[f.languageCode]
+		if !ok {
+			i = len(groups)
+			groupIndex[f.languageCode] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], f)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	progress := newSeedProgress(total)
+	stopProgress := progress.report(2 * time.Second)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	slots := make(chan struct{}, workers)
+	for _, group := range groups {
+		wg.Add(1)
+		go func(group []seedFile) {
+			defer wg.Done()
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-slots }()
+
+			for _, f := range group {
+				if err := bulkMergeFile(ctx, db, codes, f, progress); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = fmt.Errorf("%s: %w", f.path, err)
+					}
+					mu.Unlock()
+					// Files other workers already committed stay written, like earlier files on the serial path
+					cancel()
+					return
+				}
+			}
+		}(group)
+	}
+	wg.Wait()
+	stopProgress()
+
+	return firstErr
+}
+
+// --------- Codes ----------
+
+// bulkCodes maps the metadata codes word files refer to onto their IDs. They are loaded once, so
+// unknown codes are reported with their line before anything is written.
+type bulkCodes struct {
+	languages map[string]int16
+	pos       map[string]int16
+	topics    map[string]int64
+	levels    map[string]int64
+}
+
+func loadBulkCodes(ctx context.Context, db seedDB) (*bulkCodes, error) {
+	var (
+		codes bulkCodes
+		err   error
+	)
+	if codes.languages, err = loadCodeIDs[int16](ctx, db, `SELECT code, id FROM languages`); err != nil {
+		return nil, fmt.Errorf("load languages: %w", err)
+	}
+	if codes.pos, err = loadCodeIDs[int16](ctx, db, `SELECT code, id FROM parts_of_speech`); err != nil {
+		return nil, fmt.Errorf("load parts of speech: %w", err)
+	}
+	if codes.topics, err = loadCodeIDs[int64](ctx, db, `SELECT code, id FROM topics`); err != nil {
+		return nil, fmt.Errorf("load topics: %w", err)
+	}
+	// Levels are looked up by code alone, like WordUpserter.LevelID
+	if codes.levels, err = loadCodeIDs[int64](ctx, db, `SELECT DISTINCT ON (code) code, id FROM levels ORDER BY code, id`); err != nil {
+		return nil, fmt.Errorf("load levels: %w", err)
+	}
+	return &codes, nil
+}
+
+// loadCodeIDs reads (code, id) rows into a map
+func loadCodeIDs[T int16 | int64](ctx context.Context, db seedDB, q string) (map[string]T, error) {
+	rows, err := db.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make(map[string]T)
+	for rows.Next() {
+		var (
+			code string
+			id   T
+		)
+		if err := rows.Scan(&code, &id); err != nil {
+			return nil, err
+		}
+		ids[code] = id
+	}
+	return ids, rows.Err()
+}
+
+func (c *bulkCodes) language(code string) (int16, error) {
+	id, ok := c.languages[code]
+	if !ok {
+		return 0, fmt.Errorf("language %q: %w", code, domain.ErrLanguageNotFound)
+	}
+	return id, nil
+}
+
+func (c *bulkCodes) partOfSpeech(code string) (int16, error) {
+	id, ok := c.pos[code]
+	if !ok {
+		return 0, fmt.Errorf("part of speech %q: %w", code, domain.ErrPartOfSpeechNotFound)
+	}
+	return id, nil
+}
+
+func (c *bulkCodes) topic(code string) (int64, error) {
+	id, ok := c.topics[code]
+	if !ok {
+		return 0, fmt.Errorf("topic %q: %w", code, domain.ErrTopicNotFound)
+	}
+	return id, nil
+}
+
+// level returns the ID of a level code, or nil when code is empty
+func (c *bulkCodes) level(code *string) (*int64, error) {
+	if code == nil || *code == "" {
+		return nil, nil
+	}
+	id, ok := c.levels[*code]
+	if !ok {
+		return nil, fmt.Errorf("level %q: %w", *code, domain.ErrLevelNotFound)
+	}
+	return &id, nil
+}
+
+// --------- Checking and creating words ----------
+
+// bulkWordKey identifies a word the way the seeder matches words
+type bulkWordKey struct {
+	languageID int16
+	lemma      string
+}
+
+// checkBulkFiles decodes every line and stages it in a scratch chunk to resolve its codes. Failing
+// lines are reported like the serial path does. It returns the words to create up front, head
+// words in file order followed by the translation and relation targets, and the number of words.
+func checkBulkFiles(codes *bulkCodes, files []seedFile) ([][]any, int64, error) {
+	var (
+		newWords [][]any
+		total    int64
+		failed   int64
+	)
+	index := make(map[bulkWordKey]int)
+	isHead := make(map[bulkWordKey]bool)
+	addWord := func(row []any, head bool) {
+		key := bulkWordKey{languageID: row[0].(int16), lemma: row[1].(string)}
+		i, ok := index[key]
+		switch {
+		case !ok:
+			index[key] = len(newWords)
+			newWords = append(newWords, row)
+		case head && !isHead[key]:
+			// A head word is created with its own values rather than those of a target naming it
+			newWords[i] = row
+		default:
+			return
+		}
+		isHead[key] = isHead[key] || head
+	}
+
+	for _, f := range files {
+		languageID, err := codes.language(f.languageCode)
+		if err != nil {
+			return nil, 0, err
+		}
+		chunk := newBulkChunk(codes, f.languageCode, languageID)
+
+		err = scanWordFile(f.path, func(lineNumber int, line []byte) error {
+			if !f.applies(lineNumber) {
+				return nil
+			}
+			var w domain.WordJSON
+			if err := json.Unmarshal(line, &w); err != nil {
+				fmt.Printf("  %s:%d: decode word json: %v\n", f.path, lineNumber, err)
+				failed++
+				return nil
+			}
+
+			chunk.reset()
+			if err := chunk.add(w); err != nil {
+				fmt.Printf("  %s:%d: %s: %v\n", f.path, lineNumber, w.Lemma, err)
+				failed++
+				return nil
+			}
+			addWord(chunk.heads[0], true)
+			for _, row := range chunk.targets {
+				addWord(row, false)
+			}
+			total++
+			return nil
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if failed > 0 {
+		return nil, 0, fmt.Errorf("%d of %d lines failed; nothing was written", failed, failed+total)
+	}
+	if total == 0 {
+		return nil, 0, errors.New("no words found in the word files")
+	}
+
+	// Head words get the lower IDs, as they would when the files are seeded one word at a time
+	ordered := make([][]any, 0, len(newWords))
+	for _, head := range []bool{true, false} {
+		for _, row := range newWords {
+			if isHead[bulkWordKey{languageID: row[0].(int16), lemma: row[1].(string)}] == head {
+				ordered = append(ordered, row)
+			}
+		}
+	}
+	return ordered, total, nil
+}
+
+// scanWordFile calls fn with every non-empty line of a JSONL file
+func scanWordFile(filePath string, fn func(lineNumber int, line []byte) error) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("open jsonl file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	const maxLineSize = 1024 * 1024 // 1MB
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		if err := fn(lineNumber, line); err != nil {
+			return fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("scan jsonl: %w", err)
+	}
+	return nil
+}
+
+// bulkNewWordColumns are the columns of a word row, see newWordRow
+var bulkNewWordColumns = []string{
+	"language_id", "lemma", "lemma_normalized", "search_key", "romanization", "script_code", "frequency_rank", "note",
+}
+
+// createBulkWords creates the words that do not exist yet in one transaction and returns how many
+// were created. Existing words are left as they are; the merge updates head words afterwards.
+func createBulkWords(ctx context.Context, db seedDB, rows [][]any) (int64, error) {
+	const createQ = `
+CREATE TEMP TABLE IF NOT EXISTS bulk_new_words (
+    language_id      SMALLINT,
+    lemma            TEXT,
+    lemma_normalized TEXT,
+    search_key       TEXT,
+    romanization     TEXT,
+    script_code      TEXT,
+    frequency_rank   INTEGER,
+    note             TEXT,
+    ord              SERIAL
+) ON COMMIT DROP
+`
+
+	const insertQ = `
+INSERT INTO words (language_id, lemma, lemma_normalized, search_key, romanization, script_code, frequency_rank, note)
+SELECT n.language_id, n.lemma, n.lemma_normalized, n.search_key, n.romanization, n.script_code, n.frequency_rank, n.note
+FROM bulk_new_words n
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM words w
+    WHERE w.language_id = n.language_id
+      AND w.lemma = n.lemma
+)
+ORDER BY n.ord
+`
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, createQ); err != nil {
+		return 0, fmt.Errorf("create bulk_new_words: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `TRUNCATE bulk_new_words`); err != nil {
+		return 0, fmt.Errorf("truncate bulk_new_words: %w", err)
+	}
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{"bulk_new_words"}, bulkNewWordColumns, pgx.CopyFromRows(rows)); err != nil {
+		return 0, fmt.Errorf("copy bulk_new_words: %w", err)
+	}
+	tag, err := tx.Exec(ctx, insertQ)
+	if err != nil {
+		return 0, fmt.Errorf("insert words: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("commit tx: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// --------- Merging files ----------
+
+// bulkMergeFile merges a checked word file chunk by chunk in one transaction. A lemma repeated
+// within a chunk starts a new chunk, so a later line is written over an earlier one as on the
+// serial path.
+func bulkMergeFile(ctx context.Context, db seedDB, codes *bulkCodes, f seedFile, progress *seedProgress) error {
+	languageID, err := codes.language(f.languageCode)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, bulkStageTablesQ); err != nil {
+		return fmt.Errorf("create stage tables: %w", err)
+	}
+
+	meta := domain.RevisionMeta{
+		Action:  domain.RevisionActionSeed,
+		Source:  domain.RevisionSourceSeeder,
+		Summary: filepath.Base(f.path),
+	}
+	chunk := newBulkChunk(codes, f.languageCode, languageID)
+	var wordCount, revisionCount int64
+	flush := func() error {
+		if len(chunk.words) == 0 {
+			return nil
+		}
+		recorded, err := chunk.merge(ctx, tx, meta)
+		if err != nil {
+			return err
+		}
+		n := int64(len(chunk.words))
+		wordCount += n
+		revisionCount += recorded
+		progress.add(n)
+		chunk.reset()
+		return nil
+	}
+
+	err = scanWordFile(f.path, func(lineNumber int, line []byte) error {
+		if !f.applies(lineNumber) {
+			return nil
+		}
+		var w domain.WordJSON
+		if err := json.Unmarshal(line, &w); err != nil {
+			return fmt.Errorf("decode word json: %w", err)
+		}
+		if chunk.lemmas[w.Lemma] || len(chunk.words) >= bulkChunkSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		if err := chunk.add(w); err != nil {
+			return fmt.Errorf("%s: %w", w.Lemma, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	fmt.Printf("  Processed %d words from %s, %d revisions recorded\n", wordCount, f.path, revisionCount)
+	return nil
+}
+
+// bulkChunk holds the staged rows of a chunk of words, one slice per stage table. seq numbers the
+// words of the chunk; ord numbers every staged row so the last of several equal rows wins and
+// examples and characters can be referred to by their translations and readings.
+type bulkChunk struct {
+	codes        *bulkCodes
+	keys         *dictrepo.WordUpserter // only fills search keys, it does not touch the database
+	languageCode string
+	languageID   int16
+	ord          int
+
+	lemmas map[string]bool
+	// heads and targets are the words rows of the chunk's words and of the words they name
+	heads   [][]any
+	targets [][]any
+
+	words               [][]any
+	topics              [][]any
+	pronunciations      [][]any
+	senses              [][]any
+	translations        [][]any
+	examples            [][]any
+	exampleTranslations [][]any
+	relations           [][]any
+	characters          [][]any
+	readings            [][]any
+}
+
+func newBulkChunk(codes *bulkCodes, languageCode string, languageID int16) *bulkChunk {
+	return &bulkChunk{
+		codes:        codes,
+		keys:         dictrepo.NewWordUpserter(scriptTable),
+		languageCode: languageCode,
+		languageID:   languageID,
+		lemmas:       make(map[string]bool),
+	}
+}
+
+// reset empties the chunk for the next words
+func (c *bulkChunk) reset() {
+	c.ord = 0
+	clear(c.lemmas)
+	c.heads, c.targets = c.heads[:0], c.targets[:0]
+	c.words, c.topics, c.pronunciations = c.words[:0], c.topics[:0], c.pronunciations[:0]
+	c.senses, c.translations = c.senses[:0], c.translations[:0]
+	c.examples, c.exampleTranslations = c.examples[:0], c.exampleTranslations[:0]
+	c.relations, c.characters, c.readings = c.relations[:0], c.characters[:0], c.readings[:0]
+}
+
+// next returns the next row number
+func (c *bulkChunk) next() int {
+	c.ord++
+	return c.ord
+}
+
+// newWordRow builds a row of bulkNewWordColumns
+func newWordRow(languageID int16, lemma string, lemmaNormalized, searchKey, romanization, scriptCode *string, frequencyRank *int, note *string) []any {
+	return []any{languageID, lemma, lemmaNormalized, searchKey, romanization, scriptCode, frequencyRank, note}
+}
+
+// add stages a word with everything it carries. On error the chunk holds part of the word and
+// must be reset.
+func (c *bulkChunk) add(w domain.WordJSON) error {
+	seq := len(c.words)
+	c.lemmas[w.Lemma] = true
+
+	normalized, searchKey := c.keys.FillSearchKeys(c.languageCode, w.Lemma, w.Romanization, w.LemmaNormalized, w.SearchKey)
+	head := newWordRow(c.languageID, w.Lemma, normalized, searchKey, w.Romanization, w.ScriptCode, w.FrequencyRank, w.Note)
+	c.heads = append(c.heads, head)
+	c.words = append(c.words, append([]any{seq}, head...))
+
+	for _, code := range w.Topics {
+		if code == "" {
+			continue
+		}
+		topicID, err := c.codes.topic(code)
+		if err != nil {
+			return err
+		}
+		c.topics = append(c.topics, []any{seq, topicID})
+	}
+
+	for _, p := range w.Pronunciations {
+		c.pronunciations = append(c.pronunciations, []any{seq, p.Dialect, p.IPA, p.Phonetic, p.AudioURL, c.next()})
+	}
+
+	for _, s := range w.Senses {
+		if err := c.addSense(seq, s); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range w.Relations {
+		targetLanguageID, err := c.addTarget(r.TargetWord)
+		if err != nil {
+			return err
+		}
+		c.relations = append(c.relations, []any{seq, targetLanguageID, r.TargetWord.Lemma, r.RelationType, r.Note, c.next()})
+	}
+
+	for _, ch := range w.Characters {
+		if ch.Literal == "" {
+			continue
+		}
+		levelID, err := c.codes.level(ch.Level)
+		if err != nil {
+			return fmt.Errorf("get level_id for character %s: %w", ch.Literal, err)
+		}
+		charOrd := c.next()
+		c.characters = append(c.characters, []any{
+			seq, ch.CharOrder, ch.Literal, ch.Simplified, ch.Traditional, ch.ScriptCode, ch.Strokes, ch.Radical, levelID, charOrd,
+		})
+		for _, r := range ch.Readings {
+			readingLanguageID, err := c.codes.language(r.Language)
+			if err != nil {
+				return err
+			}
+			c.readings = append(c.readings, []any{charOrd, readingLanguageID, r.Reading, r.ReadingType, r.Note, c.next()})
+		}
+	}
+	return nil
+}
+
+// addSense stages a sense with its translations and examples
+func (c *bulkChunk) addSense(seq int, s domain.SenseJSON) error {
+	definitionLanguageID, err := c.codes.language(s.DefinitionLanguage)
+	if err != nil {
+		return err
+	}
+	levelID, err := c.codes.level(s.Level)
+	if err != nil {
+		return err
+	}
+	posID, err := c.codes.partOfSpeech(s.PartOfSpeech)
+	if err != nil {
+		return err
+	}
+	c.senses = append(c.senses, []any{
+		seq, s.Order, posID, s.Definition, definitionLanguageID, s.UsageLabel, levelID, s.Note, c.next(),
+	})
+
+	for _, t := range s.Translations {
+		targetLanguageID, err := c.addTarget(t.TargetWord)
+		if err != nil {
+			return err
+		}
+		c.translations = append(c.translations, []any{seq, s.Order, targetLanguageID, t.TargetWord.Lemma, t.Priority, t.Note, c.next()})
+	}
+
+	for _, ex := range s.Examples {
+		exampleLanguageID, err := c.codes.language(ex.Language)
+		if err != nil {
+			return err
+		}
+		exampleOrd := c.next()
+		c.examples = append(c.examples, []any{seq, s.Order, exampleLanguageID, ex.Content, ex.AudioURL, exampleOrd})
+		for _, tr := range ex.Translations {
+			translationLanguageID, err := c.codes.language(tr.Language)
+			if err != nil {
+				return err
+			}
+			c.exampleTranslations = append(c.exampleTranslations, []any{exampleOrd, translationLanguageID, tr.Content, c.next()})
+		}
+	}
+	return nil
+}
+
+// addTarget records a translation or relation target to create and returns its language ID
+func (c *bulkChunk) addTarget(t domain.RelatedWordJSON) (int16, error) {
+	languageID, err := c.codes.language(t.Language)
+	if err != nil {
+		return 0, err
+	}
+	normalized, searchKey := c.keys.FillSearchKeys(t.Language, t.Lemma, t.Romanization, t.LemmaNormalized, t.SearchKey)
+	c.targets = append(c.targets, newWordRow(languageID, t.Lemma, normalized, searchKey, t.Romanization, t.ScriptCode, t.FrequencyRank, t.Note))
+	return languageID, nil
+}
+
+// bulkStageTablesQ creates the stage tables of a merge transaction. They are kept for the whole
+// transaction (a dry run's, too) and truncated before each chunk.
+const bulkStageTablesQ = `
+CREATE TEMP TABLE IF NOT EXISTS bulk_words (
+    seq INTEGER, language_id SMALLINT, lemma TEXT, lemma_normalized TEXT, search_key TEXT,
+    romanization TEXT, script_code TEXT, frequency_rank INTEGER, note TEXT, word_id BIGINT
+) ON COMMIT DROP;
+CREATE TEMP TABLE IF NOT EXISTS bulk_word_topics (
+    seq INTEGER, topic_id BIGINT
+) ON COMMIT DROP;
+CREATE TEMP TABLE IF NOT EXISTS bulk_pronunciations (
+    seq INTEGER, dialect TEXT, ipa TEXT, phonetic TEXT, audio_url TEXT, ord INTEGER
+) ON COMMIT DROP;
+CREATE TEMP TABLE IF NOT EXISTS bulk_senses (
+    seq INTEGER, sense_order SMALLINT, part_of_speech_id SMALLINT, definition TEXT,
+    definition_language_id SMALLINT, usage_label TEXT, level_id BIGINT, note TEXT, ord INTEGER, sense_id BIGINT
+) ON COMMIT DROP;
+CREATE TEMP TABLE IF NOT EXISTS bulk_sense_translations (
+    seq INTEGER, sense_order SMALLINT, language_id SMALLINT, lemma TEXT, priority SMALLINT, note TEXT, ord INTEGER
+) ON COMMIT DROP;
+CREATE TEMP TABLE IF NOT EXISTS bulk_examples (
+    seq INTEGER, sense_order SMALLINT, language_id SMALLINT, content TEXT, audio_url TEXT, ord INTEGER,
+    sense_id BIGINT, example_id BIGINT
+) ON COMMIT DROP;
+CREATE TEMP TABLE IF NOT EXISTS bulk_example_translations (
+    example_ord INTEGER, language_id SMALLINT, content TEXT, ord INTEGER
+) ON COMMIT DROP;
+CREATE TEMP TABLE IF NOT EXISTS bulk_relations (
+    seq INTEGER, language_id SMALLINT, lemma TEXT, relation_type TEXT, note TEXT, ord INTEGER
+) ON COMMIT DROP;
+CREATE TEMP TABLE IF NOT EXISTS bulk_characters (
+    seq INTEGER, char_order SMALLINT, literal TEXT, simplified TEXT, traditional TEXT, script_code TEXT,
+    strokes SMALLINT, radical TEXT, level_id BIGINT, ord INTEGER, character_id BIGINT
+) ON COMMIT DROP;
+CREATE TEMP TABLE IF NOT EXISTS bulk_character_readings (
+    character_ord INTEGER, language_id SMALLINT, reading TEXT, reading_type TEXT, note TEXT, ord INTEGER
+) ON COMMIT DROP;
+`
+
+const bulkTruncateQ = `
+TRUNCATE bulk_words, bulk_word_topics, bulk_pronunciations, bulk_senses, bulk_sense_translations,
+    bulk_examples, bulk_example_translations, bulk_relations, bulk_characters, bulk_character_readings
+`
+
+// bulkStep is one set-based statement of a chunk merge
+type bulkStep struct {
+	name string
+	run  bool // false when the chunk staged nothing the step reads
+	sql  string
+}
+
+// merge copies the staged rows into the stage tables, merges them into the dictionary and records
+// revisions of the chunk's words. It returns the number of revisions recorded.
+func (c *bulkChunk) merge(ctx context.Context, tx pgx.Tx, meta domain.RevisionMeta) (int64, error) {
+	if _, err := tx.Exec(ctx, bulkTruncateQ); err != nil {
+		return 0, fmt.Errorf("truncate stage tables: %w", err)
+	}
+
+	stages := []struct {
+		table   string
+		columns []string
+		rows    [][]any
+	}{
+		{"bulk_words", append([]string{"seq"}, bulkNewWordColumns...), c.words},
+		{"bulk_word_topics", []string{"seq", "topic_id"}, c.topics},
+		{"bulk_pronunciations", []string{"seq", "dialect", "ipa", "phonetic", "audio_url", "ord"}, c.pronunciations},
+		{"bulk_senses", []string{"seq", "sense_order", "part_of_speech_id", "definition", "definition_language_id", "usage_label", "level_id", "note", "ord"}, c.senses},
+		{"bulk_sense_translations", []string{"seq", "sense_order", "language_id", "lemma", "priority", "note", "ord"}, c.translations},
+		{"bulk_examples", []string{"seq", "sense_order", "language_id", "content", "audio_url", "ord"}, c.examples},
+		{"bulk_example_translations", []string{"example_ord", "language_id", "content", "ord"}, c.exampleTranslations},
+		{"bulk_relations", []string{"seq", "language_id", "lemma", "relation_type", "note", "ord"}, c.relations},
+		{"bulk_characters", []string{"seq", "char_order", "literal", "simplified", "traditional", "script_code", "strokes", "radical", "level_id", "ord"}, c.characters},
+		{"bulk_character_readings", []string{"character_ord", "language_id", "reading", "reading_type", "note", "ord"}, c.readings},
+	}
+	for _, stage := range stages {
+		if len(stage.rows) == 0 {
+			continue
+		}
+		if _, err := tx.CopyFrom(ctx, pgx.Identifier{stage.table}, stage.columns, pgx.CopyFromRows(stage.rows)); err != nil {
+			return 0, fmt.Errorf("copy %s: %w", stage.table, err)
+		}
+	}
+
+	for _, step := range c.steps() {
+		if !step.run {
+			continue
+		}
+		if _, err := tx.Exec(ctx, step.sql); err != nil {
+			return 0, fmt.Errorf("%s: %w", step.name, err)
+		}
+	}
+
+	rows, err := tx.Query(ctx, `SELECT word_id FROM bulk_words ORDER BY seq`)
+	if err != nil {
+		return 0, fmt.Errorf("select staged words: %w", err)
+	}
+	wordIDs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return 0, fmt.Errorf("select staged words: %w", err)
+	}
+	// Revisions for the whole chunk in one pass; words whose latest revision already matches are skipped
+	recorded, err := dictrepo.RecordRevisions(ctx, tx, wordIDs, meta)
+	if err != nil {
+		return 0, fmt.Errorf("record revisions: %w", err)
+	}
+	return recorded, nil
+}
+
+// steps lists the merge statements in order. Each mirrors a WordUpserter write: rows are matched on
+// the same keys, DISTINCT ON keeps the last of several staged rows for one key, and rows that
+// would not change are not rewritten.
+func (c *bulkChunk) steps() []bulkStep {
+	hasSenses := len(c.senses) > 0
+	hasExamples := len(c.examples) > 0
+	hasCharacters := len(c.characters) > 0
+	hasReadings := len(c.readings) > 0
+
+	return []bulkStep{
+		// Head words are normally created up front; this only covers words removed since
+		{"insert words", true, `
+INSERT INTO words (language_id, lemma, lemma_normalized, search_key, romanization, script_code, frequency_rank, note)
+SELECT b.language_id, b.lemma, b.lemma_normalized, b.search_key, b.romanization, b.script_code, b.frequency_rank, b.note
+FROM bulk_words b
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM words w
+    WHERE w.language_id = b.language_id
+      AND w.lemma = b.lemma
+)
+ORDER BY b.seq
+`},
+		{"resolve words", true, `
+UPDATE bulk_words b
+SET word_id = (
+    SELECT min(w.id)
+    FROM words w
+    WHERE w.language_id = b.language_id
+      AND w.lemma = b.lemma
+)
+`},
+		{"update words", true, `
+UPDATE words w
+SET
+    lemma_normalized = b.lemma_normalized,
+    search_key       = b.search_key,
+    romanization     = b.romanization,
+    script_code      = b.script_code,
+    frequency_rank   = b.frequency_rank,
+    note             = b.note,
+    updated_at       = CURRENT_TIMESTAMP
+FROM bulk_words b
+WHERE w.id = b.word_id
+  AND (w.lemma_normalized, w.search_key, w.romanization, w.script_code, w.frequency_rank, w.note)
+      IS DISTINCT FROM (b.lemma_normalized, b.search_key, b.romanization, b.script_code, b.frequency_rank, b.note)
+`},
+		{"insert word_topics", len(c.topics) > 0, `
+INSERT INTO word_topics (word_id, topic_id)
+SELECT DISTINCT b.word_id, t.topic_id
+FROM bulk_word_topics t
+JOIN bulk_words b ON b.seq = t.seq
+ON CONFLICT (word_id, topic_id) DO NOTHING
+`},
+		{"upsert pronunciations", len(c.pronunciations) > 0, `
+INSERT INTO pronunciations (word_id, dialect, ipa, phonetic, audio_url)
+SELECT DISTINCT ON (b.word_id, p.dialect) b.word_id, p.dialect, p.ipa, p.phonetic, p.audio_url
+FROM bulk_pronunciations p
+JOIN bulk_words b ON b.seq = p.seq
+ORDER BY b.word_id, p.dialect, p.ord DESC
+ON CONFLICT (word_id, dialect) DO UPDATE
+SET ipa = EXCLUDED.ipa,
+    phonetic = EXCLUDED.phonetic,
+    audio_url = EXCLUDED.audio_url
+WHERE (pronunciations.ipa, pronunciations.phonetic, pronunciations.audio_url)
+      IS DISTINCT FROM (EXCLUDED.ipa, EXCLUDED.phonetic, EXCLUDED.audio_url)
+`},
+		{"upsert senses", hasSenses, `
+INSERT INTO senses (word_id, sense_order, part_of_speech_id, definition, definition_language_id, usage_label, level_id, note)
+SELECT DISTINCT ON (b.word_id, s.sense_order)
+    b.word_id, s.sense_order, s.part_of_speech_id, s.definition, s.definition_language_id, s.usage_label, s.level_id, s.note
+FROM bulk_senses s
+JOIN bulk_words b ON b.seq = s.seq
+ORDER BY b.word_id, s.sense_order, s.ord DESC
+ON CONFLICT (word_id, sense_order) DO UPDATE
+SET part_of_speech_id = EXCLUDED.part_of_speech_id,
+    definition = EXCLUDED.definition,
+    usage_label = EXCLUDED.usage_label,
+    level_id = EXCLUDED.level_id,
+    note = EXCLUDED.note
+WHERE (senses.part_of_speech_id, senses.definition, senses.usage_label, senses.level_id, senses.note)
+      IS DISTINCT FROM (EXCLUDED.part_of_speech_id, EXCLUDED.definition, EXCLUDED.usage_label, EXCLUDED.level_id, EXCLUDED.note)
+`},
+		{"resolve senses", hasSenses, `
+UPDATE bulk_senses s
+SET sense_id = x.id
+FROM bulk_words b, senses x
+WHERE b.seq = s.seq
+  AND x.word_id = b.word_id
+  AND x.sense_order = s.sense_order
+`},
+		{"upsert sense_translations", len(c.translations) > 0, `
+INSERT INTO sense_translations (source_sense_id, target_word_id, priority, note)
+SELECT DISTINCT ON (s.sense_id, tw.id) s.sense_id, tw.id, t.priority, t.note
+FROM bulk_sense_translations t
+JOIN bulk_senses s ON s.seq = t.seq AND s.sense_order = t.sense_order
+JOIN LATERAL (
+    SELECT min(w.id) AS id
+    FROM words w
+    WHERE w.language_id = t.language_id
+      AND w.lemma = t.lemma
+) tw ON tw.id IS NOT NULL
+ORDER BY s.sense_id, tw.id, t.ord DESC
+ON CONFLICT (source_sense_id, target_word_id) DO UPDATE
+SET priority = EXCLUDED.priority,
+    note = EXCLUDED.note
+WHERE (sense_translations.priority, sense_translations.note) IS DISTINCT FROM (EXCLUDED.priority, EXCLUDED.note)
+`},
+		{"resolve example senses", hasExamples, `
+UPDATE bulk_examples e
+SET sense_id = s.sense_id
+FROM bulk_senses s
+WHERE s.seq = e.seq
+  AND s.sense_order = e.sense_order
+`},
+		{"resolve examples", hasExamples, bulkResolveExamplesQ},
+		{"update examples", hasExamples, `
+UPDATE examples x
+SET audio_url = e.audio_url
+FROM (
+    SELECT DISTINCT ON (example_id) example_id, audio_url
+    FROM bulk_examples
+    WHERE example_id IS NOT NULL
+    ORDER BY example_id, ord DESC
+) e
+WHERE x.id = e.example_id
+  AND x.audio_url IS DISTINCT FROM e.audio_url
+`},
+		{"insert examples", hasExamples, `
+INSERT INTO examples (source_sense_id, language_id, content, audio_url, source)
+SELECT e.sense_id, e.language_id, e.content, e.audio_url, NULL
+FROM (
+    SELECT DISTINCT ON (sense_id, language_id, content) sense_id, language_id, content, audio_url, ord
+    FROM bulk_examples
+    WHERE example_id IS NULL
+    ORDER BY sense_id, language_id, content, ord DESC
+) e
+ORDER BY e.ord
+`},
+		{"resolve inserted examples", hasExamples, bulkResolveExamplesQ},
+		{"upsert example_translations", len(c.exampleTranslations) > 0, `
+INSERT INTO example_translations (example_id, language_id, content)
+SELECT DISTINCT ON (e.example_id, t.language_id) e.example_id, t.language_id, t.content
+FROM bulk_example_translations t
+JOIN bulk_examples e ON e.ord = t.example_ord
+ORDER BY e.example_id, t.language_id, t.ord DESC
+ON CONFLICT (example_id, language_id) DO UPDATE
+SET content = EXCLUDED.content
+WHERE example_translations.content IS DISTINCT FROM EXCLUDED.content
+`},
+		// The seed files may list a word as related to itself, which is skipped
+		{"upsert word_relations", len(c.relations) > 0, `
+INSERT INTO word_relations (from_word_id, to_word_id, relation_type, note)
+SELECT DISTINCT ON (b.word_id, tw.id, r.relation_type) b.word_id, tw.id, r.relation_type, r.note
+FROM bulk_relations r
+JOIN bulk_words b ON b.seq = r.seq
+JOIN LATERAL (
+    SELECT min(w.id) AS id
+    FROM words w
+    WHERE w.language_id = r.language_id
+      AND w.lemma = r.lemma
+) tw ON tw.id IS NOT NULL
+WHERE tw.id <> b.word_id
+ORDER BY b.word_id, tw.id, r.relation_type, r.ord DESC
+ON CONFLICT (from_word_id, to_word_id, relation_type) DO UPDATE
+SET note = EXCLUDED.note
+WHERE word_relations.note IS DISTINCT FROM EXCLUDED.note
+`},
+		// characters and readings have no unique keys, so workers take turns creating them
+		{"lock characters", hasCharacters, `SELECT pg_advisory_xact_lock(hashtext('seed characters'))`},
+		{"insert characters", hasCharacters, `
+INSERT INTO characters (literal, simplified, traditional, script_code, strokes, radical, level_id)
+SELECT c.literal, c.simplified, c.traditional, c.script_code, c.strokes, c.radical, c.level_id
+FROM (
+    SELECT DISTINCT ON (literal, script_code) literal, simplified, traditional, script_code, strokes, radical, level_id, ord
+    FROM bulk_characters b
+    WHERE NOT EXISTS (
+        SELECT 1
+        FROM characters x
+        WHERE x.literal = b.literal
+          AND x.script_code = b.script_code
+    )
+    ORDER BY literal, script_code, ord
+) c
+ORDER BY c.ord
+`},
+		{"resolve characters", hasCharacters, `
+UPDATE bulk_characters c
+SET character_id = (
+    SELECT min(x.id)
+    FROM characters x
+    WHERE x.literal = c.literal
+      AND x.script_code = c.script_code
+)
+`},
+		{"upsert word_characters", hasCharacters, `
+INSERT INTO word_characters (word_id, character_id, char_order)
+SELECT DISTINCT ON (b.word_id, c.char_order) b.word_id, c.character_id, c.char_order
+FROM bulk_characters c
+JOIN bulk_words b ON b.seq = c.seq
+ORDER BY b.word_id, c.char_order, c.ord DESC
+ON CONFLICT (word_id, char_order) DO UPDATE
+SET character_id = EXCLUDED.character_id
+WHERE word_characters.character_id <> EXCLUDED.character_id
+`},
+		{"update character_readings", hasReadings, `
+UPDATE character_readings x
+SET note = r.note
+FROM (` + bulkReadingsQ + `) r
+WHERE x.character_id = r.character_id
+  AND x.language_id = r.language_id
+  AND x.reading = r.reading
+  AND COALESCE(x.reading_type, '') = COALESCE(r.reading_type, '')
+  AND x.note IS DISTINCT FROM r.note
+`},
+		{"insert character_readings", hasReadings, `
+INSERT INTO character_readings (character_id, language_id, reading, reading_type, note)
+SELECT r.character_id, r.language_id, r.reading, r.reading_type, r.note
+FROM (` + bulkReadingsQ + `) r
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM character_readings x
+    WHERE x.character_id = r.character_id
+      AND x.language_id = r.language_id
+      AND x.reading = r.reading
+      AND COALESCE(x.reading_type, '') = COALESCE(r.reading_type, '')
+)
+ORDER BY r.ord
+`},
+	}
+}
+
+// bulkResolveExamplesQ points staged examples at the stored example with the same sense, language
+// and content
+const bulkResolveExamplesQ = `
+UPDATE bulk_examples e
+SET example_id = (
+    SELECT min(x.id)
+    FROM examples x
+    WHERE x.source_sense_id = e.sense_id
+      AND x.language_id = e.language_id
+      AND x.content = e.content
+)
+WHERE e.example_id IS NULL
+`
+
+// bulkReadingsQ selects the last staged reading of each character, language, reading and type
+const bulkReadingsQ = `
+    SELECT DISTINCT ON (c.character_id, r.language_id, r.reading, COALESCE(r.reading_type, ''))
+        c.character_id, r.language_id, r.reading, r.reading_type, r.note, r.ord
+    FROM bulk_character_readings r
+    JOIN bulk_characters c ON c.ord = r.character_ord
+    ORDER BY c.character_id, r.language_id, r.reading, COALESCE(r.reading_type, ''), r.ord DESC
+`
+
+// --------- Progress ----------
+
+// seedProgress counts merged words and periodically prints the count, rate and remaining time
+type seedProgress struct {
+	total int64
+	done  atomic.Int64
+	start time.Time
+}
+
+func newSeedProgress(total int64) *seedProgress {
+	return &seedProgress{total: total, start: time.Now()}
+}
+
+// add counts merged words
+func (p *seedProgress) add(n int64) {
+	p.done.Add(n)
+}
+
+// report prints progress every interval until the returned function is called, which prints the
+// final line
+func (p *seedProgress) report(interval time.Duration) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				fmt.Println(p.line())
+			case <-done:
+				fmt.Println(p.line())
+				return
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// line formats the progress as "  1200/5000 words (24.0%), 600 words/s, ETA 7s"
+func (p *seedProgress) line() string {
+	done := p.done.Load()
+	elapsed := time.Since(p.start)
+
+	percent := 100.0
+	if p.total > 0 {
+		percent = float64(done) / float64(p.total) * 100
+	}
+	rate := 0.0
+	if elapsed > 0 {
+		rate = float64(done) / elapsed.Seconds()
+	}
+
+	eta := "unknown"
+	switch {
+	case done >= p.total:
+		eta = "done in " + elapsed.Round(time.Second).String()
+	case rate > 0:
+		remaining := time.Duration(float64(p.total-done) / rate * float64(time.Second))
+		eta = "ETA " + remaining.Round(time.Second).String()
+	}
+	return fmt.Sprintf("  %d/%d words (%.1f%%), %.0f words/s, %s", done, p.total, percent, rate, eta)
+}
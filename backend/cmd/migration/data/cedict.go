@@ -0,0 +1,259 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/normalize"
+)
+
+// --------- CC-CEDICT ----------
+
+// parseCEDICT reads a CC-CEDICT file and maps every entry to a Chinese word keyed by its simplified
+// form. Each line looks like
+//
+//	學習 学习 [xue2 xi2] /to learn/to study/
+//
+// and becomes a word with tone-marked romanization, a numbered pinyin search_key and zh-CN
+// pronunciation, its characters with both script forms, and one English sense. Lines for the same
+// simplified form (different readings or traditional variants) are merged into one word.
+func parseCEDICT(filePath, defaultPOS string) ([]domain.WordJSON, []importConflict, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open cedict file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	const maxLineSize = 1024 * 1024 // 1MB
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	words := newWordCollector()
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		w, err := parseCEDICTLine(line, defaultPOS)
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+		words.add(w)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("scan cedict: %w", err)
+	}
+
+	return words.words, words.conflicts, nil
+}
+
+// parseCEDICTLine maps one CC-CEDICT entry to a word
+func parseCEDICTLine(line, defaultPOS string) (domain.WordJSON, error) {
+	traditional, rest, ok := strings.Cut(line, " ")
+	if !ok {
+		return domain.WordJSON{}, fmt.Errorf("expected traditional simplified [pinyin] /glosses/")
+	}
+	simplified, rest, ok := strings.Cut(rest, " ")
+	if !ok {
+		return domain.WordJSON{}, fmt.Errorf("expected traditional simplified [pinyin] /glosses/")
+	}
+	open, close := strings.Index(rest, "["), strings.Index(rest, "]")
+	if open != 0 || close < open {
+		return domain.WordJSON{}, fmt.Errorf("missing [pinyin]")
+	}
+	syllables := strings.Fields(rest[open+1 : close])
+	glossPart := strings.TrimSpace(rest[close+1:])
+	if !strings.HasPrefix(glossPart, "/") || !strings.HasSuffix(glossPart, "/") || len(glossPart) < 2 {
+		return domain.WordJSON{}, fmt.Errorf("missing /glosses/")
+	}
+
+	var glosses, classifiers []string
+	for _, gloss := range strings.Split(glossPart[1:len(glossPart)-1], "/") {
+		gloss = strings.TrimSpace(gloss)
+		switch {
+		case gloss == "":
+		case strings.HasPrefix(gloss, "CL:"):
+			classifiers = append(classifiers, gloss)
+		default:
+			glosses = append(glosses, gloss)
+		}
+	}
+	if len(glosses) == 0 {
+		return domain.WordJSON{}, fmt.Errorf("entry %s has no glosses", simplified)
+	}
+
+	romanization := cedictRomanization(syllables)
+	searchKey := cedictSearchKey(syllables)
+	phonetic := strings.Join(syllables, " ")
+	scriptCode := "Hani"
+
+	sense := domain.SenseJSON{
+		Order:              1,
+		PartOfSpeech:       cedictPartOfSpeech(syllables, glosses, defaultPOS),
+		DefinitionLanguage: "en",
+		Definition:         strings.Join(glosses, "; "),
+		Translations:       glossTranslations(glosses),
+	}
+	if len(classifiers) > 0 {
+		note := strings.Join(classifiers, "; ")
+		sense.Note = &note
+	}
+
+	w := domain.WordJSON{
+		Language:     "zh",
+		Lemma:        simplified,
+		Romanization: &romanization,
+		SearchKey:    &searchKey,
+		ScriptCode:   &scriptCode,
+		Pronunciations: []domain.PronunciationJSON{
+			{Dialect: "zh-CN", Phonetic: &phonetic},
+		},
+		Senses:     []domain.SenseJSON{sense},
+		Characters: cedictCharacters(traditional, simplified, syllables),
+	}
+	return w, nil
+}
+
+// cedictRomanization converts numbered CC-CEDICT syllables to tone-marked pinyin written as one
+// word, the way the seed files spell romanization: "xue2 xi2" → "xuéxí". Proper nouns keep their
+// capital ("Bei3 jing1" → "Běijīng"); letters and punctuation stay separate tokens. A syllable
+// starting with a, o or e gets an apostrophe after another syllable, as pinyin spells it:
+// "fang1 an4" → "fāng'àn", not the misleading "fāngàn".
+func cedictRomanization(syllables []string) string {
+	var b strings.Builder
+	prevPinyin := false
+	for i, s := range syllables {
+		isPinyin := isNumberedSyllable(s)
+		if i > 0 && (!isPinyin || !prevPinyin) {
+			b.WriteByte(' ')
+		} else if i > 0 && strings.ContainsAny(strings.ToLower(s[:1]), "aoe") {
+			b.WriteByte('\'')
+		}
+		if isPinyin {
+			marked := normalize.PinyinNumberedToMarked(strings.ReplaceAll(s, "u:", "v"))
+			if r, _ := utf8.DecodeRuneInString(s); unicode.IsUpper(r) {
+				first, n := utf8.DecodeRuneInString(marked)
+				marked = string(unicode.ToUpper(first)) + marked[n:]
+			}
+			b.WriteString(marked)
+		} else {
+			b.WriteString(s)
+		}
+		prevPinyin = isPinyin
+	}
+	return b.String()
+}
+
+// cedictSearchKey builds the compact numbered pinyin search_key: "Lu:4 se4" → "lv4se4"
+func cedictSearchKey(syllables []string) string {
+	key := strings.ToLower(strings.Join(syllables, ""))
+	return normalize.Compact(strings.ReplaceAll(key, "u:", "v"))
+}
+
+// isNumberedSyllable reports whether a CC-CEDICT token is a pinyin syllable with a tone number
+func isNumberedSyllable(s string) bool {
+	if len(s) < 2 || s[len(s)-1] < '1' || s[len(s)-1] > '5' {
+		return false
+	}
+	for _, r := range s[:len(s)-1] {
+		if !unicode.IsLetter(r) && r != ':' {
+			return false
+		}
+	}
+	return true
+}
+
+// cedictPartOfSpeech guesses a part of speech, since CC-CEDICT has none: proper nouns start with
+// a capital, "(idiom)" and "classifier for" glosses are marked, and verbs are glossed "to ...".
+func cedictPartOfSpeech(syllables, glosses []string, defaultPOS string) string {
+	if len(syllables) > 0 && isNumberedSyllable(syllables[0]) {
+		if r, _ := utf8.DecodeRuneInString(syllables[0]); unicode.IsUpper(r) {
+			return "propn"
+		}
+	}
+	verbs := 0
+	for _, gloss := range glosses {
+		switch {
+		case strings.HasPrefix(gloss, "(idiom)"):
+			return "idiom"
+		case strings.HasPrefix(gloss, "classifier for"):
+			return "clf"
+		case strings.HasPrefix(gloss, "to "):
+			verbs++
+		}
+	}
+	if verbs == len(glosses) {
+		return "v"
+	}
+	return defaultPOS
+}
+
+// glossTranslations turns short glosses into English translation words: "to study" → "study",
+// "a book" → "book". Glosses with explanations in parentheses or more than three words are kept
+// in the definition only.
+func glossTranslations(glosses []string) []domain.SenseTranslationJSON {
+	var translations []domain.SenseTranslationJSON
+	seen := make(map[string]bool)
+	for _, gloss := range glosses {
+		if strings.ContainsAny(gloss, "()[]|;:,") {
+			continue
+		}
+		lemma := gloss
+		for _, prefix := range []string{"to ", "a ", "an ", "the "} {
+			lemma = strings.TrimPrefix(lemma, prefix)
+		}
+		lemma = strings.TrimSpace(lemma)
+		if lemma == "" || len(strings.Fields(lemma)) > 3 || seen[lemma] {
+			continue
+		}
+		seen[lemma] = true
+		translations = append(translations, domain.SenseTranslationJSON{
+			Priority: len(translations) + 1,
+			TargetWord: domain.RelatedWordJSON{
+				Language:   "en",
+				Lemma:      lemma,
+				ScriptCode: scriptCodeFor("en"),
+			},
+		})
+	}
+	return translations
+}
+
+// cedictCharacters lists the characters of a word with their traditional forms and readings.
+// Words whose characters and syllables do not line up (letters, punctuation) get none.
+func cedictCharacters(traditional, simplified string, syllables []string) []domain.CharacterJSON {
+	simplifiedRunes, traditionalRunes := []rune(simplified), []rune(traditional)
+	if len(simplifiedRunes) != len(traditionalRunes) || len(simplifiedRunes) != len(syllables) {
+		return nil
+	}
+
+	chars := make([]domain.CharacterJSON, 0, len(simplifiedRunes))
+	readingType := "pinyin"
+	for i, r := range simplifiedRunes {
+		if !unicode.Is(unicode.Han, r) || !isNumberedSyllable(syllables[i]) {
+			return nil
+		}
+		s, t := string(r), string(traditionalRunes[i])
+		chars = append(chars, domain.CharacterJSON{
+			Literal:     s,
+			Simplified:  &s,
+			Traditional: &t,
+			ScriptCode:  "Hani",
+			CharOrder:   i + 1,
+			Readings: []domain.CharacterReadingJSON{{
+				Language:    "zh",
+				Reading:     normalize.PinyinNumberedToMarked(strings.ReplaceAll(strings.ToLower(syllables[i]), "u:", "v")),
+				ReadingType: &readingType,
+			}},
+		})
+	}
+	return chars
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/english-coach/backend/internal/shared/normalize"
+)
+
+func TestParseCEDICTLineRomanization(t *testing.T) {
+	tests := []struct {
+		line         string
+		romanization string
+		searchKey    string
+	}{
+		{"學習 学习 [xue2 xi2] /to learn/", "xuéxí", "xue2xi2"},
+		{"北京 北京 [Bei3 jing1] /Beijing/", "Běijīng", "bei3jing1"},
+		{"方案 方案 [fang1 an4] /plan/", "fāng'àn", "fang1an4"},
+		{"西安 西安 [Xi1 an1] /Xi'an/", "Xī'ān", "xi1an1"},
+		{"平安 平安 [ping2 an1] /safe and sound/", "píng'ān", "ping2an1"},
+	}
+	for _, tt := range tests {
+		w, err := parseCEDICTLine(tt.line, "noun")
+		if err != nil {
+			t.Fatalf("parseCEDICTLine(%q): %v", tt.line, err)
+		}
+		if got := *w.Romanization; got != tt.romanization {
+			t.Errorf("%s romanization = %q, want %q", w.Lemma, got, tt.romanization)
+		}
+		if got := *w.SearchKey; got != tt.searchKey {
+			t.Errorf("%s search_key = %q, want %q", w.Lemma, got, tt.searchKey)
+		}
+		// Editing the entry later derives the key from the romanization; it must not change
+		if _, key := normalize.Keys("zh", w.Lemma, w.Romanization, nil); key != tt.searchKey {
+			t.Errorf("%s key from romanization = %q, want %q", w.Lemma, key, tt.searchKey)
+		}
+	}
+}
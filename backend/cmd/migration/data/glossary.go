@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+)
+
+// --------- Bilingual glossaries ----------
+
+// glossaryColumns are the header names a glossary may use. lemma and translation are required;
+// translation and topics hold ';' separated lists.
+var glossaryColumns = map[string]bool{
+	"lemma":          true,
+	"translation":    true,
+	"romanization":   true,
+	"part_of_speech": true,
+	"definition":     true,
+	"level":          true,
+	"topics":         true,
+	"note":           true,
+}
+
+// parseGlossary reads a bilingual glossary from a CSV file, or a TSV file when the extension is
+// .tsv or .tab. The first row names the columns (see glossaryColumns). Each row becomes a word in
+// sourceLang with one sense defined in targetLang whose translations are the target words.
+// Chinese romanization may be given in numbered pinyin ("xue2 xi2"), which is converted like
+// CC-CEDICT readings. Rows with the same lemma are merged into one word.
+func parseGlossary(filePath, sourceLang, targetLang, defaultPOS string) ([]domain.WordJSON, []importConflict, error) {
+	if sourceLang == "" || targetLang == "" {
+		return nil, nil, errors.New("glossary import needs -glossary-from and -glossary-to")
+	}
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open glossary file: %w", err)
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".tsv", ".tab":
+		r.Comma = '\t'
+		r.LazyQuotes = true
+	}
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("read glossary header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if !glossaryColumns[name] {
+			return nil, nil, fmt.Errorf("unknown glossary column %q", name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["lemma"]; !ok {
+		return nil, nil, errors.New("glossary has no lemma column")
+	}
+	if _, ok := columns["translation"]; !ok {
+		return nil, nil, errors.New("glossary has no translation column")
+	}
+
+	words := newWordCollector()
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("read glossary: %w", err)
+		}
+		line, _ := r.FieldPos(0)
+
+		cell := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		lemma := cell("lemma")
+		if lemma == "" || strings.HasPrefix(lemma, "#") {
+			continue
+		}
+		translations := splitList(cell("translation"))
+		if len(translations) == 0 {
+			return nil, nil, fmt.Errorf("line %d: %s has no translation", line, lemma)
+		}
+
+		sense := domain.SenseJSON{
+			Order:              1,
+			PartOfSpeech:       defaultPOS,
+			DefinitionLanguage: targetLang,
+			Definition:         strings.Join(translations, "; "),
+			Level:              optionalString(cell("level")),
+			Note:               optionalString(cell("note")),
+		}
+		if pos := cell("part_of_speech"); pos != "" {
+			sense.PartOfSpeech = pos
+		}
+		if definition := cell("definition"); definition != "" {
+			sense.Definition = definition
+		}
+		for i, t := range translations {
+			sense.Translations = append(sense.Translations, domain.SenseTranslationJSON{
+				Priority: i + 1,
+				TargetWord: domain.RelatedWordJSON{
+					Language:   targetLang,
+					Lemma:      t,
+					ScriptCode: scriptCodeFor(targetLang),
+				},
+			})
+		}
+
+		w := domain.WordJSON{
+			Language:   sourceLang,
+			Lemma:      lemma,
+			ScriptCode: scriptCodeFor(sourceLang),
+			Topics:     splitList(cell("topics")),
+			Senses:     []domain.SenseJSON{sense},
+		}
+		if romanization := cell("romanization"); romanization != "" {
+			w.Romanization = &romanization
+			if syllables := strings.Fields(romanization); sourceLang == "zh" && isNumberedSyllable(syllables[0]) {
+				marked, searchKey, phonetic := cedictRomanization(syllables), cedictSearchKey(syllables), strings.Join(syllables, " ")
+				w.Romanization, w.SearchKey = &marked, &searchKey
+				w.Pronunciations = []domain.PronunciationJSON{{Dialect: "zh-CN", Phonetic: &phonetic}}
+			}
+		}
+		words.add(w)
+	}
+
+	return words.words, words.conflicts, nil
+}
+
+// optionalString returns nil for an empty cell
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
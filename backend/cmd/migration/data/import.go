@@ -0,0 +1,342 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	dictrepo "github.com/english-coach/backend/internal/modules/dictionary/infra/persistence/postgres"
+	"github.com/english-coach/backend/internal/shared/normalize"
+)
+
+// --------- Dictionary imports ----------
+
+// conflictKeptExisting is the resolution of a conflict where the stored value won
+const conflictKeptExisting = "kept existing"
+
+// importConflict is one line of the conflict report: a field where an imported entry disagreed
+// with the word it was merged into. WordID is 0 when both entries came from the imported file.
+type importConflict struct {
+	Language   string `json:"language"`
+	Lemma      string `json:"lemma"`
+	WordID     int64  `json:"word_id"`
+	Field      string `json:"field"`
+	Existing   string `json:"existing"`
+	Imported   string `json:"imported"`
+	Resolution string `json:"resolution"`
+}
+
+// mergeResult summarizes what merging an imported entry into a word did
+type mergeResult struct {
+	conflicts     []importConflict
+	sensesAdded   int
+	sensesSkipped int
+}
+
+// scriptCodeFor returns the script the seed files record for a language's words
+func scriptCodeFor(languageCode string) *string {
+	code := "Latn"
+	if languageCode == "zh" {
+		code = "Hani"
+	}
+	return &code
+}
+
+// wordCollector groups parsed entries by lemma, merging repeated lemmas within a file
+type wordCollector struct {
+	words     []domain.WordJSON
+	index     map[string]int
+	conflicts []importConflict
+}
+
+func newWordCollector() *wordCollector {
+	return &wordCollector{index: make(map[string]int)}
+}
+
+// add appends an entry or merges it into an earlier one with the same lemma
+func (c *wordCollector) add(w domain.WordJSON) {
+	if i, ok := c.index[w.Lemma]; ok {
+		res := mergeWord(&c.words[i], w)
+		c.conflicts = append(c.conflicts, res.conflicts...)
+		return
+	}
+	c.index[w.Lemma] = len(c.words)
+	c.words = append(c.words, w)
+}
+
+// mergeWord merges an imported entry into base. Missing values are filled in and lists are
+// unioned; where both carry a different value the existing one is kept and a conflict is
+// recorded. Senses with the same definition are merged, new ones are appended. When the imported
+// entry has a different reading (a Chinese polyphone, for instance), appended senses note it.
+func mergeWord(base *domain.WordJSON, incoming domain.WordJSON) mergeResult {
+	var res mergeResult
+	conflict := func(field, existing, imported string) {
+		res.conflicts = append(res.conflicts, importConflict{
+			Language:   base.Language,
+			Lemma:      base.Lemma,
+			Field:      field,
+			Existing:   existing,
+			Imported:   imported,
+			Resolution: conflictKeptExisting,
+		})
+	}
+
+	readingConflict := mergeString(&base.Romanization, incoming.Romanization, "romanization", conflict)
+	mergeString(&base.ScriptCode, incoming.ScriptCode, "script_code", conflict)
+	mergeString(&base.Note, incoming.Note, "note", conflict)
+	if !readingConflict {
+		mergeString(&base.SearchKey, incoming.SearchKey, "search_key", conflict)
+	}
+	if base.FrequencyRank == nil {
+		base.FrequencyRank = incoming.FrequencyRank
+	} else if incoming.FrequencyRank != nil && *base.FrequencyRank != *incoming.FrequencyRank {
+		conflict("frequency_rank", strconv.Itoa(*base.FrequencyRank), strconv.Itoa(*incoming.FrequencyRank))
+	}
+
+	for _, topic := range incoming.Topics {
+		if !slices.Contains(base.Topics, topic) {
+			base.Topics = append(base.Topics, topic)
+		}
+	}
+
+	for _, p := range incoming.Pronunciations {
+		i := slices.IndexFunc(base.Pronunciations, func(e domain.PronunciationJSON) bool { return e.Dialect == p.Dialect })
+		if i < 0 {
+			base.Pronunciations = append(base.Pronunciations, p)
+			continue
+		}
+		existing := &base.Pronunciations[i]
+		if !readingConflict {
+			field := "pronunciations[" + p.Dialect + "]."
+			mergeString(&existing.IPA, p.IPA, field+"ipa", conflict)
+			mergeString(&existing.Phonetic, p.Phonetic, field+"phonetic", conflict)
+		}
+		if existing.AudioURL == nil {
+			existing.AudioURL = p.AudioURL
+		}
+	}
+
+	for _, r := range incoming.Relations {
+		if !slices.ContainsFunc(base.Relations, func(e domain.WordRelationJSON) bool {
+			return e.RelationType == r.RelationType && sameRelatedWord(e.TargetWord, r.TargetWord)
+		}) {
+			base.Relations = append(base.Relations, r)
+		}
+	}
+
+	nextOrder := 0
+	for _, s := range base.Senses {
+		nextOrder = max(nextOrder, s.Order)
+	}
+	for _, s := range incoming.Senses {
+		i := slices.IndexFunc(base.Senses, func(e domain.SenseJSON) bool { return sameSense(e, s) })
+		if i < 0 {
+			nextOrder++
+			s.Order = nextOrder
+			if readingConflict && incoming.Romanization != nil {
+				s.Note = prependNote(*incoming.Romanization, s.Note)
+			}
+			base.Senses = append(base.Senses, s)
+			res.sensesAdded++
+			continue
+		}
+
+		existing := &base.Senses[i]
+		field := "senses[" + strconv.Itoa(existing.Order) + "]."
+		if existing.PartOfSpeech != s.PartOfSpeech {
+			conflict(field+"part_of_speech", existing.PartOfSpeech, s.PartOfSpeech)
+		}
+		mergeString(&existing.Level, s.Level, field+"level", conflict)
+		mergeString(&existing.UsageLabel, s.UsageLabel, field+"usage_label", conflict)
+		mergeString(&existing.Note, s.Note, field+"note", conflict)
+		for _, t := range s.Translations {
+			if !slices.ContainsFunc(existing.Translations, func(e domain.SenseTranslationJSON) bool {
+				return sameRelatedWord(e.TargetWord, t.TargetWord)
+			}) {
+				t.Priority = len(existing.Translations) + 1
+				existing.Translations = append(existing.Translations, t)
+			}
+		}
+		for _, ex := range s.Examples {
+			if !slices.ContainsFunc(existing.Examples, func(e domain.ExampleJSON) bool { return e.Content == ex.Content }) {
+				existing.Examples = append(existing.Examples, ex)
+			}
+		}
+		res.sensesSkipped++
+	}
+
+	if len(base.Characters) == 0 {
+		base.Characters = incoming.Characters
+	}
+	return res
+}
+
+// mergeString fills *dst from src when it is empty. It reports a conflict and returns true when
+// both are set and differ.
+func mergeString(dst **string, src *string, field string, conflict func(field, existing, imported string)) bool {
+	if src == nil || *src == "" {
+		return false
+	}
+	if *dst == nil || **dst == "" {
+		*dst = src
+		return false
+	}
+	if **dst == *src {
+		return false
+	}
+	conflict(field, **dst, *src)
+	return true
+}
+
+// sameSense reports whether two senses give the same definition in the same language
+func sameSense(a, b domain.SenseJSON) bool {
+	return a.DefinitionLanguage == b.DefinitionLanguage && normalize.Fold(a.Definition) == normalize.Fold(b.Definition)
+}
+
+// sameRelatedWord reports whether two translation or relation targets name the same word
+func sameRelatedWord(a, b domain.RelatedWordJSON) bool {
+	return a.Language == b.Language && a.Lemma == b.Lemma
+}
+
+// prependNote puts a reading in front of a sense note
+func prependNote(reading string, note *string) *string {
+	if note == nil || *note == "" {
+		return &reading
+	}
+	merged := reading + "; " + *note
+	return &merged
+}
+
+// importStats counts what an import did
+type importStats struct {
+	created       int
+	merged        int
+	sensesAdded   int
+	sensesSkipped int
+}
+
+// importWords writes parsed entries in one transaction. Entries whose lemma already exists are
+// merged into the stored word (see mergeWord) rather than overwriting it. Conflicts are appended
+// to conflicts with the word they were found in.
+func importWords(ctx context.Context, db seedDB, languageCode, filePath string, words []domain.WordJSON, conflicts *[]importConflict) (importStats, error) {
+	var stats importStats
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return stats, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	upserter := dictrepo.NewWordUpserter(scriptTable)
+	languageID, err := upserter.LanguageID(ctx, tx, languageCode)
+	if err != nil {
+		return stats, err
+	}
+
+	for _, w := range words {
+		w.Language = languageCode
+
+		existingID, err := upserter.FindWordID(ctx, tx, languageID, w.Lemma)
+		if err != nil {
+			return stats, err
+		}
+		if existingID != 0 {
+			existing, err := dictrepo.SnapshotWord(ctx, tx, existingID)
+			if err != nil {
+				return stats, fmt.Errorf("load existing word %s: %w", w.Lemma, err)
+			}
+			res := mergeWord(existing, w)
+			for _, c := range res.conflicts {
+				c.WordID = existingID
+				*conflicts = append(*conflicts, c)
+			}
+			stats.merged++
+			stats.sensesAdded += res.sensesAdded
+			stats.sensesSkipped += res.sensesSkipped
+			w = *existing
+		} else {
+			stats.created++
+			stats.sensesAdded += len(w.Senses)
+		}
+
+		wordID, err := upserter.UpsertWord(ctx, tx, languageCode, w)
+		if err != nil {
+			return stats, fmt.Errorf("upsert word %s: %w", w.Lemma, err)
+		}
+		if _, err := dictrepo.RecordRevision(ctx, tx, wordID, domain.RevisionMeta{
+			Action:  domain.RevisionActionSeed,
+			Source:  domain.RevisionSourceSeeder,
+			Summary: "import " + filepath.Base(filePath),
+		}); err != nil {
+			return stats, fmt.Errorf("record revision of word %s: %w", w.Lemma, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return stats, fmt.Errorf("commit tx: %w", err)
+	}
+	return stats, nil
+}
+
+// runImport imports the entries parsed from a file, writes the conflict report and prints a summary
+func runImport(ctx context.Context, db seedDB, languageCode, filePath, reportPath string, words []domain.WordJSON, conflicts []importConflict) error {
+	if len(words) == 0 {
+		return fmt.Errorf("no entries found in file %s", filePath)
+	}
+
+	stats, err := importWords(ctx, db, languageCode, filePath, words, &conflicts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("  Imported %d words from %s: %d created, %d merged, %d senses added, %d duplicate senses merged\n",
+		len(words), filePath, stats.created, stats.merged, stats.sensesAdded, stats.sensesSkipped)
+
+	if len(conflicts) == 0 {
+		return nil
+	}
+	if reportPath == "" {
+		fmt.Printf("  %d conflicts kept the existing values (pass -conflicts to write a report)\n", len(conflicts))
+		return nil
+	}
+	if err := writeConflictReport(reportPath, conflicts); err != nil {
+		return err
+	}
+	fmt.Printf("  %d conflicts written to %s\n", len(conflicts), reportPath)
+	return nil
+}
+
+// writeConflictReport appends conflicts to a JSONL report, so several imports can share one file
+func writeConflictReport(reportPath string, conflicts []importConflict) error {
+	f, err := os.OpenFile(reportPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("open conflict report: %w", err)
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetEscapeHTML(false)
+	for _, c := range conflicts {
+		if err := enc.Encode(c); err != nil {
+			return fmt.Errorf("write conflict report: %w", err)
+		}
+	}
+	return nil
+}
+
+// splitList splits a ';' separated cell into trimmed, non-empty values
+func splitList(s string) []string {
+	var values []string
+	for _, v := range strings.Split(s, ";") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,456 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	dictrepo "github.com/english-coach/backend/internal/modules/dictionary/infra/persistence/postgres"
+)
+
+// --------- Seed ledger ----------
+
+// discoverWordFiles lists the word files in dir, named like "0002_word_en.jsonl", in name order.
+// languages restricts the files to those languages when it is not empty.
+func discoverWordFiles(dir string, languages []string) ([]seedFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read seed directory: %w", err)
+	}
+
+	var files []seedFile
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		m := seedFileLanguage.FindStringSubmatch(e.Name())
+		if m == nil {
+			continue
+		}
+		if len(languages) > 0 && !slices.Contains(languages, m[1]) {
+			continue
+		}
+		files = append(files, seedFile{languageCode: m[1], path: filepath.Join(dir, e.Name())})
+	}
+	return files, nil
+}
+
+// splitCodes splits a comma-separated list of language codes
+func splitCodes(s string) []string {
+	var codes []string
+	for _, code := range strings.Split(s, ",") {
+		if code = strings.TrimSpace(code); code != "" {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// seedEntry is an entry of a word file: the lines that carry one lemma
+type seedEntry struct {
+	lemma       string
+	hash        string // SHA-256 of the entry's lines
+	lineNumbers []int
+}
+
+// seedFileScan is a word file split into entries
+type seedFileScan struct {
+	checksum  string
+	lineCount int
+	entries   []seedEntry // in the order of their first line
+	// undecodable lines belong to no entry; they are always applied so the seed reports them
+	undecodable []int
+}
+
+// scanSeedEntries reads a word file and hashes each entry. A lemma on several lines is one entry
+// whose hash covers all of them in order.
+func scanSeedEntries(filePath string) (*seedFileScan, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("open jsonl file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	const maxLineSize = 1024 * 1024 // 1MB
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	var (
+		scan    seedFileScan
+		lines   = make(map[string][][]byte)
+		index   = make(map[string]int)
+		fileSum = sha256.New()
+	)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Bytes()
+		fileSum.Write(line)
+		fileSum.Write([]byte{'\n'})
+		if len(line) == 0 {
+			continue
+		}
+		scan.lineCount++
+
+		var head struct {
+			Lemma string `json:"lemma"`
+		}
+		if err := json.Unmarshal(line, &head); err != nil || head.Lemma == "" {
+			scan.undecodable = append(scan.undecodable, lineNumber)
+			continue
+		}
+		i, ok := index[head.Lemma]
+		if !ok {
+			i = len(scan.entries)
+			index[head.Lemma] = i
+			scan.entries = append(scan.entries, seedEntry{lemma: head.Lemma})
+		}
+		scan.entries[i].lineNumbers = append(scan.entries[i].lineNumbers, lineNumber)
+		lines[head.Lemma] = append(lines[head.Lemma], slices.Clone(line))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan jsonl: %w", err)
+	}
+
+	for i := range scan.entries {
+		e := &scan.entries[i]
+		sum := sha256.New()
+		for _, line := range lines[e.lemma] {
+			sum.Write(line)
+			sum.Write([]byte{'\n'})
+		}
+		e.hash = hex.EncodeToString(sum.Sum(nil))
+	}
+	scan.checksum = hex.EncodeToString(fileSum.Sum(nil))
+	return &scan, nil
+}
+
+// seedLedgerLine is an entry the ledger recorded for a file
+type seedLedgerLine struct {
+	hash   string
+	wordID *int64
+}
+
+// seedPlan is what a run does with a word file: the entries to apply and the recorded entries
+// the file no longer has
+type seedPlan struct {
+	file    seedFile // lines holds the lines to apply, nil for all of them
+	scan    *seedFileScan
+	fileID  int64 // 0 when the file was never applied
+	changed bool  // the file differs from the ledger, which is rewritten after the run
+	apply   int   // new or changed entries
+	removed []string
+	ledger  map[string]seedLedgerLine
+}
+
+// planWordFile compares a word file with the ledger. With force every entry is applied.
+func planWordFile(ctx context.Context, db seedDB, f seedFile, force bool) (*seedPlan, error) {
+	scan, err := scanSeedEntries(f.path)
+	if err != nil {
+		return nil, err
+	}
+	plan := &seedPlan{file: f, scan: scan, ledger: make(map[string]seedLedgerLine)}
+
+	var checksum string
+	err = db.QueryRow(ctx, `SELECT id, checksum FROM seed_files WHERE file_name = $1`, filepath.Base(f.path)).Scan(&plan.fileID, &checksum)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("load seed file: %w", err)
+	}
+	if plan.fileID != 0 {
+		rows, err := db.Query(ctx, `SELECT lemma, line_hash, word_id FROM seed_lines WHERE seed_file_id = $1`, plan.fileID)
+		if err != nil {
+			return nil, fmt.Errorf("load seed lines: %w", err)
+		}
+		for rows.Next() {
+			var (
+				lemma string
+				line  seedLedgerLine
+			)
+			if err := rows.Scan(&lemma, &line.hash, &line.wordID); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("scan seed line: %w", err)
+			}
+			plan.ledger[lemma] = line
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("load seed lines: %w", err)
+		}
+	}
+	plan.changed = force || plan.fileID == 0 || checksum != scan.checksum
+
+	inFile := make(map[string]bool, len(scan.entries))
+	if force || plan.fileID == 0 {
+		plan.apply = len(scan.entries)
+	} else {
+		plan.file.lines = make(map[int]bool)
+		for _, e := range scan.entries {
+			if recorded, ok := plan.ledger[e.lemma]; ok && recorded.hash == e.hash {
+				continue
+			}
+			plan.apply++
+			for _, n := range e.lineNumbers {
+				plan.file.lines[n] = true
+			}
+		}
+		for _, n := range scan.undecodable {
+			plan.file.lines[n] = true
+		}
+	}
+	for _, e := range scan.entries {
+		inFile[e.lemma] = true
+	}
+	for lemma := range plan.ledger {
+		if !inFile[lemma] {
+			plan.removed = append(plan.removed, lemma)
+		}
+	}
+	slices.Sort(plan.removed)
+	return plan, nil
+}
+
+// pending reports whether the file has lines to apply
+func (p *seedPlan) pending() bool {
+	return p.file.lines == nil || len(p.file.lines) > 0
+}
+
+// recordSeedLedger stores the file's checksum and the hash and word of each entry. Entries the
+// file no longer has stay recorded until they are pruned.
+func recordSeedLedger(ctx context.Context, db seedDB, plan *seedPlan) error {
+	if !plan.changed {
+		return nil
+	}
+
+	const upsertFileQ = `
+INSERT INTO seed_files (file_name, language_id, checksum, line_count, applied_at)
+SELECT $1, l.id, $3, $4, CURRENT_TIMESTAMP
+FROM languages l
+WHERE l.code = $2
+ON CONFLICT (file_name) DO UPDATE
+SET language_id = EXCLUDED.language_id,
+    checksum = EXCLUDED.checksum,
+    line_count = EXCLUDED.line_count,
+    applied_at = EXCLUDED.applied_at
+RETURNING id, language_id
+`
+
+	const createStageQ = `
+CREATE TEMP TABLE IF NOT EXISTS seed_lines_stage (
+    lemma       TEXT,
+    line_hash   TEXT,
+    line_number INTEGER
+) ON COMMIT DROP
+`
+
+	const upsertLinesQ = `
+INSERT INTO seed_lines (seed_file_id, lemma, line_hash, line_number, word_id)
+SELECT $1, s.lemma, s.line_hash, s.line_number, (
+    SELECT min(w.id)
+    FROM words w
+    WHERE w.language_id = $2
+      AND w.lemma = s.lemma
+)
+FROM seed_lines_stage s
+ON CONFLICT (seed_file_id, lemma) DO UPDATE
+SET line_hash = EXCLUDED.line_hash,
+    line_number = EXCLUDED.line_number,
+    word_id = EXCLUDED.word_id
+WHERE (seed_lines.line_hash, seed_lines.line_number, seed_lines.word_id)
+      IS DISTINCT FROM (EXCLUDED.line_hash, EXCLUDED.line_number, EXCLUDED.word_id)
+`
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		fileID     int64
+		languageID int16
+	)
+	if err := tx.QueryRow(ctx, upsertFileQ, filepath.Base(plan.file.path), plan.file.languageCode, plan.scan.checksum, plan.scan.lineCount).Scan(&fileID, &languageID); err != nil {
+		return fmt.Errorf("upsert seed file: %w", err)
+	}
+	plan.fileID = fileID
+
+	if _, err := tx.Exec(ctx, createStageQ); err != nil {
+		return fmt.Errorf("create seed_lines_stage: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `TRUNCATE seed_lines_stage`); err != nil {
+		return fmt.Errorf("truncate seed_lines_stage: %w", err)
+	}
+	rows := make([][]any, len(plan.scan.entries))
+	for i, e := range plan.scan.entries {
+		rows[i] = []any{e.lemma, e.hash, e.lineNumbers[0]}
+	}
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{"seed_lines_stage"}, []string{"lemma", "line_hash", "line_number"}, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("copy seed_lines_stage: %w", err)
+	}
+	if _, err := tx.Exec(ctx, upsertLinesQ, fileID, languageID); err != nil {
+		return fmt.Errorf("upsert seed lines: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+// pruneSeedEntries removes the words of entries that were removed from the file (see
+// dictrepo.RemoveSeedWord) and forgets them. A word another file still carries is kept. A word
+// used by learning history is left in place with a warning and stays in the ledger, so later
+// runs report it again.
+func pruneSeedEntries(ctx context.Context, db seedDB, plan *seedPlan) error {
+	if len(plan.removed) == 0 {
+		return nil
+	}
+
+	const claimedQ = `
+SELECT EXISTS(
+    SELECT 1
+    FROM seed_lines
+    WHERE word_id = $1
+      AND NOT (seed_file_id = $2 AND lemma = $3)
+)
+`
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	summary := "removed from " + filepath.Base(plan.file.path)
+	var deleted, cleared, kept, skipped int
+	var inUse []string
+	forget := make([]string, 0, len(plan.removed))
+	for _, lemma := range plan.removed {
+		wordID := plan.ledger[lemma].wordID
+		if wordID == nil {
+			skipped++ // the word is gone already
+			forget = append(forget, lemma)
+			continue
+		}
+		var claimed bool
+		if err := tx.QueryRow(ctx, claimedQ, *wordID, plan.fileID, lemma).Scan(&claimed); err != nil {
+			return fmt.Errorf("check seed lines of %s: %w", lemma, err)
+		}
+		if claimed {
+			kept++
+			forget = append(forget, lemma)
+			continue
+		}
+		removal, err := dictrepo.RemoveSeedWord(ctx, tx, *wordID, summary)
+		if err != nil {
+			return fmt.Errorf("remove word %s: %w", lemma, err)
+		}
+		switch removal {
+		case dictrepo.SeedWordDeleted:
+			deleted++
+		case dictrepo.SeedWordCleared:
+			cleared++
+		case dictrepo.SeedWordInUse:
+			inUse = append(inUse, lemma)
+			continue
+		}
+		forget = append(forget, lemma)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM seed_lines WHERE seed_file_id = $1 AND lemma = ANY($2)`, plan.fileID, forget); err != nil {
+		return fmt.Errorf("delete seed lines: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	fmt.Printf("  Pruned %d entries removed from %s: %d words deleted, %d cleared (still linked from other words); %d kept (in another file), %d skipped (word already gone)\n",
+		deleted+cleared, plan.file.path, deleted, cleared, kept, skipped)
+	if len(inUse) > 0 {
+		fmt.Printf("  Warning: %d entries removed from %s are used by learning history and were left in place (%s)\n",
+			len(inUse), plan.file.path, previewLemmas(inUse, 5))
+	}
+	return nil
+}
+
+// seedWordFiles applies the new and changed entries of word files, one at a time or through the
+// bulk path, then records them in the ledger and, with prune, removes entries that are gone.
+func seedWordFiles(ctx context.Context, db seedDB, files []seedFile, force, prune, bulk bool, workers int) error {
+	if len(files) == 0 {
+		return errors.New("no word files found")
+	}
+
+	plans := make([]*seedPlan, 0, len(files))
+	var pending []seedFile
+	for _, f := range files {
+		plan, err := planWordFile(ctx, db, f, force)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.path, err)
+		}
+		plans = append(plans, plan)
+
+		switch {
+		case !plan.pending():
+			fmt.Printf("  %s: unchanged, %d entries\n", f.path, len(plan.scan.entries))
+		case plan.file.lines == nil:
+			fmt.Printf("  %s: applying all %d entries\n", f.path, len(plan.scan.entries))
+		default:
+			fmt.Printf("  %s: applying %d new or changed of %d entries\n", f.path, plan.apply, len(plan.scan.entries))
+		}
+		if plan.pending() {
+			pending = append(pending, plan.file)
+		}
+	}
+
+	if bulk && len(pending) > 0 {
+		if err := bulkSeedWords(ctx, db, pending, workers); err != nil {
+			return err
+		}
+	} else {
+		for _, f := range pending {
+			if err := upsertWordsFromJSONL(ctx, db, f); err != nil {
+				return fmt.Errorf("%s: %w", f.path, err)
+			}
+		}
+	}
+
+	// Every file is recorded before any is pruned, so an entry moved to another file is kept
+	for _, plan := range plans {
+		if err := recordSeedLedger(ctx, db, plan); err != nil {
+			return fmt.Errorf("%s: %w", plan.file.path, err)
+		}
+	}
+	for _, plan := range plans {
+		if len(plan.removed) == 0 {
+			continue
+		}
+		if !prune {
+			fmt.Printf("  %d entries of %s are no longer in the file (%s); pass -prune to delete them\n",
+				len(plan.removed), plan.file.path, previewLemmas(plan.removed, 5))
+			continue
+		}
+		if err := pruneSeedEntries(ctx, db, plan); err != nil {
+			return fmt.Errorf("%s: %w", plan.file.path, err)
+		}
+	}
+	return nil
+}
+
+// previewLemmas lists the first n lemmas
+func previewLemmas(lemmas []string, n int) string {
+	if len(lemmas) <= n {
+		return strings.Join(lemmas, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(lemmas[:n], ", "), len(lemmas)-n)
+}
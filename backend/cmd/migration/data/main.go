@@ -8,11 +8,19 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	appconfig "github.com/english-coach/backend/configs"
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	dictrepo "github.com/english-coach/backend/internal/modules/dictionary/infra/persistence/postgres"
+	"github.com/english-coach/backend/internal/shared/normalize"
 )
 
 // Seed data models for initial JSON (0001_init_data.json)
@@ -46,122 +54,88 @@ type SeedData struct {
 	Levels        []Level        `json:"levels"`
 }
 
-// Word JSONL models (0002/0003/0004_word_*.jsonl)
-// New format: all fields at top level, no entries array
-type WordJSON struct {
-	Language        string              `json:"language"`
-	Lemma           string              `json:"lemma"`
-	LemmaNormalized *string             `json:"lemma_normalized,omitempty"`
-	SearchKey       *string             `json:"search_key,omitempty"`
-	Romanization    *string             `json:"romanization,omitempty"`
-	ScriptCode      *string             `json:"script_code,omitempty"`
-	FrequencyRank   *int                `json:"frequency_rank,omitempty"`
-	Note            *string             `json:"note,omitempty"`
-	Topics          []string            `json:"topics,omitempty"`
-	Pronunciations  []PronunciationJSON `json:"pronunciations,omitempty"`
-	Relations       []WordRelationJSON  `json:"relations,omitempty"`
-	Senses          []SenseJSON         `json:"senses,omitempty"`
-	Characters      []CharacterJSON     `json:"characters,omitempty"` // used mainly for Chinese words
-}
-
-type PronunciationJSON struct {
-	Dialect  string  `json:"dialect"`
-	IPA      *string `json:"ipa,omitempty"`
-	Phonetic *string `json:"phonetic,omitempty"`
-	AudioURL *string `json:"audio_url,omitempty"`
-}
-
-type WordRelationJSON struct {
-	RelationType string          `json:"relation_type"`
-	Note         *string         `json:"note,omitempty"`
-	TargetWord   RelatedWordJSON `json:"target_word"`
-}
-
-type RelatedWordJSON struct {
-	Language        string   `json:"language"`
-	Lemma           string   `json:"lemma"`
-	LemmaNormalized *string  `json:"lemma_normalized,omitempty"`
-	SearchKey       *string  `json:"search_key,omitempty"`
-	PartOfSpeech    string   `json:"part_of_speech"`
-	Romanization    *string  `json:"romanization,omitempty"`
-	ScriptCode      *string  `json:"script_code,omitempty"`
-	FrequencyRank   *int     `json:"frequency_rank,omitempty"`
-	Note            *string  `json:"note,omitempty"`
-	Topics          []string `json:"topics,omitempty"`
-}
-
-type SenseJSON struct {
-	Order              int                    `json:"order"`
-	PartOfSpeech       string                 `json:"part_of_speech"`
-	DefinitionLanguage string                 `json:"definition_language"`
-	Definition         string                 `json:"definition"`
-	UsageLabel         *string                `json:"usage_label,omitempty"`
-	Level              *string                `json:"level,omitempty"`
-	Note               *string                `json:"note,omitempty"`
-	Translations       []SenseTranslationJSON `json:"translations,omitempty"`
-	Examples           []ExampleJSON          `json:"examples,omitempty"`
-}
-
-type SenseTranslationJSON struct {
-	Priority   int             `json:"priority"`
-	Note       *string         `json:"note,omitempty"`
-	TargetWord RelatedWordJSON `json:"target_word"`
-}
-
-type ExampleJSON struct {
-	Language     string                   `json:"language"`
-	Content      string                   `json:"content"`
-	AudioURL     *string                  `json:"audio_url,omitempty"`
-	Translations []ExampleTranslationJSON `json:"translations,omitempty"`
-}
+const (
+	seedDataDir  = "db/migrations/data"
+	initDataPath = "db/migrations/data/0001_init_data.json"
 
-type ExampleTranslationJSON struct {
-	Language string `json:"language"`
-	Content  string `json:"content"`
-}
+	scriptConversionsDataPath = "db/migrations/data/0005_script_conversions.tsv"
+)
 
-type CharacterJSON struct {
-	Literal     string                 `json:"literal"`
-	Simplified  *string                `json:"simplified,omitempty"`
-	Traditional *string                `json:"traditional,omitempty"`
-	ScriptCode  string                 `json:"script_code"`
-	Strokes     *int                   `json:"strokes,omitempty"`
-	Radical     *string                `json:"radical,omitempty"`
-	Level       *string                `json:"level,omitempty"` // level code will be converted to level_id
-	CharOrder   int                    `json:"char_order"`
-	Readings    []CharacterReadingJSON `json:"readings,omitempty"`
-}
+// scriptTable maps traditional Chinese to simplified when computing lemma_normalized,
+// so traditional and simplified spellings of a query reach the same words.
+// It is loaded from script_conversions before any words are upserted.
+var scriptTable *normalize.ScriptTable
 
-type CharacterReadingJSON struct {
-	Language    string  `json:"language"`
-	Reading     string  `json:"reading"`
-	ReadingType *string `json:"reading_type,omitempty"`
-	Note        *string `json:"note,omitempty"`
+// seedDB is where the seed steps write: the pool, or one transaction for a dry run. Steps begin
+// their own transactions, which become savepoints inside a dry run's transaction.
+type seedDB interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 }
 
-const (
-	initDataPath   = "db/migrations/data/0001_init_data.json"
-	wordEnDataPath = "db/migrations/data/0002_word_en.jsonl"
-	wordViDataPath = "db/migrations/data/0003_word_vi.jsonl"
-	wordZhDataPath = "db/migrations/data/0004_word_zh.jsonl"
-)
-
 func main() {
+	// "validate" checks seed files without touching the database
+	if len(os.Args) > 1 && os.Args[1] == "validate" {
+		os.Exit(runValidate(os.Args[2:]))
+	}
+
 	initFlag := flag.Bool("init", false, "Upsert initial dictionary metadata (languages, parts of speech, topics, levels)")
-	wordEnFlag := flag.Bool("word-en", false, "Upsert English words from JSONL")
-	wordViFlag := flag.Bool("word-vi", false, "Upsert Vietnamese words from JSONL")
-	wordZhFlag := flag.Bool("word-zh", false, "Upsert Chinese words from JSONL")
+	wordsFlag := flag.Bool("words", false, "Upsert the word files found in -data-dir (NNNN_word_<language>.jsonl)")
+	dataDir := flag.String("data-dir", seedDataDir, "Directory searched for word files")
+	languagesFlag := flag.String("languages", "", "Comma-separated languages of the word files to upsert (default: all found)")
+	wordEnFlag := flag.Bool("word-en", false, "Upsert English words (same as -words -languages en)")
+	wordViFlag := flag.Bool("word-vi", false, "Upsert Vietnamese words (same as -words -languages vi)")
+	wordZhFlag := flag.Bool("word-zh", false, "Upsert Chinese words (same as -words -languages zh)")
+	force := flag.Bool("force", false, "Apply every line of the word files, not only new or changed entries")
+	prune := flag.Bool("prune", false, "Delete words whose entries were removed from their word file")
+	scriptsPath := flag.String("script-conversions", "", "Upsert simplified/traditional pairs from a TSV file (simplified<TAB>traditional per line)")
+	cedictPath := flag.String("cedict", "", "Import Chinese words from a CC-CEDICT file, merging into existing words")
+	glossaryPath := flag.String("glossary", "", "Import words from a bilingual CSV/TSV glossary, merging into existing words")
+	glossaryFrom := flag.String("glossary-from", "", "Language code of the glossary lemmas")
+	glossaryTo := flag.String("glossary-to", "", "Language code of the glossary translations")
+	defaultPOS := flag.String("default-pos", "n", "Part of speech for imported entries that do not give or imply one")
+	conflictsPath := flag.String("conflicts", "import-conflicts.jsonl", "Append import merge conflicts to this JSONL report (empty to only count them)")
+	dryRun := flag.Bool("dry-run", false, "Run the seed inside one transaction and roll it back")
+	bulk := flag.Bool("bulk", false, "Upsert the word files with COPY and set-based merges instead of one word at a time")
+	workers := flag.Int("workers", 3, "Word files of different languages merged in parallel with -bulk")
 	dsn := flag.String("dsn", "", "PostgreSQL DSN (or use env DATABASE_URL / app config)")
 	flag.Parse()
 
+	importing := *cedictPath != "" || *glossaryPath != ""
+
+	languages := splitCodes(*languagesFlag)
+	if *wordEnFlag {
+		languages = append(languages, "en")
+	}
+	if *wordViFlag {
+		languages = append(languages, "vi")
+	}
+	if *wordZhFlag {
+		languages = append(languages, "zh")
+	}
+	if len(languages) > 0 {
+		*wordsFlag = true
+	}
+
 	// If no action flags provided, run full seed: init + all word files
-	if !*initFlag && !*wordEnFlag && !*wordViFlag && !*wordZhFlag {
+	if !*initFlag && !*wordsFlag && *scriptsPath == "" && !importing {
 		*initFlag = true
-		*wordEnFlag = true
-		*wordViFlag = true
-		*wordZhFlag = true
+		*wordsFlag = true
+		*scriptsPath = scriptConversionsDataPath
 	}
 
+	var wordFiles []seedFile
+	if *wordsFlag {
+		var err error
+		wordFiles, err = discoverWordFiles(*dataDir, languages)
+		if err != nil {
+			log.Fatalf("word files error: %v", err)
+		}
+	}
+	seedingChinese := slices.ContainsFunc(wordFiles, func(f seedFile) bool { return f.languageCode == "zh" })
+
 	ctx := context.Background()
 
 	pool, err := connectDB(ctx, *dsn)
@@ -170,32 +144,80 @@ func main() {
 	}
 	defer pool.Close()
 
+	var db seedDB = pool
+	var dryRunTx pgx.Tx
+	if *dryRun {
+		dryRunTx, err = pool.Begin(ctx)
+		if err != nil {
+			log.Fatalf("begin dry-run tx: %v", err)
+		}
+		defer dryRunTx.Rollback(ctx)
+		db = dryRunTx
+	}
+
 	if *initFlag {
-		if err := runInit(ctx, pool, initDataPath); err != nil {
+		if err := runInit(ctx, db, initDataPath); err != nil {
 			log.Fatalf("init seed error: %v", err)
 		}
 		fmt.Println("Initial metadata seed completed successfully.")
 	}
 
-	if *wordEnFlag {
-		if err := upsertWordsFromJSONL(ctx, pool, "en", wordEnDataPath); err != nil {
-			log.Fatalf("word-en upsert error: %v", err)
+	if *scriptsPath != "" {
+		if err := upsertScriptConversionsFromTSV(ctx, db, *scriptsPath); err != nil {
+			log.Fatalf("script conversions upsert error: %v", err)
 		}
-		fmt.Println("English words upsert completed successfully.")
+		fmt.Println("Script conversions upsert completed successfully.")
 	}
 
-	if *wordViFlag {
-		if err := upsertWordsFromJSONL(ctx, pool, "vi", wordViDataPath); err != nil {
-			log.Fatalf("word-vi upsert error: %v", err)
+	if *wordsFlag || *scriptsPath != "" || importing {
+		scriptTable, err = loadScriptTable(ctx, db)
+		if err != nil {
+			log.Fatalf("load script conversions error: %v", err)
 		}
-		fmt.Println("Vietnamese words upsert completed successfully.")
 	}
 
-	if *wordZhFlag {
-		if err := upsertWordsFromJSONL(ctx, pool, "zh", wordZhDataPath); err != nil {
-			log.Fatalf("word-zh upsert error: %v", err)
+	if *wordsFlag {
+		if err := seedWordFiles(ctx, db, wordFiles, *force, *prune, *bulk, *workers); err != nil {
+			log.Fatalf("word upsert error: %v", err)
 		}
-		fmt.Println("Chinese words upsert completed successfully.")
+		fmt.Println("Words upsert completed successfully.")
+	}
+
+	if *cedictPath != "" {
+		words, conflicts, err := parseCEDICT(*cedictPath, *defaultPOS)
+		if err != nil {
+			log.Fatalf("cedict parse error: %v", err)
+		}
+		if err := runImport(ctx, db, "zh", *cedictPath, *conflictsPath, words, conflicts); err != nil {
+			log.Fatalf("cedict import error: %v", err)
+		}
+		fmt.Println("CC-CEDICT import completed successfully.")
+	}
+
+	if *glossaryPath != "" {
+		words, conflicts, err := parseGlossary(*glossaryPath, *glossaryFrom, *glossaryTo, *defaultPOS)
+		if err != nil {
+			log.Fatalf("glossary parse error: %v", err)
+		}
+		if err := runImport(ctx, db, *glossaryFrom, *glossaryPath, *conflictsPath, words, conflicts); err != nil {
+			log.Fatalf("glossary import error: %v", err)
+		}
+		fmt.Println("Glossary import completed successfully.")
+	}
+
+	// New characters or imported pairs can change how existing Chinese lemmas normalize
+	if seedingChinese || *scriptsPath != "" || *cedictPath != "" || (*glossaryPath != "" && *glossaryFrom == "zh") {
+		if err := syncScriptConversions(ctx, db); err != nil {
+			log.Fatalf("script conversions sync error: %v", err)
+		}
+		fmt.Println("Script conversions sync completed successfully.")
+	}
+
+	if *dryRun {
+		if err := dryRunTx.Rollback(ctx); err != nil {
+			log.Fatalf("rollback dry-run tx: %v", err)
+		}
+		fmt.Println("Dry run: all changes rolled back.")
 	}
 }
 
@@ -238,7 +260,7 @@ func connectDB(ctx context.Context, cliDSN string) (*pgxpool.Pool, error) {
 	return pool, nil
 }
 
-func runInit(ctx context.Context, pool *pgxpool.Pool, filePath string) error {
+func runInit(ctx context.Context, db seedDB, filePath string) error {
 	f, err := os.Open(filePath)
 	if err != nil {
 		return fmt.Errorf("open json file: %w", err)
@@ -250,7 +272,7 @@ func runInit(ctx context.Context, pool *pgxpool.Pool, filePath string) error {
 		return fmt.Errorf("decode json: %w", err)
 	}
 
-	tx, err := pool.Begin(ctx)
+	tx, err := db.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("begin tx: %w", err)
 	}
@@ -354,8 +376,11 @@ SET name = EXCLUDED.name,
 	return nil
 }
 
-// upsertWordsFromJSONL reads a JSONL file and upserts words for a given language.
-func upsertWordsFromJSONL(ctx context.Context, pool *pgxpool.Pool, languageCode, filePath string) error {
+// upsertWordsFromJSONL reads a JSONL file and upserts the words of its lines to apply.
+// Each word is written under a savepoint, so a failing line is reported and the rest of the file
+// is still checked; the file is only committed when every line succeeded.
+func upsertWordsFromJSONL(ctx context.Context, db seedDB, file seedFile) error {
+	languageCode, filePath := file.languageCode, file.path
 	f, err := os.Open(filePath)
 	if err != nil {
 		return fmt.Errorf("open jsonl file: %w", err)
@@ -369,69 +394,50 @@ func upsertWordsFromJSONL(ctx context.Context, pool *pgxpool.Pool, languageCode,
 	buf := make([]byte, 0, 64*1024)
 	scanner.Buffer(buf, maxLineSize)
 
-	// Caches to reduce round-trips
-	langID, err := getLanguageID(ctx, pool, languageCode)
-	if err != nil {
-		return err
-	}
-
-	languageCache := map[string]int16{
-		languageCode: langID,
-	}
-	posCache := make(map[string]*int16)
-	topicCache := make(map[string]int64)
-	levelCache := make(map[string]*int64)
-	wordCache := make(map[string]int64)      // key: lang|lemma|pos
-	characterCache := make(map[string]int64) // key: literal|script
-
-	tx, err := pool.Begin(ctx)
+	tx, err := db.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("begin tx: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
+	// The upserter caches code and word lookups to reduce round-trips
+	upserter := dictrepo.NewWordUpserter(scriptTable)
+
 	lineNumber := 0
 	wordCount := 0
+	failedCount := 0
 	for scanner.Scan() {
 		lineNumber++
 		line := scanner.Bytes()
-		if len(line) == 0 {
+		if len(line) == 0 || !file.applies(lineNumber) {
 			continue
 		}
 
-		var w WordJSON
+		var w domain.WordJSON
 		if err := json.Unmarshal(line, &w); err != nil {
-			return fmt.Errorf("decode word json (line %d): %w", lineNumber, err)
+			fmt.Printf("  %s:%d: decode word json: %v\n", filePath, lineNumber, err)
+			failedCount++
+			continue
 		}
 
-		// Upsert the word (single word per line in new format)
-		wordID, err := upsertSingleWord(ctx, tx, langID, w.Lemma, w)
-		if err != nil {
-			return fmt.Errorf("upsert word %s at line %d: %w", w.Lemma, lineNumber, err)
+		if err := upsertWordLine(ctx, tx, upserter, languageCode, filePath, w); err != nil {
+			fmt.Printf("  %s:%d: %s: %v\n", filePath, lineNumber, w.Lemma, err)
+			failedCount++
+			// Words cached by the upserter may have been rolled back with the line
+			upserter = dictrepo.NewWordUpserter(scriptTable)
+			continue
 		}
 		wordCount++
-
-		if err := upsertWordDetails(
-			ctx,
-			pool,
-			tx,
-			wordID,
-			w,
-			languageCache,
-			posCache,
-			topicCache,
-			levelCache,
-			wordCache,
-			characterCache,
-		); err != nil {
-			return fmt.Errorf("upsert word details %s at line %d: %w", w.Lemma, lineNumber, err)
-		}
 	}
 
 	if err := scanner.Err(); err != nil {
 		return fmt.Errorf("scan jsonl: %w", err)
 	}
 
+	if failedCount > 0 {
+		return fmt.Errorf("%d of %d lines in %s failed; nothing was written", failedCount, failedCount+wordCount, filePath)
+	}
+
 	if wordCount == 0 {
 		return fmt.Errorf("no words found in file %s", filePath)
 	}
@@ -444,770 +450,229 @@ func upsertWordsFromJSONL(ctx context.Context, pool *pgxpool.Pool, languageCode,
 	return nil
 }
 
-func getLanguageID(ctx context.Context, pool *pgxpool.Pool, code string) (int16, error) {
-	const q = `SELECT id FROM languages WHERE code = $1`
-	var id int16
-	if err := pool.QueryRow(ctx, q, code).Scan(&id); err != nil {
-		return 0, fmt.Errorf("get language id for %s: %w", code, err)
-	}
-	return id, nil
-}
-
-func getPartOfSpeechID(ctx context.Context, tx pgx.Tx, cache map[string]*int16, code string) (*int16, error) {
-	if code == "" {
-		return nil, nil
-	}
-
-	if v, ok := cache[code]; ok {
-		return v, nil
-	}
-
-	const q = `SELECT id FROM parts_of_speech WHERE code = $1`
-	var id int16
-	err := tx.QueryRow(ctx, q, code).Scan(&id)
+// upsertWordLine writes one word under a savepoint and records its revision
+func upsertWordLine(ctx context.Context, tx pgx.Tx, upserter *dictrepo.WordUpserter, languageCode, filePath string, w domain.WordJSON) error {
+	savepoint, err := tx.Begin(ctx)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			cache[code] = nil
-			return nil, nil
-		}
-		return nil, err
+		return fmt.Errorf("begin savepoint: %w", err)
 	}
-	cache[code] = &id
-	return &id, nil
-}
+	defer savepoint.Rollback(ctx)
 
-// Helper: get language id using a small in-memory cache.
-func getLanguageIDWithCache(ctx context.Context, pool *pgxpool.Pool, cache map[string]int16, code string) (int16, error) {
-	if id, ok := cache[code]; ok {
-		return id, nil
-	}
-	id, err := getLanguageID(ctx, pool, code)
+	// Upsert the word (single word per line in new format)
+	wordID, err := upserter.UpsertWord(ctx, savepoint, languageCode, w)
 	if err != nil {
-		return 0, err
-	}
-	cache[code] = id
-	return id, nil
-}
-
-// Helper: get topic id by code.
-func getTopicID(ctx context.Context, pool *pgxpool.Pool, cache map[string]int64, code string) (int64, error) {
-	if id, ok := cache[code]; ok {
-		return id, nil
+		return fmt.Errorf("upsert word: %w", err)
 	}
-	const q = `SELECT id FROM topics WHERE code = $1`
-	var id int64
-	if err := pool.QueryRow(ctx, q, code).Scan(&id); err != nil {
-		return 0, fmt.Errorf("get topic id for %s: %w", code, err)
+	// Record a revision when the seed changed the entry, so editors can see and undo it
+	if _, err := dictrepo.RecordRevision(ctx, savepoint, wordID, domain.RevisionMeta{
+		Action:  domain.RevisionActionSeed,
+		Source:  domain.RevisionSourceSeeder,
+		Summary: filepath.Base(filePath),
+	}); err != nil {
+		return fmt.Errorf("record revision: %w", err)
 	}
-	cache[code] = id
-	return id, nil
-}
 
-// Helper: get level id by code (may be nullable).
-func getLevelID(ctx context.Context, pool *pgxpool.Pool, cache map[string]*int64, code *string) (*int64, error) {
-	if code == nil || *code == "" {
-		return nil, nil
-	}
-	if id, ok := cache[*code]; ok {
-		return id, nil
-	}
-	const q = `SELECT id FROM levels WHERE code = $1`
-	var id int64
-	if err := pool.QueryRow(ctx, q, *code).Scan(&id); err != nil {
-		return nil, fmt.Errorf("get level id for %s: %w", *code, err)
-	}
-	cache[*code] = &id
-	return &id, nil
+	return savepoint.Commit(ctx)
 }
 
-// Cache key for words (for related/translation targets).
-func wordCacheKey(lang, lemma string) string {
-	return fmt.Sprintf("%s|%s", lang, lemma)
-}
+// --------- Script conversions ----------
 
-func upsertSingleWord(ctx context.Context, tx pgx.Tx, languageID int16, lemma string, w WordJSON) (int64, error) {
-	const selectQ = `
-SELECT id
-FROM words
-WHERE language_id = $1
-  AND lemma = $2
-`
-
-	const insertQ = `
-INSERT INTO words (
-    language_id,
-    lemma,
-    lemma_normalized,
-    search_key,
-    romanization,
-    script_code,
-    frequency_rank,
-    note
-)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING id
-`
-
-	const updateQ = `
-UPDATE words
-SET
-    lemma_normalized = $2,
-    search_key       = $3,
-    romanization     = $4,
-    script_code      = $5,
-    frequency_rank   = $6,
-    note             = $7,
-    updated_at       = CURRENT_TIMESTAMP
-WHERE id = $1
-`
-
-	var wordID int64
-	err := tx.QueryRow(ctx, selectQ, languageID, lemma).Scan(&wordID)
+// upsertScriptConversionsFromTSV imports simplified/traditional pairs from a TSV file.
+// Imported pairs are stored with source 'file' and take precedence over pairs derived from characters.
+func upsertScriptConversionsFromTSV(ctx context.Context, db seedDB, filePath string) error {
+	pairs, err := readScriptConversionsTSV(filePath)
 	if err != nil {
-		if err != pgx.ErrNoRows {
-			return 0, fmt.Errorf("select existing word: %w", err)
-		}
-
-		// Insert new word
-		if err := tx.QueryRow(
-			ctx,
-			insertQ,
-			languageID,
-			lemma,
-			w.LemmaNormalized,
-			w.SearchKey,
-			w.Romanization,
-			w.ScriptCode,
-			w.FrequencyRank,
-			w.Note,
-		).Scan(&wordID); err != nil {
-			return 0, fmt.Errorf("insert word: %w", err)
-		}
-
-		return wordID, nil
-	}
-
-	// Update existing word
-	if _, err := tx.Exec(
-		ctx,
-		updateQ,
-		wordID,
-		w.LemmaNormalized,
-		w.SearchKey,
-		w.Romanization,
-		w.ScriptCode,
-		w.FrequencyRank,
-		w.Note,
-	); err != nil {
-		return 0, fmt.Errorf("update word: %w", err)
-	}
-
-	return wordID, nil
-}
-
-// upsertWordDetails handles all dictionary structures that depend on a word:
-// topics, pronunciations, senses, translations, relations, examples, characters, etc.
-func upsertWordDetails(
-	ctx context.Context,
-	pool *pgxpool.Pool,
-	tx pgx.Tx,
-	wordID int64,
-	w WordJSON,
-	languageCache map[string]int16,
-	posCache map[string]*int16,
-	topicCache map[string]int64,
-	levelCache map[string]*int64,
-	wordCache map[string]int64,
-	characterCache map[string]int64,
-) error {
-	// Topics
-	if err := upsertWordTopics(ctx, pool, tx, wordID, w.Topics, topicCache); err != nil {
 		return err
 	}
 
-	// Pronunciations
-	if err := upsertWordPronunciations(ctx, tx, wordID, w.Pronunciations); err != nil {
-		return err
-	}
+	const q = `
+INSERT INTO script_conversions (simplified, traditional, source)
+VALUES ($1, $2, 'file')
+ON CONFLICT (simplified, traditional) DO UPDATE
+SET source = EXCLUDED.source,
+    updated_at = NOW()
+WHERE script_conversions.source <> EXCLUDED.source
+`
 
-	// Senses, translations, examples
-	if err := upsertWordSenses(
-		ctx,
-		pool,
-		tx,
-		wordID,
-		w.Senses,
-		languageCache,
-		levelCache,
-		wordCache,
-		posCache,
-	); err != nil {
-		return err
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
 	}
+	defer tx.Rollback(ctx)
 
-	// Relations
-	if err := upsertWordRelations(
-		ctx,
-		pool,
-		tx,
-		wordID,
-		w.Relations,
-		languageCache,
-		wordCache,
-	); err != nil {
-		return err
+	for _, p := range pairs {
+		if _, err := tx.Exec(ctx, q, p.Simplified, p.Traditional); err != nil {
+			return fmt.Errorf("upsert script conversion %s/%s: %w", p.Simplified, p.Traditional, err)
+		}
 	}
 
-	// Characters (mainly for Chinese)
-	if len(w.Characters) > 0 {
-		if err := upsertWordCharacters(
-			ctx,
-			pool,
-			tx,
-			wordID,
-			w.Characters,
-			languageCache,
-			characterCache,
-			levelCache,
-		); err != nil {
-			return err
-		}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
 	}
 
+	fmt.Printf("  Processed %d script conversion pairs from %s\n", len(pairs), filePath)
 	return nil
 }
 
-// --------- Word topics ----------
-
-func upsertWordTopics(
-	ctx context.Context,
-	pool *pgxpool.Pool,
-	tx pgx.Tx,
-	wordID int64,
-	topicCodes []string,
-	topicCache map[string]int64,
-) error {
-	if len(topicCodes) == 0 {
-		return nil
+// readScriptConversionsTSV reads simplified/traditional pairs from a TSV file.
+// Each line holds "simplified<TAB>traditional"; blank lines and lines starting with # are skipped.
+func readScriptConversionsTSV(filePath string) ([]normalize.ScriptPair, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("open tsv file: %w", err)
 	}
+	defer f.Close()
 
-	const insertQ = `
-INSERT INTO word_topics (word_id, topic_id)
-VALUES ($1, $2)
-ON CONFLICT (word_id, topic_id) DO NOTHING
-`
-
-	for _, code := range topicCodes {
-		if code == "" {
+	var pairs []normalize.ScriptPair
+	scanner := bufio.NewScanner(f)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
 			continue
 		}
-		topicID, err := getTopicID(ctx, pool, topicCache, code)
-		if err != nil {
-			return err
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: expected simplified<TAB>traditional", lineNumber)
 		}
-		if _, err := tx.Exec(ctx, insertQ, wordID, topicID); err != nil {
-			return fmt.Errorf("insert word_topic (%d, %d): %w", wordID, topicID, err)
+		simplified := strings.TrimSpace(fields[0])
+		traditional := strings.TrimSpace(fields[1])
+		if utf8.RuneCountInString(simplified) != 1 || utf8.RuneCountInString(traditional) != 1 {
+			return nil, fmt.Errorf("line %d: pairs must be single characters", lineNumber)
 		}
-	}
-	return nil
-}
-
-// --------- Pronunciations ----------
-
-func upsertWordPronunciations(
-	ctx context.Context,
-	tx pgx.Tx,
-	wordID int64,
-	prons []PronunciationJSON,
-) error {
-	if len(prons) == 0 {
-		return nil
-	}
-
-	const insertQ = `
-INSERT INTO pronunciations (word_id, dialect, ipa, phonetic, audio_url)
-VALUES ($1, $2, $3, $4, $5)
-ON CONFLICT (word_id, dialect) DO UPDATE
-SET ipa = EXCLUDED.ipa,
-    phonetic = EXCLUDED.phonetic,
-    audio_url = EXCLUDED.audio_url
-`
-
-	for _, p := range prons {
-		if _, err := tx.Exec(ctx, insertQ, wordID, p.Dialect, p.IPA, p.Phonetic, p.AudioURL); err != nil {
-			return fmt.Errorf("upsert pronunciation: %w", err)
+		if simplified == traditional {
+			continue
 		}
+		pairs = append(pairs, normalize.ScriptPair{Simplified: simplified, Traditional: traditional})
 	}
 
-	return nil
-}
-
-// --------- Senses, translations, examples ----------
-
-func upsertWordSenses(
-	ctx context.Context,
-	pool *pgxpool.Pool,
-	tx pgx.Tx,
-	wordID int64,
-	senses []SenseJSON,
-	languageCache map[string]int16,
-	levelCache map[string]*int64,
-	wordCache map[string]int64,
-	posCache map[string]*int16,
-) error {
-	if len(senses) == 0 {
-		return nil
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan tsv: %w", err)
 	}
+	return pairs, nil
+}
 
-	const insertSenseQ = `
-INSERT INTO senses (word_id, sense_order, part_of_speech_id, definition, definition_language_id, usage_label, level_id, note)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-ON CONFLICT (word_id, sense_order) DO UPDATE
-SET part_of_speech_id = EXCLUDED.part_of_speech_id,
-    definition = EXCLUDED.definition,
-    usage_label = EXCLUDED.usage_label,
-    level_id = EXCLUDED.level_id,
-    note = EXCLUDED.note
-RETURNING id
+// loadScriptTable loads every conversion pair, imported pairs first so they win over derived ones.
+func loadScriptTable(ctx context.Context, db seedDB) (*normalize.ScriptTable, error) {
+	const q = `
+SELECT simplified, traditional
+FROM script_conversions
+ORDER BY (source = 'file') DESC, simplified, traditional
 `
 
-	for _, s := range senses {
-		defLangID, err := getLanguageIDWithCache(ctx, pool, languageCache, s.DefinitionLanguage)
-		if err != nil {
-			return err
-		}
-
-		levelID, err := getLevelID(ctx, pool, levelCache, s.Level)
-		if err != nil {
-			return err
-		}
-
-		posID, err := getPartOfSpeechID(ctx, tx, posCache, s.PartOfSpeech)
-		if err != nil {
-			return fmt.Errorf("get part_of_speech_id (%s): %w", s.PartOfSpeech, err)
-		}
-		if posID == nil {
-			return fmt.Errorf("part_of_speech is required for sense but was empty or not found: %s", s.PartOfSpeech)
-		}
-
-		var senseID int64
-		if err := tx.QueryRow(
-			ctx,
-			insertSenseQ,
-			wordID,
-			s.Order,
-			posID,
-			s.Definition,
-			defLangID,
-			s.UsageLabel,
-			levelID,
-			s.Note,
-		).Scan(&senseID); err != nil {
-			return fmt.Errorf("upsert sense: %w", err)
-		}
-
-		// Translations for this sense
-		if err := upsertSenseTranslations(
-			ctx,
-			pool,
-			tx,
-			senseID,
-			s.Translations,
-			languageCache,
-			wordCache,
-		); err != nil {
-			return err
-		}
+	rows, err := db.Query(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("query script conversions: %w", err)
+	}
+	defer rows.Close()
 
-		// Examples for this sense
-		if err := upsertSenseExamples(
-			ctx,
-			pool,
-			tx,
-			senseID,
-			s.Examples,
-			languageCache,
-		); err != nil {
-			return err
+	var pairs []normalize.ScriptPair
+	for rows.Next() {
+		var p normalize.ScriptPair
+		if err := rows.Scan(&p.Simplified, &p.Traditional); err != nil {
+			return nil, fmt.Errorf("scan script conversion: %w", err)
 		}
+		pairs = append(pairs, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate script conversions: %w", err)
 	}
 
-	return nil
+	return normalize.NewScriptTable(pairs), nil
 }
 
-func upsertSenseTranslations(
-	ctx context.Context,
-	pool *pgxpool.Pool,
-	tx pgx.Tx,
-	senseID int64,
-	translations []SenseTranslationJSON,
-	languageCache map[string]int16,
-	wordCache map[string]int64,
-) error {
-	if len(translations) == 0 {
-		return nil
-	}
-
+// syncScriptConversions derives pairs from characters that list both script forms, reloads the
+// conversion table and re-simplifies lemma_normalized of Chinese words whose value changed.
+func syncScriptConversions(ctx context.Context, db seedDB) error {
 	const insertQ = `
-INSERT INTO sense_translations (source_sense_id, target_word_id, priority, note)
-VALUES ($1, $2, $3, $4)
-ON CONFLICT (source_sense_id, target_word_id) DO UPDATE
-SET priority = EXCLUDED.priority,
-    note = EXCLUDED.note
-RETURNING id
+INSERT INTO script_conversions (simplified, traditional, source)
+SELECT DISTINCT simplified, traditional, 'characters'
+FROM characters
+WHERE simplified IS NOT NULL
+  AND traditional IS NOT NULL
+  AND simplified <> traditional
+  AND char_length(simplified) = 1
+  AND char_length(traditional) = 1
+ON CONFLICT (simplified, traditional) DO NOTHING
 `
 
-	for _, t := range translations {
-		targetLangID, err := getLanguageIDWithCache(ctx, pool, languageCache, t.TargetWord.Language)
-		if err != nil {
-			return err
-		}
-
-		targetWordID, err := upsertRelatedWord(ctx, tx, targetLangID, t.TargetWord, wordCache)
-		if err != nil {
-			return err
-		}
-
-		if _, err := tx.Exec(
-			ctx,
-			insertQ,
-			senseID,
-			targetWordID,
-			t.Priority,
-			t.Note,
-		); err != nil {
-			return fmt.Errorf("upsert sense_translation: %w", err)
-		}
+	tag, err := db.Exec(ctx, insertQ)
+	if err != nil {
+		return fmt.Errorf("derive script conversions from characters: %w", err)
 	}
+	fmt.Printf("  Added %d script conversion pairs from characters\n", tag.RowsAffected())
 
-	return nil
-}
-
-func upsertSenseExamples(
-	ctx context.Context,
-	pool *pgxpool.Pool,
-	tx pgx.Tx,
-	senseID int64,
-	examples []ExampleJSON,
-	languageCache map[string]int16,
-) error {
-	if len(examples) == 0 {
-		return nil
+	scriptTable, err = loadScriptTable(ctx, db)
+	if err != nil {
+		return err
 	}
 
-	const selectExampleQ = `
-SELECT id
-FROM examples
-WHERE source_sense_id = $1 AND language_id = $2 AND content = $3
-`
-
-	const insertExampleQ = `
-INSERT INTO examples (source_sense_id, language_id, content, audio_url, source)
-VALUES ($1, $2, $3, $4, NULL)
-RETURNING id
-`
-
-	const updateExampleQ = `
-UPDATE examples
-SET audio_url = $2
-WHERE id = $1
-`
-
-	const insertTransQ = `
-INSERT INTO example_translations (example_id, language_id, content)
-VALUES ($1, $2, $3)
-ON CONFLICT (example_id, language_id) DO UPDATE
-SET content = EXCLUDED.content
+	const selectQ = `
+SELECT w.id, w.lemma, w.lemma_normalized
+FROM words w
+JOIN languages l ON l.id = w.language_id
+WHERE l.code = 'zh'
 `
 
-	for _, ex := range examples {
-		langID, err := getLanguageIDWithCache(ctx, pool, languageCache, ex.Language)
-		if err != nil {
-			return err
-		}
-
-		var exampleID int64
-		err = tx.QueryRow(ctx, selectExampleQ, senseID, langID, ex.Content).Scan(&exampleID)
-		if err != nil {
-			if err == pgx.ErrNoRows {
-				if err := tx.QueryRow(
-					ctx,
-					insertExampleQ,
-					senseID,
-					langID,
-					ex.Content,
-					ex.AudioURL,
-				).Scan(&exampleID); err != nil {
-					return fmt.Errorf("insert example: %w", err)
-				}
-			} else {
-				return fmt.Errorf("select example: %w", err)
-			}
-		} else {
-			if _, err := tx.Exec(ctx, updateExampleQ, exampleID, ex.AudioURL); err != nil {
-				return fmt.Errorf("update example: %w", err)
-			}
-		}
-
-		for _, tr := range ex.Translations {
-			trLangID, err := getLanguageIDWithCache(ctx, pool, languageCache, tr.Language)
-			if err != nil {
-				return err
-			}
-
-			if _, err := tx.Exec(
-				ctx,
-				insertTransQ,
-				exampleID,
-				trLangID,
-				tr.Content,
-			); err != nil {
-				return fmt.Errorf("upsert example_translation: %w", err)
-			}
-		}
+	rows, err := db.Query(ctx, selectQ)
+	if err != nil {
+		return fmt.Errorf("query chinese words: %w", err)
 	}
 
-	return nil
-}
-
-// --------- Word relations ----------
-
-func upsertWordRelations(
-	ctx context.Context,
-	pool *pgxpool.Pool,
-	tx pgx.Tx,
-	fromWordID int64,
-	relations []WordRelationJSON,
-	languageCache map[string]int16,
-	wordCache map[string]int64,
-) error {
-	if len(relations) == 0 {
-		return nil
+	type normalizedLemma struct {
+		id    int64
+		value string
 	}
-
-	const insertQ = `
-INSERT INTO word_relations (from_word_id, to_word_id, relation_type, note)
-VALUES ($1, $2, $3, $4)
-ON CONFLICT (from_word_id, to_word_id, relation_type) DO UPDATE
-SET note = EXCLUDED.note
-`
-
-	for _, r := range relations {
-		targetLangID, err := getLanguageIDWithCache(ctx, pool, languageCache, r.TargetWord.Language)
-		if err != nil {
-			return err
-		}
-
-		targetWordID, err := upsertRelatedWord(ctx, tx, targetLangID, r.TargetWord, wordCache)
-		if err != nil {
-			return err
+	var updates []normalizedLemma
+	for rows.Next() {
+		var (
+			id              int64
+			lemma           string
+			lemmaNormalized *string
+		)
+		if err := rows.Scan(&id, &lemma, &lemmaNormalized); err != nil {
+			rows.Close()
+			return fmt.Errorf("scan chinese word: %w", err)
 		}
 
-		// Skip if from_word_id == to_word_id (CHECK constraint will prevent this)
-		if fromWordID == targetWordID {
-			continue
+		var value string
+		if lemmaNormalized != nil && *lemmaNormalized != "" {
+			value = scriptTable.ToSimplified(*lemmaNormalized)
+		} else {
+			value, _ = normalize.Keys("zh", lemma, nil, scriptTable)
 		}
-
-		if _, err := tx.Exec(
-			ctx,
-			insertQ,
-			fromWordID,
-			targetWordID,
-			r.RelationType,
-			r.Note,
-		); err != nil {
-			return fmt.Errorf("upsert word_relation: %w", err)
+		if lemmaNormalized == nil || *lemmaNormalized != value {
+			updates = append(updates, normalizedLemma{id: id, value: value})
 		}
 	}
-
-	return nil
-}
-
-// upsertRelatedWord ensures target/related words exist in the words table and returns their id.
-func upsertRelatedWord(ctx context.Context, tx pgx.Tx, languageID int16, w RelatedWordJSON, wordCache map[string]int64) (int64, error) {
-	key := wordCacheKey(w.Language, w.Lemma)
-	if id, ok := wordCache[key]; ok {
-		return id, nil
-	}
-
-	const selectQ = `
-SELECT id
-FROM words
-WHERE language_id = $1 AND lemma = $2
-`
-
-	const insertQ = `
-INSERT INTO words (
-    language_id,
-    lemma,
-    lemma_normalized,
-    search_key,
-    romanization,
-    script_code,
-    frequency_rank,
-    note
-)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING id
-`
-
-	var id int64
-	err := tx.QueryRow(ctx, selectQ, languageID, w.Lemma).Scan(&id)
-	if err != nil {
-		if err != pgx.ErrNoRows {
-			return 0, fmt.Errorf("select related word: %w", err)
-		}
-		if err := tx.QueryRow(
-			ctx,
-			insertQ,
-			languageID,
-			w.Lemma,
-			w.LemmaNormalized,
-			w.SearchKey,
-			w.Romanization,
-			w.ScriptCode,
-			w.FrequencyRank,
-			w.Note,
-		).Scan(&id); err != nil {
-			return 0, fmt.Errorf("insert related word: %w", err)
-		}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate chinese words: %w", err)
 	}
 
-	wordCache[key] = id
-	return id, nil
-}
-
-// --------- Characters & readings ----------
-
-func upsertWordCharacters(
-	ctx context.Context,
-	pool *pgxpool.Pool,
-	tx pgx.Tx,
-	wordID int64,
-	chars []CharacterJSON,
-	languageCache map[string]int16,
-	characterCache map[string]int64,
-	levelCache map[string]*int64,
-) error {
-	if len(chars) == 0 {
+	if len(updates) == 0 {
 		return nil
 	}
 
-	const selectCharQ = `
-SELECT id
-FROM characters
-WHERE literal = $1 AND script_code = $2
-`
-
-	const insertCharQ = `
-INSERT INTO characters (literal, simplified, traditional, script_code, strokes, radical, level_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id
-`
-
-	const insertWordCharQ = `
-INSERT INTO word_characters (word_id, character_id, char_order)
-VALUES ($1, $2, $3)
-ON CONFLICT (word_id, char_order) DO UPDATE SET character_id = EXCLUDED.character_id
-`
-
-	const selectReadingQ = `
-SELECT id
-FROM character_readings
-WHERE character_id = $1 AND language_id = $2 AND reading = $3 AND COALESCE(reading_type, '') = COALESCE($4, '')
-`
-
-	const insertReadingQ = `
-INSERT INTO character_readings (character_id, language_id, reading, reading_type, note)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id
-`
-
-	const updateReadingQ = `
-UPDATE character_readings
-SET note = $2
-WHERE id = $1
-`
-
-	for _, c := range chars {
-		if c.Literal == "" {
-			continue
-		}
-
-		charKey := fmt.Sprintf("%s|%s", c.Literal, c.ScriptCode)
-		var charID int64
-		if cachedID, ok := characterCache[charKey]; ok {
-			charID = cachedID
-		} else {
-			err := tx.QueryRow(ctx, selectCharQ, c.Literal, c.ScriptCode).Scan(&charID)
-			if err != nil {
-				if err == pgx.ErrNoRows {
-					// Convert level code to level_id
-					var levelID *int64
-					if c.Level != nil && *c.Level != "" {
-						levelID, err = getLevelID(ctx, pool, levelCache, c.Level)
-						if err != nil {
-							return fmt.Errorf("get level_id for character level %s: %w", *c.Level, err)
-						}
-					}
-
-					if err := tx.QueryRow(
-						ctx,
-						insertCharQ,
-						c.Literal,
-						c.Simplified,
-						c.Traditional,
-						c.ScriptCode,
-						c.Strokes,
-						c.Radical,
-						levelID,
-					).Scan(&charID); err != nil {
-						return fmt.Errorf("insert character: %w", err)
-					}
-				} else {
-					return fmt.Errorf("select character: %w", err)
-				}
-			}
-			characterCache[charKey] = charID
-		}
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
 
-		// Link word to character with order
-		if _, err := tx.Exec(ctx, insertWordCharQ, wordID, charID, c.CharOrder); err != nil {
-			return fmt.Errorf("insert word_character: %w", err)
+	const updateQ = `UPDATE words SET lemma_normalized = $2, updated_at = NOW() WHERE id = $1`
+	for _, u := range updates {
+		if _, err := tx.Exec(ctx, updateQ, u.id, u.value); err != nil {
+			return fmt.Errorf("update lemma_normalized of word %d: %w", u.id, err)
 		}
+	}
 
-		// Readings for this character
-		for _, r := range c.Readings {
-			langID, err := getLanguageIDWithCache(ctx, pool, languageCache, r.Language)
-			if err != nil {
-				return err
-			}
-
-			var readingID int64
-			err = tx.QueryRow(ctx, selectReadingQ, charID, langID, r.Reading, r.ReadingType).Scan(&readingID)
-			if err != nil {
-				if err == pgx.ErrNoRows {
-					if err := tx.QueryRow(
-						ctx,
-						insertReadingQ,
-						charID,
-						langID,
-						r.Reading,
-						r.ReadingType,
-						r.Note,
-					).Scan(&readingID); err != nil {
-						return fmt.Errorf("insert character_reading: %w", err)
-					}
-				} else {
-					return fmt.Errorf("select character_reading: %w", err)
-				}
-			} else {
-				if _, err := tx.Exec(ctx, updateReadingQ, readingID, r.Note); err != nil {
-					return fmt.Errorf("update character_reading: %w", err)
-				}
-			}
-		}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
 	}
 
+	fmt.Printf("  Re-normalized %d Chinese lemmas\n", len(updates))
 	return nil
 }
@@ -0,0 +1,497 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/normalize"
+)
+
+// --------- Seed file validation ----------
+
+// Values the generation prompt (dictionary-entry.prompt.txt) allows beyond the codes stored in
+// the init data. Entries outside them import fine but are reported as warnings.
+var (
+	promptDialects    = []string{"en-US", "en-UK", "weak_form", "zh-CN"}
+	promptUsageLabels = []string{"common", "grammar", "informal/common"}
+)
+
+// seedFileLanguage extracts the language of a word seed file from its name ("0002_word_en.jsonl")
+var seedFileLanguage = regexp.MustCompile(`word_([a-z]+)\.jsonl$`)
+
+// seedCodes are the codes a word file may reference, loaded from the init data
+type seedCodes struct {
+	languages     map[string]bool
+	topics        map[string]bool
+	partsOfSpeech map[string]bool
+	// levels maps a level code to its language, "" for levels shared by all languages
+	levels map[string]string
+}
+
+// seedLinter checks word seed files line by line without a database
+type seedLinter struct {
+	codes   seedCodes
+	scripts *normalize.ScriptTable
+}
+
+// lintIssue is a problem found on a line. Errors make the import fail or store wrong data;
+// warnings are departures from the generation prompt's rules.
+type lintIssue struct {
+	warning bool
+	field   string
+	message string
+}
+
+// runValidate implements the validate subcommand and returns the exit code
+func runValidate(args []string) int {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	initPath := fs.String("init", initDataPath, "Init data JSON with the known languages, topics, levels and parts of speech")
+	scriptsPath := fs.String("script-conversions", scriptConversionsDataPath, "Simplified/traditional pairs used to check Chinese lemma_normalized")
+	language := fs.String("language", "", "Language of the files (default: from file names like 0002_word_en.jsonl, else each line's language)")
+	strict := fs.Bool("strict", false, "Fail on warnings as well as errors")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s validate [flags] [file.jsonl ...]\n\nChecks word seed files (default: every word file in the seed directory) without a database.\n\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+
+	files := fs.Args()
+	if len(files) == 0 {
+		found, err := discoverWordFiles(seedDataDir, nil)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "validate: %v\n", err)
+			return 2
+		}
+		for _, f := range found {
+			files = append(files, f.path)
+		}
+	}
+
+	linter, err := newSeedLinter(*initPath, *scriptsPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "validate: %v\n", err)
+		return 2
+	}
+
+	totalErrors, totalWarnings := 0, 0
+	for _, path := range files {
+		lang := *language
+		if lang == "" {
+			if m := seedFileLanguage.FindStringSubmatch(path); m != nil {
+				lang = m[1]
+			}
+		}
+		errs, warns, err := linter.lintFile(path, lang)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "validate: %v\n", err)
+			return 2
+		}
+		totalErrors += errs
+		totalWarnings += warns
+	}
+
+	fmt.Printf("%d errors, %d warnings in %d files\n", totalErrors, totalWarnings, len(files))
+	if totalErrors > 0 || (*strict && totalWarnings > 0) {
+		return 1
+	}
+	return 0
+}
+
+// newSeedLinter loads the known codes from the init data and the conversion pairs from the TSV
+// file. A missing TSV file leaves Chinese lemmas in their original script.
+func newSeedLinter(initPath, scriptsPath string) (*seedLinter, error) {
+	f, err := os.Open(initPath)
+	if err != nil {
+		return nil, fmt.Errorf("open init data: %w", err)
+	}
+	defer f.Close()
+
+	var data SeedData
+	if err := json.NewDecoder(f).Decode(&data); err != nil {
+		return nil, fmt.Errorf("decode init data: %w", err)
+	}
+
+	codes := seedCodes{
+		languages:     make(map[string]bool, len(data.Languages)),
+		topics:        make(map[string]bool, len(data.Topics)),
+		partsOfSpeech: make(map[string]bool, len(data.PartsOfSpeech)),
+		levels:        make(map[string]string, len(data.Levels)),
+	}
+	for _, l := range data.Languages {
+		codes.languages[l.Code] = true
+	}
+	for _, t := range data.Topics {
+		codes.topics[t.Code] = true
+	}
+	for _, p := range data.PartsOfSpeech {
+		codes.partsOfSpeech[p.Code] = true
+	}
+	for _, lv := range data.Levels {
+		codes.levels[lv.Code] = ""
+		if lv.Language != nil {
+			codes.levels[lv.Code] = *lv.Language
+		}
+	}
+
+	pairs, err := readScriptConversionsTSV(scriptsPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	return &seedLinter{codes: codes, scripts: normalize.NewScriptTable(pairs)}, nil
+}
+
+// lintFile prints the issues of every line of a word file and returns the error and warning
+// counts. languageCode is the language every word must have, or "" to accept any known one.
+func (l *seedLinter) lintFile(filePath, languageCode string) (int, int, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return 0, 0, fmt.Errorf("open jsonl file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	const maxLineSize = 1024 * 1024 // 1MB
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	errCount, warnCount := 0, 0
+	lemmas := make(map[string]int)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		var w domain.WordJSON
+		var issues []lintIssue
+		if err := json.Unmarshal(line, &w); err != nil {
+			issues = []lintIssue{{message: "invalid json: " + err.Error()}}
+		} else {
+			// Fields outside the schema are dropped by the seeder, which usually hides a typo
+			dec := json.NewDecoder(bytes.NewReader(line))
+			dec.DisallowUnknownFields()
+			if err := dec.Decode(&domain.WordJSON{}); err != nil {
+				issues = append(issues, lintIssue{message: strings.TrimPrefix(err.Error(), "json: ")})
+			}
+			issues = append(issues, l.lintWord(languageCode, w)...)
+			if first, ok := lemmas[w.Lemma]; ok {
+				issues = append(issues, lintIssue{
+					warning: true,
+					field:   "lemma",
+					message: fmt.Sprintf("also on line %d; the later line overwrites the earlier", first),
+				})
+			} else {
+				lemmas[w.Lemma] = lineNumber
+			}
+		}
+
+		for _, issue := range issues {
+			severity := "error"
+			if issue.warning {
+				severity = "warning"
+				warnCount++
+			} else {
+				errCount++
+			}
+			location := strings.TrimSpace(w.Lemma + " " + issue.field)
+			if location != "" {
+				location += ": "
+			}
+			fmt.Printf("%s:%d: %s: %s%s\n", filePath, lineNumber, severity, location, issue.message)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return errCount, warnCount, fmt.Errorf("scan %s: %w", filePath, err)
+	}
+	return errCount, warnCount, nil
+}
+
+// lintWord checks one word against the codes in the init data and the prompt's schema
+func (l *seedLinter) lintWord(languageCode string, w domain.WordJSON) []lintIssue {
+	var issues []lintIssue
+	errorf := func(field, format string, args ...any) {
+		issues = append(issues, lintIssue{field: field, message: fmt.Sprintf(format, args...)})
+	}
+	warnf := func(field, format string, args ...any) {
+		issues = append(issues, lintIssue{warning: true, field: field, message: fmt.Sprintf(format, args...)})
+	}
+
+	switch {
+	case w.Language == "":
+		errorf("language", "is required")
+	case !l.codes.languages[w.Language]:
+		errorf("language", "unknown language %q", w.Language)
+	case languageCode != "" && w.Language != languageCode:
+		errorf("language", "is %q in a %s file", w.Language, languageCode)
+	}
+	if languageCode == "" {
+		languageCode = w.Language
+	}
+	if blank(w.Lemma) {
+		errorf("lemma", "is required")
+	}
+
+	// The seeder keeps provided keys as they are, so a wrong key makes the word unsearchable
+	lemmaNormalized, searchKey := normalize.Keys(languageCode, w.Lemma, w.Romanization, l.scripts)
+	if w.LemmaNormalized != nil && *w.LemmaNormalized != lemmaNormalized {
+		errorf("lemma_normalized", "%q does not match lemma (expected %q)", *w.LemmaNormalized, lemmaNormalized)
+	}
+	if w.SearchKey != nil && *w.SearchKey != searchKey {
+		if languageCode == "zh" {
+			errorf("search_key", "%q does not match romanization %q (expected %q)", *w.SearchKey, deref(w.Romanization), searchKey)
+		} else {
+			errorf("search_key", "%q does not match lemma_normalized %q", *w.SearchKey, lemmaNormalized)
+		}
+	}
+
+	if languageCode == "zh" {
+		if blank(deref(w.Romanization)) {
+			warnf("romanization", "pinyin with tone marks is required for zh")
+		}
+	} else if w.Romanization != nil {
+		warnf("romanization", "should be null for %s", languageCode)
+	}
+	if w.ScriptCode == nil {
+		warnf("script_code", "is required")
+	} else if want := *scriptCodeFor(languageCode); *w.ScriptCode != want {
+		errorf("script_code", "is %q, %s words are %q", *w.ScriptCode, languageCode, want)
+	}
+	if w.FrequencyRank != nil && *w.FrequencyRank < 1 {
+		errorf("frequency_rank", "must be positive")
+	} else if w.FrequencyRank == nil || *w.FrequencyRank > 10 {
+		warnf("frequency_rank", "the prompt uses a 1-10 scale")
+	}
+	if blank(deref(w.Note)) {
+		warnf("note", "is required")
+	}
+
+	if len(w.Topics) == 0 {
+		warnf("topics", "at least one topic is required")
+	}
+	l.lintTopics("topics", w.Topics, errorf, warnf)
+
+	if len(w.Pronunciations) == 0 {
+		warnf("pronunciations", "at least one pronunciation is required")
+	}
+	dialects := make(map[string]bool, len(w.Pronunciations))
+	for i, p := range w.Pronunciations {
+		field := fmt.Sprintf("pronunciations[%d]", i)
+		switch {
+		case blank(p.Dialect):
+			errorf(field+".dialect", "is required")
+		case dialects[p.Dialect]:
+			errorf(field+".dialect", "%q is listed more than once", p.Dialect)
+		case !slices.Contains(promptDialects, p.Dialect):
+			warnf(field+".dialect", "%q is not one of %s", p.Dialect, strings.Join(promptDialects, ", "))
+		}
+		dialects[p.Dialect] = true
+		if p.IPA == nil && p.Phonetic == nil {
+			warnf(field, "ipa or phonetic is required")
+		}
+	}
+
+	for i, r := range w.Relations {
+		field := fmt.Sprintf("relations[%d]", i)
+		if !slices.Contains(domain.RelationTypes, r.RelationType) {
+			errorf(field+".relation_type", "%q is not one of %s", r.RelationType, strings.Join(domain.RelationTypes, ", "))
+		}
+		l.lintRelatedWord(field+".target_word", r.TargetWord, errorf, warnf)
+		if r.TargetWord.Language == w.Language && r.TargetWord.Lemma == w.Lemma {
+			warnf(field, "relates the word to itself and is skipped")
+		}
+	}
+
+	if len(w.Senses) == 0 {
+		warnf("senses", "at least one sense is required")
+	}
+	orders := make(map[int]bool, len(w.Senses))
+	for i, s := range w.Senses {
+		field := fmt.Sprintf("senses[%d]", i)
+		if orders[s.Order] {
+			errorf(field+".order", "%d is used by another sense, which it overwrites", s.Order)
+		}
+		orders[s.Order] = true
+		l.lintSense(field, languageCode, s, errorf, warnf)
+	}
+
+	if len(w.Characters) > 0 && languageCode != "zh" {
+		warnf("characters", "only zh words list characters")
+	}
+	charOrders := make(map[int]bool, len(w.Characters))
+	for i, c := range w.Characters {
+		field := fmt.Sprintf("characters[%d]", i)
+		if utf8.RuneCountInString(c.Literal) != 1 {
+			errorf(field+".literal", "%q must be a single character", c.Literal)
+		}
+		if charOrders[c.CharOrder] {
+			errorf(field+".char_order", "%d is used by another character", c.CharOrder)
+		}
+		charOrders[c.CharOrder] = true
+		l.lintLevel(field+".level", "zh", c.Level, errorf)
+		for j, r := range c.Readings {
+			if !l.codes.languages[r.Language] {
+				errorf(fmt.Sprintf("%s.readings[%d].language", field, j), "unknown language %q", r.Language)
+			}
+			if blank(r.Reading) {
+				errorf(fmt.Sprintf("%s.readings[%d].reading", field, j), "is required")
+			}
+		}
+	}
+
+	return issues
+}
+
+// lintSense checks a sense with its translations and examples
+func (l *seedLinter) lintSense(field, languageCode string, s domain.SenseJSON, errorf, warnf func(field, format string, args ...any)) {
+	if s.Order < 1 || s.Order > 32767 {
+		errorf(field+".order", "must be between 1 and 32767")
+	}
+	if !l.codes.partsOfSpeech[s.PartOfSpeech] {
+		errorf(field+".part_of_speech", "unknown part of speech %q", s.PartOfSpeech)
+	}
+	if !l.codes.languages[s.DefinitionLanguage] {
+		errorf(field+".definition_language", "unknown language %q", s.DefinitionLanguage)
+	} else if s.DefinitionLanguage != "vi" {
+		warnf(field+".definition_language", "definitions are written in vi")
+	}
+	if blank(s.Definition) {
+		errorf(field+".definition", "is required")
+	}
+	l.lintLevel(field+".level", languageCode, s.Level, errorf)
+	if s.UsageLabel != nil && !slices.Contains(promptUsageLabels, *s.UsageLabel) {
+		warnf(field+".usage_label", "%q is not one of %s", *s.UsageLabel, strings.Join(promptUsageLabels, ", "))
+	}
+
+	// Words are translated into the other two languages, except grammar senses
+	translated := make(map[string]bool)
+	for i, t := range s.Translations {
+		tf := fmt.Sprintf("%s.translations[%d]", field, i)
+		l.lintRelatedWord(tf+".target_word", t.TargetWord, errorf, warnf)
+		if t.TargetWord.Language == languageCode {
+			warnf(tf+".target_word.language", "translates into the word's own language")
+		}
+		translated[t.TargetWord.Language] = true
+	}
+	if deref(s.UsageLabel) == "grammar" {
+		if len(s.Translations) > 0 {
+			warnf(field+".translations", "grammar senses have no translations")
+		}
+	} else {
+		for _, lang := range l.otherLanguages(languageCode) {
+			if !translated[lang] {
+				warnf(field+".translations", "no %s translation", lang)
+			}
+		}
+	}
+
+	if len(s.Examples) == 0 {
+		warnf(field+".examples", "at least one example is required")
+	}
+	for i, ex := range s.Examples {
+		ef := fmt.Sprintf("%s.examples[%d]", field, i)
+		if !l.codes.languages[ex.Language] {
+			errorf(ef+".language", "unknown language %q", ex.Language)
+		}
+		if blank(ex.Content) {
+			errorf(ef+".content", "is required")
+		}
+		exampleTranslated := make(map[string]bool, len(ex.Translations))
+		for j, tr := range ex.Translations {
+			tf := fmt.Sprintf("%s.translations[%d]", ef, j)
+			switch {
+			case !l.codes.languages[tr.Language]:
+				errorf(tf+".language", "unknown language %q", tr.Language)
+			case exampleTranslated[tr.Language]:
+				errorf(tf+".language", "more than one %s translation", tr.Language)
+			}
+			if blank(tr.Content) {
+				errorf(tf+".content", "is required")
+			}
+			exampleTranslated[tr.Language] = true
+		}
+		for _, lang := range l.otherLanguages(ex.Language) {
+			if !exampleTranslated[lang] {
+				warnf(ef+".translations", "no %s translation", lang)
+			}
+		}
+	}
+}
+
+// lintRelatedWord checks a translation or relation target
+func (l *seedLinter) lintRelatedWord(field string, w domain.RelatedWordJSON, errorf, warnf func(field, format string, args ...any)) {
+	if !l.codes.languages[w.Language] {
+		errorf(field+".language", "unknown language %q", w.Language)
+	}
+	if blank(w.Lemma) {
+		errorf(field+".lemma", "is required")
+	}
+	if w.FrequencyRank != nil && *w.FrequencyRank < 1 {
+		errorf(field+".frequency_rank", "must be positive")
+	}
+	// The seeder does not import the topics of target words, so unknown ones are only warnings
+	l.lintTopics(field+".topics", w.Topics, warnf, warnf)
+}
+
+// lintTopics reports unknown topic codes through unknown and repeated ones as warnings
+func (l *seedLinter) lintTopics(field string, topics []string, unknown, warnf func(field, format string, args ...any)) {
+	seen := make(map[string]bool, len(topics))
+	for _, code := range topics {
+		switch {
+		case !l.codes.topics[code]:
+			unknown(field, "unknown topic %q", code)
+		case seen[code]:
+			warnf(field, "topic %q is listed more than once", code)
+		}
+		seen[code] = true
+	}
+}
+
+// lintLevel reports unknown levels and levels of another language (HSK on an English word)
+func (l *seedLinter) lintLevel(field, languageCode string, level *string, errorf func(field, format string, args ...any)) {
+	if level == nil || *level == "" {
+		return
+	}
+	levelLanguage, ok := l.codes.levels[*level]
+	switch {
+	case !ok:
+		errorf(field, "unknown level %q", *level)
+	case levelLanguage != "" && levelLanguage != languageCode:
+		errorf(field, "level %q is for %s words", *level, levelLanguage)
+	}
+}
+
+// otherLanguages returns the known languages other than languageCode, in a stable order
+func (l *seedLinter) otherLanguages(languageCode string) []string {
+	var langs []string
+	for lang := range l.codes.languages {
+		if lang != languageCode {
+			langs = append(langs, lang)
+		}
+	}
+	slices.Sort(langs)
+	return langs
+}
+
+// blank reports whether s is empty after trimming spaces
+func blank(s string) bool {
+	return strings.TrimSpace(s) == ""
+}
+
+// deref returns the value of an optional string, or ""
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
@@ -113,22 +114,36 @@ func main() {
 		log.Fatalf("Failed to ping database: %v", err)
 	}
 
-	// Read migration file
-	migrationPath := "db/migrations/schema/0001_init_schema.sql"
-	if _, err := os.Stat(migrationPath); os.IsNotExist(err) {
+	// Collect migration files (applied in lexical order: 0001_..., 0002_..., ...)
+	migrationDir := "db/migrations/schema"
+	if _, err := os.Stat(migrationDir); os.IsNotExist(err) {
 		// Try alternative path
-		migrationPath = filepath.Join("backend", migrationPath)
+		migrationDir = filepath.Join("backend", migrationDir)
 	}
 
-	sqlBytes, err := os.ReadFile(migrationPath)
+	migrationPaths, err := filepath.Glob(filepath.Join(migrationDir, "*.up.sql"))
 	if err != nil {
-		log.Fatalf("Failed to read migration file: %v", err)
+		log.Fatalf("Failed to list migration files: %v", err)
 	}
-
-	// Execute migration
-	sql := string(sqlBytes)
-	if _, err := db.ExecContext(ctx, sql); err != nil {
-		log.Fatalf("Failed to execute migration: %v", err)
+	sort.Strings(migrationPaths)
+
+	// Execute migrations
+	for _, migrationPath := range migrationPaths {
+		sqlBytes, err := os.ReadFile(migrationPath)
+		if err != nil {
+			log.Fatalf("Failed to read migration file %s: %v", migrationPath, err)
+		}
+
+		// Skip placeholder files
+		sql := string(sqlBytes)
+		if strings.TrimSpace(sql) == "" {
+			continue
+		}
+
+		if _, err := db.ExecContext(ctx, sql); err != nil {
+			log.Fatalf("Failed to execute migration %s: %v", migrationPath, err)
+		}
+		log.Printf("Applied migration %s", filepath.Base(migrationPath))
 	}
 
 	fmt.Println("Migration completed successfully!")
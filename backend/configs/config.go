@@ -10,12 +10,14 @@ import (
 
 // Config holds all application configuration
 type Config struct {
-	App      AppConfig
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	Logging  LoggingConfig
-	CORS     CORSConfig
+	App        AppConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	JWT        JWTConfig
+	Logging    LoggingConfig
+	CORS       CORSConfig
+	VocabGame  VocabGameConfig
+	Dictionary DictionaryConfig
 }
 
 // AppConfig holds application-specific configuration
@@ -64,6 +66,46 @@ type CORSConfig struct {
 	AllowedOrigins []string
 }
 
+// VocabGameConfig holds vocabgame configuration
+type VocabGameConfig struct {
+	QuestionPool QuestionPoolConfig `mapstructure:"question_pool"`
+}
+
+// QuestionPoolConfig holds background question pool configuration
+type QuestionPoolConfig struct {
+	Enabled        bool
+	Size           int           // Question sets kept ready per (source, target, level, topic)
+	RefillInterval time.Duration `mapstructure:"refill_interval"`
+	RefillBatch    int           `mapstructure:"refill_batch"`
+	MaxKeys        int           `mapstructure:"max_keys"`
+}
+
+// DictionaryConfig holds dictionary configuration
+type DictionaryConfig struct {
+	SuggestIndex     SuggestIndexConfig     `mapstructure:"suggest_index"`
+	ScriptConversion ScriptConversionConfig `mapstructure:"script_conversion"`
+	WordDetailCache  WordDetailCacheConfig  `mapstructure:"word_detail_cache"`
+}
+
+// SuggestIndexConfig holds in-memory autocomplete index configuration
+type SuggestIndexConfig struct {
+	Enabled         bool
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"` // How often the words table is checked for changes
+}
+
+// ScriptConversionConfig holds simplified/traditional Chinese conversion configuration
+type ScriptConversionConfig struct {
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"` // How often the conversion table is checked for changes
+}
+
+// WordDetailCacheConfig holds in-memory word detail cache configuration
+type WordDetailCacheConfig struct {
+	Enabled         bool
+	Capacity        int           // Maximum number of cached words
+	TTL             time.Duration // Upper bound on how long a cached detail is served
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"` // How often words.updated_at is checked for changes
+}
+
 // Load loads configuration from environment variables and config files
 func Load() (*Config, error) {
 	// Enable environment variables
@@ -154,6 +196,22 @@ func setDefaults() {
 	// CORS defaults
 	viper.SetDefault("cors.allowed_origins", []string{"http://localhost:3000", "http://localhost:3300"})
 
+	// VocabGame defaults
+	viper.SetDefault("vocabgame.question_pool.enabled", true)
+	viper.SetDefault("vocabgame.question_pool.size", 5)
+	viper.SetDefault("vocabgame.question_pool.refill_interval", "30s")
+	viper.SetDefault("vocabgame.question_pool.refill_batch", 2)
+	viper.SetDefault("vocabgame.question_pool.max_keys", 200)
+
+	// Dictionary defaults
+	viper.SetDefault("dictionary.suggest_index.enabled", true)
+	viper.SetDefault("dictionary.suggest_index.refresh_interval", "1m")
+	viper.SetDefault("dictionary.script_conversion.refresh_interval", "5m")
+	viper.SetDefault("dictionary.word_detail_cache.enabled", true)
+	viper.SetDefault("dictionary.word_detail_cache.capacity", 5000)
+	viper.SetDefault("dictionary.word_detail_cache.ttl", "10m")
+	viper.SetDefault("dictionary.word_detail_cache.refresh_interval", "30s")
+
 	// Environment variable mappings
 	// Viper automatically maps environment variables, but we need to set up the key replacer
 	// Since viper.NewReplacer doesn't exist in newer versions, we'll handle it differently
@@ -170,4 +228,5 @@ func setDefaults() {
 	viper.BindEnv("logging.level", "LOG_LEVEL")
 	viper.BindEnv("logging.path", "LOG_PATH")
 	viper.BindEnv("cors.allowed_origins", "CORS_ALLOWED_ORIGINS")
+	viper.BindEnv("vocabgame.question_pool.enabled", "QUESTION_POOL_ENABLED")
 }
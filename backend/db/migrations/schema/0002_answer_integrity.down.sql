@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS vocab_game_session_flags;
+
+DROP INDEX IF EXISTS idx_vgs_user_unflagged;
+
+ALTER TABLE vocab_game_sessions DROP COLUMN IF EXISTS flagged_at;
+ALTER TABLE vocab_game_question_answers DROP COLUMN IF EXISTS client_response_time_ms;
+ALTER TABLE vocab_game_questions DROP COLUMN IF EXISTS served_at;
@@ -0,0 +1,30 @@
+-- PostgreSQL Migration: Answer integrity checks
+
+-- Time the question was first served to the player (set when the session is created)
+ALTER TABLE vocab_game_questions
+    ADD COLUMN served_at TIMESTAMP; -- when the question was handed out with its session
+
+-- response_time_ms is now computed by the server; keep the client-reported value for review
+ALTER TABLE vocab_game_question_answers
+    ADD COLUMN client_response_time_ms INTEGER; -- response time reported by the client (ms)
+
+ALTER TABLE vocab_game_sessions
+    ADD COLUMN flagged_at TIMESTAMP; -- set when an integrity check fails (excluded from statistics)
+
+CREATE INDEX idx_vgs_user_unflagged ON vocab_game_sessions(user_id, started_at)
+    WHERE flagged_at IS NULL;
+
+CREATE TABLE vocab_game_session_flags (
+    id          BIGSERIAL PRIMARY KEY, -- flag id
+    session_id  BIGINT NOT NULL, -- FK -> vocab_game_sessions.id
+    question_id BIGINT, -- FK -> vocab_game_questions.id (question that triggered the flag)
+    reason      VARCHAR(50) NOT NULL, -- reason: 'too_fast', 'out_of_order', 'burst', ...
+    detail      TEXT, -- human-readable detail for reviewers
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- flag creation time
+    CONSTRAINT fk_vgsf_session
+        FOREIGN KEY (session_id) REFERENCES vocab_game_sessions(id),
+    CONSTRAINT fk_vgsf_question
+        FOREIGN KEY (question_id) REFERENCES vocab_game_questions(id)
+);
+
+CREATE INDEX idx_vgsf_session ON vocab_game_session_flags(session_id);
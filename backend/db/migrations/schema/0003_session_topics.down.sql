@@ -0,0 +1 @@
+DROP TABLE IF EXISTS vocab_game_session_topics;
@@ -0,0 +1,14 @@
+-- PostgreSQL Migration: Session topics
+-- A vocabgame session can be played over several topics
+
+CREATE TABLE vocab_game_session_topics (
+    session_id BIGINT NOT NULL, -- FK -> vocab_game_sessions.id
+    topic_id   BIGINT NOT NULL, -- FK -> topics.id
+    PRIMARY KEY (session_id, topic_id),
+    CONSTRAINT fk_vgst_session
+        FOREIGN KEY (session_id) REFERENCES vocab_game_sessions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_vgst_topic
+        FOREIGN KEY (topic_id) REFERENCES topics(id)
+);
+
+CREATE INDEX idx_vgst_topic ON vocab_game_session_topics(topic_id);
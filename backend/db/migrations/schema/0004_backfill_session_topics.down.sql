@@ -0,0 +1,14 @@
+ALTER TABLE vocab_game_sessions ADD COLUMN IF NOT EXISTS topic_id BIGINT;
+
+-- Only one topic fits the old column; keep the lowest topic id per session
+UPDATE vocab_game_sessions s
+SET topic_id = t.topic_id
+FROM (
+    SELECT session_id, MIN(topic_id) AS topic_id
+    FROM vocab_game_session_topics
+    GROUP BY session_id
+) t
+WHERE s.id = t.session_id;
+
+ALTER TABLE vocab_game_sessions
+    ADD CONSTRAINT fk_vgs_topic FOREIGN KEY (topic_id) REFERENCES topics(id);
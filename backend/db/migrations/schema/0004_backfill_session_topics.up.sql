@@ -0,0 +1,12 @@
+-- PostgreSQL Migration: Backfill session topics
+-- Copy the single topic_id of existing sessions into vocab_game_session_topics,
+-- then drop the column (topics are only read from the join table from now on)
+
+INSERT INTO vocab_game_session_topics (session_id, topic_id)
+SELECT id, topic_id
+FROM vocab_game_sessions
+WHERE topic_id IS NOT NULL
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE vocab_game_sessions DROP CONSTRAINT IF EXISTS fk_vgs_topic;
+ALTER TABLE vocab_game_sessions DROP COLUMN IF EXISTS topic_id;
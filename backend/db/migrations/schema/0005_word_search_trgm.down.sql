@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_words_search_trgm;
+DROP INDEX IF EXISTS idx_words_norm_trgm;
+DROP INDEX IF EXISTS idx_words_lemma_trgm;
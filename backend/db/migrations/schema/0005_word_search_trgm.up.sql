@@ -0,0 +1,8 @@
+-- PostgreSQL Migration: Fuzzy word search
+-- Trigram indexes back both substring (LIKE '%q%') and similarity (%) matching
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_words_lemma_trgm ON words USING GIN (lower(lemma) gin_trgm_ops);
+CREATE INDEX idx_words_norm_trgm ON words USING GIN (lower(lemma_normalized) gin_trgm_ops);
+CREATE INDEX idx_words_search_trgm ON words USING GIN (lower(search_key) gin_trgm_ops);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_words_search_toneless_trgm;
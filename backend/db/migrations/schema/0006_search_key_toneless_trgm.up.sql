@@ -0,0 +1,4 @@
+-- PostgreSQL Migration: Tone-insensitive search keys
+-- Backs matching pinyin search keys without tone numbers, so "xuexi" finds "xue2xi2"
+
+CREATE INDEX idx_words_search_toneless_trgm ON words USING GIN (translate(lower(search_key), 'ü012345 ', 'v') gin_trgm_ops);
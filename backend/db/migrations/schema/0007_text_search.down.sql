@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_ext_content_trgm;
+DROP INDEX IF EXISTS idx_ext_content_fts_simple;
+DROP INDEX IF EXISTS idx_ext_content_fts_english;
+DROP INDEX IF EXISTS idx_examples_content_trgm;
+DROP INDEX IF EXISTS idx_examples_content_fts_simple;
+DROP INDEX IF EXISTS idx_examples_content_fts_english;
+DROP INDEX IF EXISTS idx_senses_definition_trgm;
+DROP INDEX IF EXISTS idx_senses_definition_fts_simple;
+DROP INDEX IF EXISTS idx_senses_definition_fts_english;
@@ -0,0 +1,15 @@
+-- PostgreSQL Migration: Full-text search over definitions and examples
+-- English text is stemmed; other space-delimited languages use the simple config.
+-- Languages without word boundaries (zh, ja, ko) are matched by substring on the trigram indexes.
+
+CREATE INDEX idx_senses_definition_fts_english ON senses USING GIN (to_tsvector('english', definition));
+CREATE INDEX idx_senses_definition_fts_simple ON senses USING GIN (to_tsvector('simple', definition));
+CREATE INDEX idx_senses_definition_trgm ON senses USING GIN (lower(definition) gin_trgm_ops);
+
+CREATE INDEX idx_examples_content_fts_english ON examples USING GIN (to_tsvector('english', content));
+CREATE INDEX idx_examples_content_fts_simple ON examples USING GIN (to_tsvector('simple', content));
+CREATE INDEX idx_examples_content_trgm ON examples USING GIN (lower(content) gin_trgm_ops);
+
+CREATE INDEX idx_ext_content_fts_english ON example_translations USING GIN (to_tsvector('english', content));
+CREATE INDEX idx_ext_content_fts_simple ON example_translations USING GIN (to_tsvector('simple', content));
+CREATE INDEX idx_ext_content_trgm ON example_translations USING GIN (lower(content) gin_trgm_ops);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_wc_char;
+DROP INDEX IF EXISTS idx_characters_strokes;
+DROP INDEX IF EXISTS idx_characters_radical;
+DROP INDEX IF EXISTS idx_characters_traditional;
+DROP INDEX IF EXISTS idx_characters_simplified;
+DROP INDEX IF EXISTS idx_characters_literal;
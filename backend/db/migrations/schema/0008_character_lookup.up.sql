@@ -0,0 +1,10 @@
+-- PostgreSQL Migration: Character dictionary lookups
+-- Backs lookup by literal (or its simplified/traditional form), browsing by radical and stroke count,
+-- and listing the words that contain a character
+
+CREATE INDEX idx_characters_literal ON characters(literal);
+CREATE INDEX idx_characters_simplified ON characters(simplified);
+CREATE INDEX idx_characters_traditional ON characters(traditional);
+CREATE INDEX idx_characters_radical ON characters(radical);
+CREATE INDEX idx_characters_strokes ON characters(strokes);
+CREATE INDEX idx_wc_char ON word_characters(character_id);
@@ -0,0 +1,5 @@
+ALTER TABLE user_profiles
+    DROP CONSTRAINT IF EXISTS chk_user_profiles_preferred_script,
+    DROP COLUMN IF EXISTS preferred_script;
+
+DROP TABLE IF EXISTS script_conversions;
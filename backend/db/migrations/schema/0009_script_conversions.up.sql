@@ -0,0 +1,33 @@
+-- PostgreSQL Migration: Simplified/traditional Chinese conversion
+-- script_conversions maps single characters between simplified and traditional script.
+-- Rows come from the characters table (source 'characters') or from imported files (source 'file');
+-- imported rows take precedence when a character has several counterparts.
+
+CREATE TABLE script_conversions (
+    simplified  VARCHAR(2) NOT NULL, -- simplified character: '学'
+    traditional VARCHAR(2) NOT NULL, -- traditional character: '學'
+    source      VARCHAR(20) NOT NULL DEFAULT 'characters', -- 'characters' or 'file'
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (simplified, traditional),
+    CONSTRAINT chk_script_conversions_distinct CHECK (simplified <> traditional)
+);
+
+CREATE INDEX idx_script_conversions_traditional ON script_conversions(traditional);
+
+CREATE TRIGGER update_script_conversions_updated_at BEFORE UPDATE ON script_conversions
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Seed from characters already in the database
+INSERT INTO script_conversions (simplified, traditional, source)
+SELECT DISTINCT COALESCE(simplified, literal), COALESCE(traditional, literal), 'characters'
+FROM characters
+WHERE (simplified IS NOT NULL OR traditional IS NOT NULL)
+  AND COALESCE(simplified, literal) <> COALESCE(traditional, literal)
+ON CONFLICT (simplified, traditional) DO NOTHING;
+
+-- Preferred Chinese script for display: 'simplified', 'traditional' or NULL (no preference)
+ALTER TABLE user_profiles
+    ADD COLUMN preferred_script VARCHAR(12),
+    ADD CONSTRAINT chk_user_profiles_preferred_script
+        CHECK (preferred_script IN ('simplified', 'traditional'));
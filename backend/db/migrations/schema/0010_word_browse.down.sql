@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_senses_pos_word;
+DROP INDEX IF EXISTS idx_senses_level_word;
+DROP INDEX IF EXISTS idx_wt_topic;
+DROP INDEX IF EXISTS idx_words_lang_freq;
@@ -0,0 +1,7 @@
+-- PostgreSQL Migration: Browse words by topic, level and part of speech
+-- Backs the paginated word listing filtered by language, topic, level and part of speech
+
+CREATE INDEX idx_words_lang_freq ON words(language_id, frequency_rank);
+CREATE INDEX idx_wt_topic ON word_topics(topic_id, word_id);
+CREATE INDEX idx_senses_level_word ON senses(level_id, word_id);
+CREATE INDEX idx_senses_pos_word ON senses(part_of_speech_id, word_id);
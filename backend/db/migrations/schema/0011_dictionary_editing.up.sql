@@ -0,0 +1,6 @@
+-- PostgreSQL Migration: Dictionary editing
+-- Users with the 'editor' or 'admin' role may create, update and delete dictionary content
+
+ALTER TABLE users
+    ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'learner', -- 'learner', 'editor' or 'admin'
+    ADD CONSTRAINT chk_users_role CHECK (role IN ('learner', 'editor', 'admin'));
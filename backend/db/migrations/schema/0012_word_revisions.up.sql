@@ -0,0 +1,23 @@
+-- PostgreSQL Migration: Word revision history
+-- Every change to a dictionary entry stores a JSON snapshot of the whole entry (word, topics,
+-- pronunciations, relations, senses with translations and examples) in the WordJSON seed format.
+-- word_id has no foreign key so the history of deleted words is kept and they can be restored.
+
+CREATE TABLE word_revisions (
+    id         BIGSERIAL PRIMARY KEY, -- revision row id
+    word_id    BIGINT NOT NULL, -- words.id the revision belongs to
+    revision   INTEGER NOT NULL, -- per-word revision number, starting at 1
+    action     VARCHAR(20) NOT NULL, -- 'create', 'update', 'delete', 'restore' or 'seed'
+    source     VARCHAR(20) NOT NULL, -- 'editor' or 'seeder'
+    user_id    BIGINT, -- FK -> users.id (editor who made the change; NULL for the seeder)
+    summary    TEXT, -- short description of the change
+    snapshot   JSONB NOT NULL, -- the entry after the change; before it for deletes
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP, -- time of the change
+    CONSTRAINT fk_word_revisions_user
+        FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT chk_word_revisions_action
+        CHECK (action IN ('create', 'update', 'delete', 'restore', 'seed')),
+    CONSTRAINT chk_word_revisions_source
+        CHECK (source IN ('editor', 'seeder')),
+    UNIQUE (word_id, revision)
+);
@@ -0,0 +1,41 @@
+-- PostgreSQL Migration: User-submitted correction proposals
+-- Any logged-in user can propose a change to a sense, a sense translation or an example. Proposals
+-- wait in a moderation queue; approving one applies the change through the editor path, so it is
+-- recorded in the word's revision history like any other edit.
+
+CREATE TABLE word_proposals (
+    id             BIGSERIAL PRIMARY KEY, -- proposal id
+    word_id        BIGINT NOT NULL, -- FK -> words.id (entry the proposal is about)
+    kind           VARCHAR(30) NOT NULL, -- proposed change, see chk_word_proposals_kind
+    sense_id       BIGINT, -- senses.id the change targets (not for sense_create)
+    example_id     BIGINT, -- examples.id for example_update and example_delete
+    target_word_id BIGINT, -- words.id of the translation for translation_delete
+    payload        JSONB, -- proposed content in the WordJSON seed format; NULL for deletes
+    comment        TEXT, -- why the user proposes the change
+    status         VARCHAR(20) NOT NULL DEFAULT 'pending', -- 'pending', 'approved', 'rejected' or 'merged'
+    user_id        BIGINT NOT NULL, -- FK -> users.id (user who proposed the change)
+    moderator_id   BIGINT, -- FK -> users.id (moderator who reviewed it)
+    reason         TEXT, -- moderator's reason, required for rejections
+    merged_into_id BIGINT, -- FK -> word_proposals.id (proposal a duplicate was merged into)
+    created_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP, -- submission time
+    reviewed_at    TIMESTAMP, -- time of the moderator's decision
+    CONSTRAINT fk_word_proposals_word
+        FOREIGN KEY (word_id) REFERENCES words(id) ON DELETE CASCADE,
+    CONSTRAINT fk_word_proposals_user
+        FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT fk_word_proposals_moderator
+        FOREIGN KEY (moderator_id) REFERENCES users(id),
+    CONSTRAINT fk_word_proposals_merged_into
+        FOREIGN KEY (merged_into_id) REFERENCES word_proposals(id) ON DELETE SET NULL,
+    CONSTRAINT chk_word_proposals_kind
+        CHECK (kind IN ('sense_create', 'sense_update', 'sense_delete',
+                        'translation_upsert', 'translation_delete',
+                        'example_create', 'example_update', 'example_delete')),
+    CONSTRAINT chk_word_proposals_status
+        CHECK (status IN ('pending', 'approved', 'rejected', 'merged'))
+);
+
+-- Moderation queue, oldest first
+CREATE INDEX idx_word_proposals_status ON word_proposals(status, created_at);
+-- Pending count on word detail
+CREATE INDEX idx_word_proposals_word_status ON word_proposals(word_id, status);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS seed_lines;
+DROP TABLE IF EXISTS seed_files;
@@ -0,0 +1,29 @@
+-- PostgreSQL Migration: Seed ledger
+-- The data seeder records every word file it applied and a hash of each entry (the lines of one
+-- lemma), so later runs only apply new or changed entries and can find entries removed from a file.
+
+CREATE TABLE seed_files (
+    id          BIGSERIAL PRIMARY KEY, -- seed file id
+    file_name   VARCHAR(255) NOT NULL UNIQUE, -- file name in the seed directory: '0002_word_en.jsonl'
+    language_id SMALLINT NOT NULL, -- FK -> languages.id (language of the file's head words)
+    checksum    CHAR(64) NOT NULL, -- SHA-256 of the whole file when it was last applied
+    line_count  INTEGER NOT NULL, -- number of non-empty lines
+    applied_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP, -- time of the last run that changed it
+    CONSTRAINT fk_seed_files_language
+        FOREIGN KEY (language_id) REFERENCES languages(id)
+);
+
+CREATE TABLE seed_lines (
+    seed_file_id BIGINT NOT NULL, -- FK -> seed_files.id
+    lemma        VARCHAR(255) NOT NULL, -- lemma of the entry
+    line_hash    CHAR(64) NOT NULL, -- SHA-256 of the entry's lines
+    line_number  INTEGER NOT NULL, -- first line of the entry in the file
+    word_id      BIGINT, -- FK -> words.id (word the entry was written to)
+    PRIMARY KEY (seed_file_id, lemma),
+    CONSTRAINT fk_seed_lines_file
+        FOREIGN KEY (seed_file_id) REFERENCES seed_files(id) ON DELETE CASCADE,
+    CONSTRAINT fk_seed_lines_word
+        FOREIGN KEY (word_id) REFERENCES words(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_seed_lines_word ON seed_lines(word_id);
@@ -0,0 +1,39 @@
+-- name: CountWordsByCharacterID :one
+SELECT COUNT(DISTINCT word_id)
+FROM word_characters
+WHERE character_id = $1;
+
+-- name: FindCharacterByLiteral :one
+-- Matches the literal itself first, then a character whose simplified or traditional form it is
+SELECT id, literal, simplified, traditional, script_code, strokes, radical, level_id
+FROM characters
+WHERE literal = $1 OR simplified = $1 OR traditional = $1
+ORDER BY (literal = $1) DESC, id
+LIMIT 1;
+
+-- name: FindCharacterReadingsByCharacterIDs :many
+SELECT id, character_id, language_id, reading, reading_type, note
+FROM character_readings
+WHERE character_id = ANY($1::bigint[])
+ORDER BY character_id, language_id, id;
+
+-- name: FindWordsByCharacterID :many
+SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
+       w.romanization, w.script_code, w.frequency_rank,
+       w.note, w.created_at, w.updated_at,
+       MIN(wc.char_order)::smallint AS char_order
+FROM word_characters wc
+JOIN words w ON w.id = wc.word_id
+WHERE wc.character_id = sqlc.arg('character_id')
+GROUP BY w.id
+ORDER BY w.frequency_rank NULLS LAST, length(w.lemma), w.id
+LIMIT sqlc.arg('limit');
+
+-- name: SearchCharacters :many
+SELECT c.id, c.literal, c.simplified, c.traditional, c.script_code, c.strokes, c.radical, c.level_id,
+       COUNT(*) OVER () AS total_count
+FROM characters c
+WHERE (sqlc.narg('radical')::text IS NULL OR c.radical = sqlc.narg('radical'))
+  AND (sqlc.narg('strokes')::smallint IS NULL OR c.strokes = sqlc.narg('strokes'))
+ORDER BY c.strokes NULLS LAST, c.level_id NULLS LAST, c.literal, c.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
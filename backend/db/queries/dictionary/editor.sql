@@ -0,0 +1,191 @@
+-- name: LockWord :one
+-- Locks a word for the rest of the transaction so concurrent edits of it are serialized
+SELECT w.id, w.language_id, l.code AS language_code, w.lemma
+FROM words w
+JOIN languages l ON l.id = w.language_id
+WHERE w.id = $1
+FOR UPDATE OF w;
+
+-- name: TouchWords :exec
+-- Bumps updated_at so cached details of these words are refreshed
+UPDATE words
+SET updated_at = CURRENT_TIMESTAMP
+WHERE id = ANY(sqlc.arg('word_ids')::bigint[]);
+
+-- name: UpdateWord :exec
+UPDATE words
+SET lemma            = $2,
+    lemma_normalized = $3,
+    search_key       = $4,
+    romanization     = $5,
+    script_code      = $6,
+    frequency_rank   = $7,
+    note             = $8,
+    updated_at       = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: FindWordsReferencingWord :many
+-- Words whose detail shows the given word as a translation or relation target
+SELECT s.word_id
+FROM sense_translations st
+JOIN senses s ON s.id = st.source_sense_id
+WHERE st.target_word_id = $1
+UNION
+SELECT wr.from_word_id
+FROM word_relations wr
+WHERE wr.to_word_id = $1;
+
+-- name: WordHasLearningHistory :one
+-- Reports whether game questions or word statistics point to the word or one of its senses
+SELECT (
+    EXISTS (SELECT 1 FROM user_word_statistics WHERE word_id = $1)
+    OR EXISTS (
+        SELECT 1
+        FROM vocab_game_questions
+        WHERE source_word_id = $1
+           OR correct_target_word_id = $1
+           OR source_sense_id IN (SELECT id FROM senses WHERE word_id = $1)
+    )
+    OR EXISTS (SELECT 1 FROM vocab_game_question_options WHERE target_word_id = $1)
+) AS exists;
+
+-- name: DeleteWordTopics :exec
+DELETE FROM word_topics
+WHERE word_id = $1;
+
+-- name: DeleteWordRelationsOfWord :exec
+DELETE FROM word_relations
+WHERE from_word_id = $1 OR to_word_id = $1;
+
+-- name: DeleteWordRelationsFromWord :exec
+DELETE FROM word_relations
+WHERE from_word_id = $1;
+
+-- name: DeleteSenseTranslationsToWord :exec
+DELETE FROM sense_translations
+WHERE target_word_id = $1;
+
+-- name: DeletePronunciationsOfWord :exec
+DELETE FROM pronunciations
+WHERE word_id = $1;
+
+-- name: DeleteWordCharactersOfWord :exec
+DELETE FROM word_characters
+WHERE word_id = $1;
+
+-- name: DeleteWord :execrows
+DELETE FROM words
+WHERE id = $1;
+
+-- name: FindSenseIDsByWordID :many
+SELECT id
+FROM senses
+WHERE word_id = $1
+ORDER BY sense_order;
+
+-- name: FindSenseWordID :one
+SELECT word_id
+FROM senses
+WHERE id = $1;
+
+-- name: ExistsSenseOrder :one
+-- Reports whether another sense of the word already uses the order
+SELECT EXISTS(
+    SELECT 1
+    FROM senses
+    WHERE word_id = $1
+      AND sense_order = $2
+      AND id <> sqlc.arg('exclude_sense_id')::bigint
+) AS exists;
+
+-- name: UpdateSense :exec
+UPDATE senses
+SET sense_order            = $2,
+    part_of_speech_id      = $3,
+    definition             = $4,
+    definition_language_id = $5,
+    usage_label            = $6,
+    level_id               = $7,
+    note                   = $8
+WHERE id = $1;
+
+-- name: DeleteExampleTranslationsOfSenses :exec
+DELETE FROM example_translations
+WHERE example_id IN (
+    SELECT id FROM examples WHERE source_sense_id = ANY(sqlc.arg('sense_ids')::bigint[])
+);
+
+-- name: DeleteExamplesOfSenses :exec
+DELETE FROM examples
+WHERE source_sense_id = ANY(sqlc.arg('sense_ids')::bigint[]);
+
+-- name: DeleteSenseTranslationsOfSenses :exec
+DELETE FROM sense_translations
+WHERE source_sense_id = ANY(sqlc.arg('sense_ids')::bigint[]);
+
+-- name: DeleteSenses :execrows
+DELETE FROM senses
+WHERE id = ANY(sqlc.arg('sense_ids')::bigint[]);
+
+-- name: DeleteSenseTranslation :execrows
+DELETE FROM sense_translations
+WHERE source_sense_id = $1 AND target_word_id = $2;
+
+-- name: FindExampleWordID :one
+SELECT s.word_id
+FROM examples e
+JOIN senses s ON s.id = e.source_sense_id
+WHERE e.id = $1;
+
+-- name: UpdateExample :exec
+UPDATE examples
+SET language_id = $2,
+    content     = $3,
+    audio_url   = $4
+WHERE id = $1;
+
+-- name: DeleteExampleTranslations :exec
+DELETE FROM example_translations
+WHERE example_id = $1;
+
+-- name: DeleteExample :execrows
+DELETE FROM examples
+WHERE id = $1;
+
+-- name: FindPronunciationWordID :one
+SELECT word_id
+FROM pronunciations
+WHERE id = $1;
+
+-- name: DeletePronunciation :execrows
+DELETE FROM pronunciations
+WHERE id = $1;
+
+-- name: DeleteWordRelation :execrows
+DELETE FROM word_relations
+WHERE from_word_id = $1 AND to_word_id = $2 AND relation_type = $3;
+
+-- name: CreateTopic :one
+INSERT INTO topics (code, name)
+VALUES ($1, $2)
+RETURNING id, code, name;
+
+-- name: UpdateTopic :execrows
+UPDATE topics
+SET code = $2,
+    name = $3
+WHERE id = $1;
+
+-- name: FindWordIDsByTopicID :many
+SELECT word_id
+FROM word_topics
+WHERE topic_id = $1
+ORDER BY word_id;
+
+-- name: DeleteWordTopicsOfTopic :exec
+DELETE FROM word_topics
+WHERE topic_id = $1;
+
+-- name: DeleteTopic :execrows
+DELETE FROM topics
+WHERE id = $1;
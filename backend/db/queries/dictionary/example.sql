@@ -0,0 +1,12 @@
+-- name: FindExamplesBySenseIDs :many
+SELECT id, source_sense_id, language_id, content, audio_url, source
+FROM examples
+WHERE source_sense_id = ANY($1::bigint[])
+ORDER BY source_sense_id, id;
+
+-- name: FindExampleTranslationsByExampleIDs :many
+SELECT et.example_id, l.code AS language_code, et.content
+FROM example_translations et
+JOIN languages l ON l.id = et.language_id
+WHERE et.example_id = ANY($1::bigint[])
+ORDER BY et.example_id, l.code;
@@ -0,0 +1,13 @@
+-- name: FindExportWordIDs :many
+-- One keyset page of the words selected for an export; filters are optional
+SELECT w.id
+FROM words w
+WHERE w.id > sqlc.arg('after_id')::bigint
+  AND (sqlc.narg('language_id')::smallint IS NULL OR w.language_id = sqlc.narg('language_id')::smallint)
+  AND (sqlc.narg('topic_id')::bigint IS NULL OR EXISTS (
+        SELECT 1 FROM word_topics wt WHERE wt.word_id = w.id AND wt.topic_id = sqlc.narg('topic_id')::bigint))
+  AND (sqlc.narg('level_id')::bigint IS NULL OR EXISTS (
+        SELECT 1 FROM senses s WHERE s.word_id = w.id AND s.level_id = sqlc.narg('level_id')::bigint))
+  AND (sqlc.narg('word_ids')::bigint[] IS NULL OR w.id = ANY(sqlc.narg('word_ids')::bigint[]))
+ORDER BY w.id
+LIMIT sqlc.arg('limit');
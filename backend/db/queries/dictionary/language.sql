@@ -13,3 +13,8 @@ SELECT id, code, name
 FROM languages 
 WHERE code = $1;
 
+-- name: FindLanguagesByIDs :many
+SELECT id, code, name
+FROM languages
+WHERE id = ANY($1::smallint[])
+ORDER BY id;
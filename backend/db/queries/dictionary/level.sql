@@ -30,3 +30,8 @@ WHERE
     )
 ORDER BY l.difficulty_order, l.code;
 
+-- name: FindLevelsByIDs :many
+SELECT id, code, name, description, language_id, difficulty_order
+FROM levels
+WHERE id = ANY($1::bigint[])
+ORDER BY id;
@@ -0,0 +1,65 @@
+-- name: CreateProposal :one
+INSERT INTO word_proposals (word_id, kind, sense_id, example_id, target_word_id, payload, comment, user_id)
+VALUES (sqlc.arg('word_id'), sqlc.arg('kind'), sqlc.narg('sense_id'), sqlc.narg('example_id'),
+        sqlc.narg('target_word_id'), sqlc.narg('payload'), sqlc.narg('comment'), sqlc.arg('user_id'))
+RETURNING id;
+
+-- name: GetProposal :one
+SELECT p.id, p.word_id, w.lemma, p.kind, p.sense_id, p.example_id, p.target_word_id, p.payload,
+       p.comment, p.status, p.user_id, u.username, p.moderator_id, p.reason, p.merged_into_id,
+       (SELECT COUNT(*) FROM word_proposals d WHERE d.merged_into_id = p.id) AS duplicates,
+       p.created_at, p.reviewed_at
+FROM word_proposals p
+JOIN words w ON w.id = p.word_id
+JOIN users u ON u.id = p.user_id
+WHERE p.id = $1;
+
+-- name: FindProposals :many
+-- Moderation queue, oldest first; status, word and user filters are optional
+SELECT p.id, p.word_id, w.lemma, p.kind, p.sense_id, p.example_id, p.target_word_id, p.payload,
+       p.comment, p.status, p.user_id, u.username, p.moderator_id, p.reason, p.merged_into_id,
+       (SELECT COUNT(*) FROM word_proposals d WHERE d.merged_into_id = p.id) AS duplicates,
+       p.created_at, p.reviewed_at
+FROM word_proposals p
+JOIN words w ON w.id = p.word_id
+JOIN users u ON u.id = p.user_id
+WHERE (sqlc.narg('status')::varchar IS NULL OR p.status = sqlc.narg('status')::varchar)
+  AND (sqlc.narg('word_id')::bigint IS NULL OR p.word_id = sqlc.narg('word_id')::bigint)
+  AND (sqlc.narg('user_id')::bigint IS NULL OR p.user_id = sqlc.narg('user_id')::bigint)
+ORDER BY p.created_at, p.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountProposals :one
+SELECT COUNT(*)
+FROM word_proposals p
+WHERE (sqlc.narg('status')::varchar IS NULL OR p.status = sqlc.narg('status')::varchar)
+  AND (sqlc.narg('word_id')::bigint IS NULL OR p.word_id = sqlc.narg('word_id')::bigint)
+  AND (sqlc.narg('user_id')::bigint IS NULL OR p.user_id = sqlc.narg('user_id')::bigint);
+
+-- name: LockProposal :one
+SELECT id, word_id, status
+FROM word_proposals
+WHERE id = $1
+FOR UPDATE;
+
+-- name: ReviewProposal :execrows
+-- Settles a pending proposal; affects no rows when it was already reviewed
+UPDATE word_proposals
+SET status = sqlc.arg('status'),
+    moderator_id = sqlc.arg('moderator_id'),
+    reason = sqlc.narg('reason'),
+    merged_into_id = sqlc.narg('merged_into_id'),
+    reviewed_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id') AND status = 'pending';
+
+-- name: ReopenProposal :exec
+-- Puts a proposal back in the queue when applying it failed
+UPDATE word_proposals
+SET status = 'pending', moderator_id = NULL, reason = NULL, merged_into_id = NULL, reviewed_at = NULL
+WHERE id = $1;
+
+-- name: MoveMergedProposals :exec
+-- Re-points the duplicates of a proposal that is itself merged into another
+UPDATE word_proposals
+SET merged_into_id = sqlc.arg('to_id')::bigint
+WHERE merged_into_id = sqlc.arg('from_id')::bigint;
@@ -0,0 +1,122 @@
+-- name: FindSnapshotWords :many
+-- Word fields of revision snapshots and exports
+SELECT w.id, l.code AS language_code, w.lemma, w.lemma_normalized, w.search_key,
+       w.romanization, w.script_code, w.frequency_rank, w.note
+FROM words w
+JOIN languages l ON l.id = w.language_id
+WHERE w.id = ANY(sqlc.arg('word_ids')::bigint[])
+ORDER BY w.id;
+
+-- name: FindSnapshotTopics :many
+SELECT wt.word_id, t.code
+FROM word_topics wt
+JOIN topics t ON t.id = wt.topic_id
+WHERE wt.word_id = ANY(sqlc.arg('word_ids')::bigint[])
+ORDER BY wt.word_id, t.code;
+
+-- name: FindSnapshotPronunciations :many
+SELECT word_id, COALESCE(dialect, '')::text AS dialect, ipa, phonetic, audio_url
+FROM pronunciations
+WHERE word_id = ANY(sqlc.arg('word_ids')::bigint[])
+ORDER BY word_id, dialect;
+
+-- name: FindSnapshotRelations :many
+SELECT wr.from_word_id, wr.relation_type, wr.note, tl.code AS language_code, tw.lemma, tw.romanization, tw.script_code
+FROM word_relations wr
+JOIN words tw ON tw.id = wr.to_word_id
+JOIN languages tl ON tl.id = tw.language_id
+WHERE wr.from_word_id = ANY(sqlc.arg('word_ids')::bigint[])
+ORDER BY wr.from_word_id, wr.relation_type, tl.code, tw.lemma;
+
+-- name: FindSnapshotSenses :many
+SELECT s.id, s.word_id, s.sense_order, pos.code AS part_of_speech_code, dl.code AS definition_language_code,
+       s.definition, s.usage_label, lv.code AS level_code, s.note
+FROM senses s
+JOIN parts_of_speech pos ON pos.id = s.part_of_speech_id
+JOIN languages dl ON dl.id = s.definition_language_id
+LEFT JOIN levels lv ON lv.id = s.level_id
+WHERE s.word_id = ANY(sqlc.arg('word_ids')::bigint[])
+ORDER BY s.word_id, s.sense_order;
+
+-- name: FindSnapshotSenseTranslations :many
+SELECT st.source_sense_id, st.priority, st.note, tl.code AS language_code, tw.lemma,
+       tw.romanization, tw.script_code
+FROM sense_translations st
+JOIN words tw ON tw.id = st.target_word_id
+JOIN languages tl ON tl.id = tw.language_id
+WHERE st.source_sense_id = ANY(sqlc.arg('sense_ids')::bigint[])
+ORDER BY st.source_sense_id, st.priority, tl.code, tw.lemma;
+
+-- name: FindSnapshotExamples :many
+SELECT e.id, e.source_sense_id, l.code AS language_code, e.content, e.audio_url
+FROM examples e
+JOIN languages l ON l.id = e.language_id
+WHERE e.source_sense_id = ANY(sqlc.arg('sense_ids')::bigint[])
+ORDER BY e.source_sense_id, e.id;
+
+-- name: FindSnapshotExampleTranslations :many
+SELECT et.example_id, l.code AS language_code, et.content
+FROM example_translations et
+JOIN languages l ON l.id = et.language_id
+WHERE et.example_id = ANY(sqlc.arg('example_ids')::bigint[])
+ORDER BY et.example_id, l.code;
+
+-- name: LatestWordRevisionMatches :one
+-- Reports whether the latest revision of the word already holds the snapshot
+SELECT EXISTS(
+    SELECT 1
+    FROM word_revisions
+    WHERE word_id = $1
+      AND snapshot = sqlc.arg('snapshot')::jsonb
+      AND revision = (SELECT MAX(r.revision) FROM word_revisions r WHERE r.word_id = $1)
+) AS matches;
+
+-- name: CreateWordRevision :one
+-- Appends a revision; callers hold the word lock so revision numbers do not collide
+INSERT INTO word_revisions (word_id, revision, action, source, user_id, summary, snapshot)
+SELECT sqlc.arg('word_id')::bigint, COALESCE(MAX(r.revision), 0) + 1, sqlc.arg('action')::varchar,
+       sqlc.arg('source')::varchar, sqlc.narg('user_id')::bigint, sqlc.narg('summary')::text, sqlc.arg('snapshot')::jsonb
+FROM word_revisions r
+WHERE r.word_id = sqlc.arg('word_id')::bigint
+RETURNING revision;
+
+-- name: CreateWordRevisions :execrows
+-- Appends a revision to each word whose latest revision does not already hold its snapshot;
+-- callers hold the word locks so revision numbers do not collide
+INSERT INTO word_revisions (word_id, revision, action, source, user_id, summary, snapshot)
+SELECT i.word_id, COALESCE(latest.revision, 0) + 1, sqlc.arg('action')::varchar, sqlc.arg('source')::varchar,
+       sqlc.narg('user_id')::bigint, sqlc.narg('summary')::text, i.snapshot
+FROM unnest(sqlc.arg('word_ids')::bigint[], sqlc.arg('snapshots')::jsonb[]) AS i(word_id, snapshot)
+LEFT JOIN LATERAL (
+    SELECT r.revision, r.snapshot
+    FROM word_revisions r
+    WHERE r.word_id = i.word_id
+    ORDER BY r.revision DESC
+    LIMIT 1
+) latest ON true
+WHERE latest.snapshot IS DISTINCT FROM i.snapshot;
+
+-- name: MoveWordRevisions :exec
+-- Carries the history of a deleted word over to the word restored from it
+UPDATE word_revisions
+SET word_id = sqlc.arg('to_word_id')
+WHERE word_id = sqlc.arg('from_word_id');
+
+-- name: FindWordRevisions :many
+SELECT r.id, r.word_id, r.revision, r.action, r.source, r.user_id, u.username, r.summary, r.created_at
+FROM word_revisions r
+LEFT JOIN users u ON u.id = r.user_id
+WHERE r.word_id = $1
+ORDER BY r.revision DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountWordRevisions :one
+SELECT COUNT(*)
+FROM word_revisions
+WHERE word_id = $1;
+
+-- name: GetWordRevision :one
+SELECT r.id, r.word_id, r.revision, r.action, r.source, r.user_id, u.username, r.summary, r.created_at, r.snapshot
+FROM word_revisions r
+LEFT JOIN users u ON u.id = r.user_id
+WHERE r.word_id = $1 AND r.revision = $2;
@@ -0,0 +1,11 @@
+-- name: FindAllScriptConversions :many
+-- Imported pairs come first so they win when a character has several counterparts
+SELECT simplified, traditional, source
+FROM script_conversions
+ORDER BY (source = 'file') DESC, simplified, traditional;
+
+-- name: GetScriptConversionsVersion :one
+-- Cheap fingerprint of the conversion table; the in-memory converter reloads when it changes
+SELECT COUNT(*)::bigint AS pair_count,
+       COALESCE(MAX(updated_at), 'epoch'::timestamp)::timestamp AS last_updated_at
+FROM script_conversions;
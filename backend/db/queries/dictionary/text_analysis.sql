@@ -0,0 +1,42 @@
+-- name: FindAnalyzerWords :many
+-- Words of a language whose lemma_normalized is one of the forms cut from an analyzed text.
+-- Each word comes with the easiest level among its senses and the gloss the browse list shows:
+-- the first sense's definition and that sense's first translation, optionally restricted to
+-- translation_language_id.
+SELECT w.id, w.lemma, w.lemma_normalized, w.romanization, w.frequency_rank,
+       lv.id AS level_id,
+       lv.code AS level_code,
+       lv.name AS level_name,
+       lv.difficulty_order AS level_order,
+       fs.id AS gloss_sense_id,
+       fs.definition AS gloss_definition,
+       ft.id AS gloss_translation_word_id,
+       ft.lemma AS gloss_translation
+FROM words w
+LEFT JOIN LATERAL (
+    SELECT l.id, l.code, l.name, l.difficulty_order
+    FROM senses s
+    INNER JOIN levels l ON l.id = s.level_id
+    WHERE s.word_id = w.id
+    ORDER BY l.difficulty_order NULLS LAST, l.id
+    LIMIT 1
+) lv ON TRUE
+LEFT JOIN LATERAL (
+    SELECT s.id, s.definition
+    FROM senses s
+    WHERE s.word_id = w.id
+    ORDER BY s.sense_order, s.id
+    LIMIT 1
+) fs ON TRUE
+LEFT JOIN LATERAL (
+    SELECT tw.id, tw.lemma
+    FROM sense_translations st
+    INNER JOIN words tw ON tw.id = st.target_word_id
+    WHERE st.source_sense_id = fs.id
+      AND (sqlc.narg('translation_language_id')::smallint IS NULL OR tw.language_id = sqlc.narg('translation_language_id'))
+    ORDER BY st.priority NULLS LAST, st.id
+    LIMIT 1
+) ft ON TRUE
+WHERE w.language_id = sqlc.arg('language_id')
+  AND w.lemma_normalized = ANY(sqlc.arg('forms')::text[])
+ORDER BY w.frequency_rank NULLS LAST, w.id;
@@ -0,0 +1,97 @@
+-- name: SearchTextFullText :many
+-- Full-text search over definitions, examples and example translations written in language_id.
+-- Snippets are highlighted with ts_headline on the requested page only.
+WITH q AS (
+  SELECT websearch_to_tsquery(sqlc.arg('config')::regconfig, sqlc.arg('query')) AS query
+),
+hits AS (
+  SELECT 'definition'::text AS source, s.word_id, s.id AS sense_id, s.sense_order,
+         NULL::bigint AS example_id, s.definition AS content,
+         ts_rank(to_tsvector(sqlc.arg('config')::regconfig, s.definition), q.query) AS rank
+  FROM senses s
+  CROSS JOIN q
+  WHERE sqlc.arg('include_definitions')::boolean
+    AND s.definition_language_id = sqlc.arg('language_id')
+    AND to_tsvector(sqlc.arg('config')::regconfig, s.definition) @@ q.query
+  UNION ALL
+  SELECT 'example'::text, s.word_id, s.id, s.sense_order,
+         e.id, e.content,
+         ts_rank(to_tsvector(sqlc.arg('config')::regconfig, e.content), q.query)
+  FROM examples e
+  JOIN senses s ON s.id = e.source_sense_id
+  CROSS JOIN q
+  WHERE sqlc.arg('include_examples')::boolean
+    AND e.language_id = sqlc.arg('language_id')
+    AND to_tsvector(sqlc.arg('config')::regconfig, e.content) @@ q.query
+  UNION ALL
+  SELECT 'example_translation'::text, s.word_id, s.id, s.sense_order,
+         e.id, et.content,
+         ts_rank(to_tsvector(sqlc.arg('config')::regconfig, et.content), q.query)
+  FROM example_translations et
+  JOIN examples e ON e.id = et.example_id
+  JOIN senses s ON s.id = e.source_sense_id
+  CROSS JOIN q
+  WHERE sqlc.arg('include_translations')::boolean
+    AND et.language_id = sqlc.arg('language_id')
+    AND to_tsvector(sqlc.arg('config')::regconfig, et.content) @@ q.query
+),
+page AS (
+  SELECT h.source, h.word_id, h.sense_id, h.sense_order, h.example_id, h.content, h.rank,
+         COUNT(*) OVER () AS total_count
+  FROM hits h
+  ORDER BY h.rank DESC, h.word_id, h.sense_order, h.example_id NULLS FIRST
+  LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset')
+)
+SELECT p.source, p.word_id, w.language_id AS word_language_id, w.lemma, w.romanization,
+       p.sense_id, p.sense_order, p.example_id, p.rank::real AS rank,
+       ts_headline(sqlc.arg('config')::regconfig, p.content, q.query, sqlc.arg('headline_options')::text) AS snippet,
+       p.total_count
+FROM page p
+JOIN words w ON w.id = p.word_id
+CROSS JOIN q
+ORDER BY p.rank DESC, p.word_id, p.sense_order, p.example_id NULLS FIRST;
+
+-- name: SearchTextSubstring :many
+-- Substring search for languages without word boundaries (zh, ja, ko), backed by trigram indexes.
+-- Returns the full content; the caller cuts and highlights the snippet.
+WITH hits AS (
+  SELECT 'definition'::text AS source, s.word_id, s.id AS sense_id, s.sense_order,
+         NULL::bigint AS example_id, s.definition AS content,
+         similarity(lower(s.definition), sqlc.arg('query')) AS rank
+  FROM senses s
+  WHERE sqlc.arg('include_definitions')::boolean
+    AND s.definition_language_id = sqlc.arg('language_id')
+    AND lower(s.definition) LIKE '%' || sqlc.arg('like_query') || '%'
+  UNION ALL
+  SELECT 'example'::text, s.word_id, s.id, s.sense_order,
+         e.id, e.content,
+         similarity(lower(e.content), sqlc.arg('query'))
+  FROM examples e
+  JOIN senses s ON s.id = e.source_sense_id
+  WHERE sqlc.arg('include_examples')::boolean
+    AND e.language_id = sqlc.arg('language_id')
+    AND lower(e.content) LIKE '%' || sqlc.arg('like_query') || '%'
+  UNION ALL
+  SELECT 'example_translation'::text, s.word_id, s.id, s.sense_order,
+         e.id, et.content,
+         similarity(lower(et.content), sqlc.arg('query'))
+  FROM example_translations et
+  JOIN examples e ON e.id = et.example_id
+  JOIN senses s ON s.id = e.source_sense_id
+  WHERE sqlc.arg('include_translations')::boolean
+    AND et.language_id = sqlc.arg('language_id')
+    AND lower(et.content) LIKE '%' || sqlc.arg('like_query') || '%'
+),
+page AS (
+  SELECT h.source, h.word_id, h.sense_id, h.sense_order, h.example_id, h.content, h.rank,
+         COUNT(*) OVER () AS total_count
+  FROM hits h
+  ORDER BY h.rank DESC, h.word_id, h.sense_order, h.example_id NULLS FIRST
+  LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset')
+)
+SELECT p.source, p.word_id, w.language_id AS word_language_id, w.lemma, w.romanization,
+       p.sense_id, p.sense_order, p.example_id, p.rank::real AS rank,
+       p.content, p.total_count
+FROM page p
+JOIN words w ON w.id = p.word_id
+ORDER BY p.rank DESC, p.word_id, p.sense_order, p.example_id NULLS FIRST;
@@ -80,6 +80,43 @@ WHERE s.level_id = sqlc.arg('level_id')
 ORDER BY w.frequency_rank NULLS LAST, w.id
 LIMIT sqlc.arg('limit');
 
+-- name: FindReverseTranslations :many
+-- Finds source senses whose translations include the queried word. Only the best tier of
+-- query matches is used: exact lemma matches win over diacritic/tone-insensitive ones.
+WITH candidates AS (
+  SELECT tw.id, tw.lemma,
+         CASE WHEN lower(tw.lemma) = sqlc.arg('query') THEN 1 ELSE 2 END AS match_rank
+  FROM words tw
+  WHERE tw.language_id = sqlc.arg('target_language_id')
+    AND (
+      lower(tw.lemma) = sqlc.arg('query')
+      OR lower(tw.lemma_normalized) = sqlc.arg('folded')
+      OR lower(tw.search_key) = sqlc.arg('folded')
+      OR lower(tw.search_key) = sqlc.arg('numbered')
+      OR (sqlc.arg('toneless')::text <> ''
+          AND translate(lower(tw.search_key), 'ü012345 ', 'v') = sqlc.arg('toneless'))
+    )
+),
+targets AS (
+  SELECT c.id, c.lemma
+  FROM candidates c
+  WHERE c.match_rank = (SELECT MIN(match_rank) FROM candidates)
+)
+SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
+       w.romanization, w.script_code, w.frequency_rank,
+       w.note, w.created_at, w.updated_at,
+       s.id AS sense_id, s.sense_order, s.part_of_speech_id, s.definition,
+       s.definition_language_id, s.usage_label, s.level_id, s.note AS sense_note,
+       st.priority, t.id AS target_word_id, t.lemma AS target_lemma
+FROM targets t
+JOIN sense_translations st ON st.target_word_id = t.id
+JOIN senses s ON s.id = st.source_sense_id
+JOIN words w ON w.id = s.word_id
+WHERE w.language_id <> sqlc.arg('target_language_id')
+  AND (sqlc.arg('source_language_id')::smallint = 0 OR w.language_id = sqlc.arg('source_language_id'))
+ORDER BY w.language_id, st.priority NULLS LAST, w.frequency_rank NULLS LAST, w.id, s.sense_order
+LIMIT sqlc.arg('limit');
+
 -- name: FindTranslationsForWord :many
 WITH ranked AS (
   SELECT
@@ -103,35 +140,76 @@ ORDER BY r.ord_priority ASC NULLS LAST,
 LIMIT sqlc.arg('limit');
 
 -- name: SearchWords :many
-SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
-       w.romanization, w.script_code, w.frequency_rank,
-       w.note, w.created_at, w.updated_at
-FROM words w
-WHERE w.language_id = sqlc.arg('language_id')
-  AND (
-    w.lemma ILIKE sqlc.arg('search_pattern')
-    OR w.lemma_normalized ILIKE sqlc.arg('search_pattern')
-    OR w.search_key ILIKE sqlc.arg('search_pattern')
-  )
-ORDER BY 
-  CASE 
-    WHEN w.lemma = sqlc.arg('exact_match') THEN 1
-    WHEN w.lemma ILIKE sqlc.arg('search_pattern') THEN 2
-    WHEN w.lemma_normalized ILIKE sqlc.arg('search_pattern') THEN 3
-    WHEN w.search_key ILIKE sqlc.arg('search_pattern') THEN 4
-    ELSE 5
-  END,
-  w.frequency_rank NULLS LAST,
-  w.id
+-- match_rank: 1 exact, 2 prefix, 3 normalized (lemma_normalized/search_key), 4 substring, 5 fuzzy (trigram)
+-- query is the lowercased input; folded drops diacritics, numbered converts pinyin tone marks
+-- to tone numbers and toneless drops tones, spaces and apostrophes altogether
+WITH matches AS (
+  SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
+         w.romanization, w.script_code, w.frequency_rank,
+         w.note, w.created_at, w.updated_at,
+         CASE
+           WHEN lower(w.lemma) = sqlc.arg('query') THEN 1
+           WHEN lower(w.lemma) LIKE sqlc.arg('like_query') || '%' THEN 2
+           WHEN lower(w.lemma_normalized) LIKE sqlc.arg('like_folded') || '%'
+             OR lower(w.search_key) LIKE sqlc.arg('like_folded') || '%'
+             OR lower(w.search_key) LIKE sqlc.arg('like_numbered') || '%'
+             OR (sqlc.arg('like_toneless')::text <> ''
+                 AND translate(lower(w.search_key), 'ü012345 ', 'v') LIKE sqlc.arg('like_toneless') || '%') THEN 3
+           WHEN lower(w.lemma) LIKE '%' || sqlc.arg('like_query') || '%'
+             OR lower(w.lemma_normalized) LIKE '%' || sqlc.arg('like_folded') || '%'
+             OR lower(w.search_key) LIKE '%' || sqlc.arg('like_folded') || '%'
+             OR lower(w.search_key) LIKE '%' || sqlc.arg('like_numbered') || '%'
+             OR (sqlc.arg('like_toneless')::text <> ''
+                 AND translate(lower(w.search_key), 'ü012345 ', 'v') LIKE '%' || sqlc.arg('like_toneless') || '%') THEN 4
+           ELSE 5
+         END::int AS match_rank,
+         GREATEST(
+           similarity(lower(w.lemma), sqlc.arg('query')),
+           similarity(lower(w.lemma_normalized), sqlc.arg('folded')),
+           similarity(lower(w.search_key), sqlc.arg('numbered'))
+         )::real AS score
+  FROM words w
+  WHERE w.language_id = sqlc.arg('language_id')
+    AND (
+      lower(w.lemma) LIKE '%' || sqlc.arg('like_query') || '%'
+      OR lower(w.lemma_normalized) LIKE '%' || sqlc.arg('like_folded') || '%'
+      OR lower(w.search_key) LIKE '%' || sqlc.arg('like_folded') || '%'
+      OR lower(w.search_key) LIKE '%' || sqlc.arg('like_numbered') || '%'
+      OR (sqlc.arg('like_toneless')::text <> ''
+          AND translate(lower(w.search_key), 'ü012345 ', 'v') LIKE '%' || sqlc.arg('like_toneless') || '%')
+      OR lower(w.lemma) % sqlc.arg('query')
+      OR lower(w.lemma_normalized) % sqlc.arg('folded')
+      OR lower(w.search_key) % sqlc.arg('numbered')
+    )
+)
+SELECT m.id, m.language_id, m.lemma, m.lemma_normalized, m.search_key,
+       m.romanization, m.script_code, m.frequency_rank,
+       m.note, m.created_at, m.updated_at,
+       m.match_rank, m.score,
+       COUNT(*) OVER () AS total_count
+FROM matches m
+ORDER BY m.match_rank, m.score DESC, m.frequency_rank NULLS LAST, m.id
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: CountSearchWords :one
-SELECT COUNT(DISTINCT w.id)
+SELECT COUNT(*)
 FROM words w
 WHERE w.language_id = sqlc.arg('language_id')
   AND (
-    w.lemma ILIKE sqlc.arg('search_pattern')
-    OR w.lemma_normalized ILIKE sqlc.arg('search_pattern')
-    OR w.search_key ILIKE sqlc.arg('search_pattern')
+    lower(w.lemma) LIKE '%' || sqlc.arg('like_query') || '%'
+    OR lower(w.lemma_normalized) LIKE '%' || sqlc.arg('like_folded') || '%'
+    OR lower(w.search_key) LIKE '%' || sqlc.arg('like_folded') || '%'
+    OR lower(w.search_key) LIKE '%' || sqlc.arg('like_numbered') || '%'
+    OR (sqlc.arg('like_toneless')::text <> ''
+        AND translate(lower(w.search_key), 'ü012345 ', 'v') LIKE '%' || sqlc.arg('like_toneless') || '%')
+    OR lower(w.lemma) % sqlc.arg('query')
+    OR lower(w.lemma_normalized) % sqlc.arg('folded')
+    OR lower(w.search_key) % sqlc.arg('numbered')
   );
 
+-- name: FindWordsUpdatedSince :many
+-- Words changed at or after the given time; cached word details are evicted from these
+SELECT id, updated_at
+FROM words
+WHERE updated_at >= $1
+ORDER BY updated_at, id;
@@ -0,0 +1,75 @@
+-- name: CountBrowseWords :one
+SELECT COUNT(*)
+FROM words w
+WHERE (sqlc.narg('language_id')::smallint IS NULL OR w.language_id = sqlc.narg('language_id'))
+  AND (sqlc.narg('topic_id')::bigint IS NULL OR EXISTS (
+      SELECT 1 FROM word_topics wt WHERE wt.word_id = w.id AND wt.topic_id = sqlc.narg('topic_id')
+  ))
+  AND (sqlc.narg('level_id')::bigint IS NULL OR EXISTS (
+      SELECT 1 FROM senses s WHERE s.word_id = w.id AND s.level_id = sqlc.narg('level_id')
+  ))
+  AND (sqlc.narg('pos')::text IS NULL OR EXISTS (
+      SELECT 1
+      FROM senses s
+      INNER JOIN parts_of_speech p ON p.id = s.part_of_speech_id
+      WHERE s.word_id = w.id AND p.code = sqlc.narg('pos')
+  ));
+
+-- name: BrowseWords :many
+-- Filters are optional; level and part of speech match any sense of the word.
+-- The page is cut before the gloss is joined: the first sense's definition and that sense's
+-- first translation, optionally restricted to translation_language_id.
+SELECT p.id, p.language_id, p.lemma, p.lemma_normalized, p.search_key,
+       p.romanization, p.script_code, p.frequency_rank,
+       p.note, p.created_at, p.updated_at,
+       fs.id AS gloss_sense_id,
+       fs.definition AS gloss_definition,
+       ft.id AS gloss_translation_word_id,
+       ft.lemma AS gloss_translation,
+       p.total_count
+FROM (
+    SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
+           w.romanization, w.script_code, w.frequency_rank,
+           w.note, w.created_at, w.updated_at,
+           ROW_NUMBER() OVER (
+               ORDER BY
+                   CASE WHEN sqlc.arg('sort')::text = 'alpha' THEN COALESCE(w.lemma_normalized, w.lemma) END,
+                   w.frequency_rank NULLS LAST,
+                   w.lemma,
+                   w.id
+           ) AS position,
+           COUNT(*) OVER () AS total_count
+    FROM words w
+    WHERE (sqlc.narg('language_id')::smallint IS NULL OR w.language_id = sqlc.narg('language_id'))
+      AND (sqlc.narg('topic_id')::bigint IS NULL OR EXISTS (
+          SELECT 1 FROM word_topics wt WHERE wt.word_id = w.id AND wt.topic_id = sqlc.narg('topic_id')
+      ))
+      AND (sqlc.narg('level_id')::bigint IS NULL OR EXISTS (
+          SELECT 1 FROM senses s WHERE s.word_id = w.id AND s.level_id = sqlc.narg('level_id')
+      ))
+      AND (sqlc.narg('pos')::text IS NULL OR EXISTS (
+          SELECT 1
+          FROM senses s
+          INNER JOIN parts_of_speech ps ON ps.id = s.part_of_speech_id
+          WHERE s.word_id = w.id AND ps.code = sqlc.narg('pos')
+      ))
+    ORDER BY position
+    LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset')
+) p
+LEFT JOIN LATERAL (
+    SELECT s.id, s.definition
+    FROM senses s
+    WHERE s.word_id = p.id
+    ORDER BY s.sense_order, s.id
+    LIMIT 1
+) fs ON TRUE
+LEFT JOIN LATERAL (
+    SELECT tw.id, tw.lemma
+    FROM sense_translations st
+    INNER JOIN words tw ON tw.id = st.target_word_id
+    WHERE st.source_sense_id = fs.id
+      AND (sqlc.narg('translation_language_id')::smallint IS NULL OR tw.language_id = sqlc.narg('translation_language_id'))
+    ORDER BY st.priority NULLS LAST, st.id
+    LIMIT 1
+) ft ON TRUE
+ORDER BY p.position;
@@ -0,0 +1,39 @@
+-- name: FindRelationEdgesByWordIDs :many
+-- Relations touching any of the words, in either direction
+SELECT from_word_id, to_word_id, relation_type, note
+FROM word_relations
+WHERE (from_word_id = ANY(sqlc.arg('word_ids')::bigint[]) OR to_word_id = ANY(sqlc.arg('word_ids')::bigint[]))
+  AND relation_type = ANY(sqlc.arg('relation_types')::text[])
+ORDER BY from_word_id, to_word_id, relation_type;
+
+-- name: FindTranslationEdgesByWordIDs :many
+-- Collapses sense translations into word-to-word edges touching any of the words, in either direction
+SELECT s.word_id AS source_word_id,
+       st.target_word_id,
+       COALESCE(MIN(st.priority), 1)::smallint AS priority
+FROM sense_translations st
+JOIN senses s ON s.id = st.source_sense_id
+WHERE s.word_id = ANY($1::bigint[]) OR st.target_word_id = ANY($1::bigint[])
+GROUP BY s.word_id, st.target_word_id
+ORDER BY s.word_id, st.target_word_id;
+
+-- name: FindSharedCharacterEdgesByWordIDs :many
+-- Pairs each word with up to neighbor_limit other words per shared character, most frequent first
+SELECT DISTINCT wc.word_id,
+       other.word_id AS other_word_id,
+       c.id AS character_id,
+       c.literal
+FROM word_characters wc
+JOIN characters c ON c.id = wc.character_id
+CROSS JOIN LATERAL (
+    SELECT o.word_id
+    FROM word_characters o
+    JOIN words w ON w.id = o.word_id
+    WHERE o.character_id = wc.character_id
+      AND o.word_id <> wc.word_id
+    GROUP BY o.word_id, w.frequency_rank
+    ORDER BY w.frequency_rank NULLS LAST, o.word_id
+    LIMIT sqlc.arg('neighbor_limit')
+) other
+WHERE wc.word_id = ANY(sqlc.arg('word_ids')::bigint[])
+ORDER BY wc.word_id, c.id, other.word_id;
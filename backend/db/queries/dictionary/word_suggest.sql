@@ -0,0 +1,38 @@
+-- name: FindWordSuggestEntries :many
+-- Loads the fields the in-memory suggest index is built from
+SELECT id, language_id, lemma, lemma_normalized, search_key, romanization, frequency_rank
+FROM words
+ORDER BY id;
+
+-- name: GetWordsVersion :one
+-- Cheap fingerprint of the words table; the suggest index rebuilds when it changes
+SELECT COUNT(*)::bigint AS word_count,
+       COALESCE(MAX(updated_at), 'epoch'::timestamp)::timestamp AS last_updated_at
+FROM words;
+
+-- name: SuggestWords :many
+-- Database fallback for suggestions while the in-memory index is not ready.
+-- match_rank: 1 lemma, 2 search_key/lemma_normalized, 3 romanization
+SELECT w.id, w.language_id, w.lemma, w.romanization, w.frequency_rank,
+       CASE
+         WHEN lower(w.lemma) LIKE sqlc.arg('like_query') || '%' THEN 1
+         WHEN lower(w.lemma_normalized) LIKE sqlc.arg('like_folded') || '%'
+           OR lower(w.search_key) LIKE sqlc.arg('like_folded') || '%'
+           OR lower(w.search_key) LIKE sqlc.arg('like_numbered') || '%'
+           OR (sqlc.arg('like_toneless')::text <> ''
+               AND translate(lower(w.search_key), 'ü012345 ', 'v') LIKE sqlc.arg('like_toneless') || '%') THEN 2
+         ELSE 3
+       END::int AS match_rank
+FROM words w
+WHERE w.language_id = sqlc.arg('language_id')
+  AND (
+    lower(w.lemma) LIKE sqlc.arg('like_query') || '%'
+    OR lower(w.lemma_normalized) LIKE sqlc.arg('like_folded') || '%'
+    OR lower(w.search_key) LIKE sqlc.arg('like_folded') || '%'
+    OR lower(w.search_key) LIKE sqlc.arg('like_numbered') || '%'
+    OR (sqlc.arg('like_toneless')::text <> ''
+        AND translate(lower(w.search_key), 'ü012345 ', 'v') LIKE sqlc.arg('like_toneless') || '%')
+    OR lower(w.romanization) LIKE sqlc.arg('like_query') || '%'
+  )
+ORDER BY match_rank, w.frequency_rank NULLS LAST, length(w.lemma), w.id
+LIMIT sqlc.arg('limit');
@@ -0,0 +1,28 @@
+-- name: FindGameSessionExportRows :many
+SELECT s.id AS session_id, s.mode, s.source_language_id, s.target_language_id,
+       s.level_id,
+       ARRAY(
+           SELECT st.topic_id FROM vocab_game_session_topics st
+           WHERE st.session_id = s.id ORDER BY st.topic_id
+       )::bigint[] AS topic_ids,
+       s.total_questions, s.correct_questions,
+       s.started_at, s.ended_at, s.flagged_at,
+       q.id AS question_id, q.question_order, q.question_type,
+       q.source_word_id, sw.lemma AS source_word,
+       q.correct_target_word_id, cw.lemma AS correct_word,
+       o.option_label AS selected_option_label,
+       o.target_word_id AS selected_word_id, ow.lemma AS selected_word,
+       a.is_correct, a.response_time_ms, a.answered_at
+FROM vocab_game_sessions s
+JOIN vocab_game_questions q ON q.session_id = s.id
+JOIN words sw ON sw.id = q.source_word_id
+JOIN words cw ON cw.id = q.correct_target_word_id
+LEFT JOIN vocab_game_question_answers a ON a.question_id = q.id AND a.user_id = s.user_id
+LEFT JOIN vocab_game_question_options o ON o.id = a.selected_option_id
+LEFT JOIN words ow ON ow.id = o.target_word_id
+WHERE s.user_id = sqlc.arg('user_id')
+  AND (sqlc.narg('started_from')::timestamp IS NULL OR s.started_at >= sqlc.narg('started_from'))
+  AND (sqlc.narg('started_to')::timestamp IS NULL OR s.started_at < sqlc.narg('started_to'))
+  AND (s.id, q.question_order) > (sqlc.arg('after_session_id')::bigint, sqlc.arg('after_question_order')::smallint)
+ORDER BY s.id, q.question_order
+LIMIT sqlc.arg('limit');
@@ -0,0 +1,11 @@
+-- name: CreateGameSessionFlag :one
+INSERT INTO vocab_game_session_flags (
+    session_id, question_id, reason, detail, created_at
+) VALUES ($1, $2, $3, $4, $5)
+RETURNING id, created_at;
+
+-- name: FindGameSessionFlagsBySessionID :many
+SELECT id, session_id, question_id, reason, detail, created_at
+FROM vocab_game_session_flags
+WHERE session_id = $1
+ORDER BY created_at, id;
@@ -15,7 +15,7 @@ RETURNING id;
 -- name: FindGameQuestionsBySessionID :many
 SELECT id, session_id, question_order, question_type,
        source_word_id, source_sense_id, correct_target_word_id,
-       source_language_id, target_language_id, created_at
+       source_language_id, target_language_id, created_at, served_at
 FROM vocab_game_questions
 WHERE session_id = $1
 ORDER BY question_order;
@@ -23,7 +23,7 @@ ORDER BY question_order;
 -- name: FindGameQuestionByID :one
 SELECT id, session_id, question_order, question_type,
        source_word_id, source_sense_id, correct_target_word_id,
-       source_language_id, target_language_id, created_at
+       source_language_id, target_language_id, created_at, served_at
 FROM vocab_game_questions
 WHERE id = $1;
 
@@ -39,3 +39,8 @@ FROM vocab_game_question_options
 WHERE question_id = ANY($1::bigint[])
 ORDER BY question_id, option_label;
 
+
+-- name: MarkGameQuestionsServed :exec
+UPDATE vocab_game_questions
+SET served_at = $2
+WHERE session_id = $1 AND served_at IS NULL;
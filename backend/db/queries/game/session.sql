@@ -1,15 +1,14 @@
 -- name: CreateGameSession :one
 INSERT INTO vocab_game_sessions (
     user_id, mode, source_language_id, target_language_id,
-    topic_id, level_id, total_questions, correct_questions,
-    started_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    level_id, total_questions, correct_questions, started_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING id, started_at;
 
 -- name: FindGameSessionByID :one
 SELECT id, user_id, mode, source_language_id, target_language_id,
-       topic_id, level_id, total_questions, correct_questions,
-       started_at, ended_at
+       level_id, total_questions, correct_questions,
+       started_at, ended_at, flagged_at
 FROM vocab_game_sessions
 WHERE id = $1;
 
@@ -27,8 +26,8 @@ WHERE id = $1;
 
 -- name: FindGameSessionsByUserID :many
 SELECT id, user_id, mode, source_language_id, target_language_id,
-       topic_id, level_id, total_questions, correct_questions,
-       started_at, ended_at
+       level_id, total_questions, correct_questions,
+       started_at, ended_at, flagged_at
 FROM vocab_game_sessions
 WHERE user_id = sqlc.arg('user_id')
 ORDER BY started_at DESC
@@ -39,3 +38,8 @@ SELECT COUNT(*)
 FROM vocab_game_sessions
 WHERE user_id = sqlc.arg('user_id');
 
+
+-- name: FlagGameSession :exec
+UPDATE vocab_game_sessions
+SET flagged_at = $2
+WHERE id = $1 AND flagged_at IS NULL;
@@ -0,0 +1,10 @@
+-- name: CreateGameSessionTopics :exec
+INSERT INTO vocab_game_session_topics (session_id, topic_id)
+SELECT sqlc.arg('session_id')::bigint, unnest(sqlc.arg('topic_ids')::bigint[])
+ON CONFLICT DO NOTHING;
+
+-- name: FindGameSessionTopicsBySessionIDs :many
+SELECT session_id, topic_id
+FROM vocab_game_session_topics
+WHERE session_id = ANY(sqlc.arg('session_ids')::bigint[])
+ORDER BY session_id, topic_id;
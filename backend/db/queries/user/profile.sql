@@ -1,10 +1,10 @@
 -- name: CreateUserProfile :one
 INSERT INTO user_profiles (user_id, display_name, avatar_url, birth_day, bio)
 VALUES ($1, $2, $3, $4, $5)
-RETURNING user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at;
+RETURNING user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at, preferred_script;
 
 -- name: GetUserProfile :one
-SELECT user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at
+SELECT user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at, preferred_script
 FROM user_profiles
 WHERE user_id = $1;
 
@@ -14,6 +14,7 @@ SET display_name = COALESCE($2, display_name),
     avatar_url = COALESCE($3, avatar_url),
     birth_day = COALESCE($4, birth_day),
     bio = COALESCE($5, bio),
+    preferred_script = COALESCE($6, preferred_script),
     updated_at = CURRENT_TIMESTAMP
 WHERE user_id = $1
-RETURNING user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at;
+RETURNING user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at, preferred_script;
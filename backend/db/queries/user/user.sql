@@ -1,20 +1,20 @@
 -- name: CreateUser :one
 INSERT INTO users (email, username, password_hash, is_active)
 VALUES ($1, $2, $3, $4)
-RETURNING id, email, username, password_hash, created_at, updated_at, is_active;
+RETURNING id, email, username, password_hash, created_at, updated_at, is_active, role;
 
 -- name: FindUserByID :one
-SELECT id, email, username, password_hash, created_at, updated_at, is_active
+SELECT id, email, username, password_hash, created_at, updated_at, is_active, role
 FROM users
 WHERE id = $1;
 
 -- name: FindUserByEmail :one
-SELECT id, email, username, password_hash, created_at, updated_at, is_active
+SELECT id, email, username, password_hash, created_at, updated_at, is_active, role
 FROM users
 WHERE email = $1;
 
 -- name: FindUserByUsername :one
-SELECT id, email, username, password_hash, created_at, updated_at, is_active
+SELECT id, email, username, password_hash, created_at, updated_at, is_active, role
 FROM users
 WHERE username = $1;
 
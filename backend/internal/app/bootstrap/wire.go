@@ -111,7 +111,7 @@ func registerRoutes(httpServer *HTTPServer, container *di.Container) {
 	{
 		// Register module routes
 		useradapter.RegisterRoutes(apiV1, container.UserHandler, container.AuthMiddleware)
-		dictadapter.RegisterRoutes(apiV1, container.DictionaryHandler)
-		vocabgameadapter.RegisterRoutes(apiV1, container.VocabGameHandler, container.AuthMiddleware)
+		dictadapter.RegisterRoutes(apiV1, container.DictionaryHandler, container.AuthMiddleware, container.OptionalAuthMiddleware, container.EditorMiddleware)
+		vocabgameadapter.RegisterRoutes(apiV1, container.VocabGameHandler, container.AuthMiddleware, container.AdminMiddleware)
 	}
 }
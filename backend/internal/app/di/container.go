@@ -6,8 +6,22 @@ import (
 	config "github.com/english-coach/backend/configs"
 	dictadapter "github.com/english-coach/backend/internal/modules/dictionary/adapter/http"
 	dictrepo "github.com/english-coach/backend/internal/modules/dictionary/infra/persistence/postgres"
+	dictanalyze "github.com/english-coach/backend/internal/modules/dictionary/usecase/analyze_text"
+	dictbrowse "github.com/english-coach/backend/internal/modules/dictionary/usecase/browse_words"
+	dictconvert "github.com/english-coach/backend/internal/modules/dictionary/usecase/convert_script"
+	dictproposals "github.com/english-coach/backend/internal/modules/dictionary/usecase/correction_proposals"
+	dictedit "github.com/english-coach/backend/internal/modules/dictionary/usecase/edit_dictionary"
+	dictexport "github.com/english-coach/backend/internal/modules/dictionary/usecase/export_words"
+	dictcharacter "github.com/english-coach/backend/internal/modules/dictionary/usecase/get_character"
 	dictusecase "github.com/english-coach/backend/internal/modules/dictionary/usecase/get_word_detail"
+	dictgraph "github.com/english-coach/backend/internal/modules/dictionary/usecase/get_word_graph"
+	dictreverse "github.com/english-coach/backend/internal/modules/dictionary/usecase/reverse_lookup"
+	dictsearchchars "github.com/english-coach/backend/internal/modules/dictionary/usecase/search_characters"
+	dictsearchtext "github.com/english-coach/backend/internal/modules/dictionary/usecase/search_text"
+	dictsuggest "github.com/english-coach/backend/internal/modules/dictionary/usecase/suggest_words"
+	dictrevisions "github.com/english-coach/backend/internal/modules/dictionary/usecase/word_revisions"
 	useradapter "github.com/english-coach/backend/internal/modules/user/adapter/http"
+	userdomain "github.com/english-coach/backend/internal/modules/user/domain"
 	userrepo "github.com/english-coach/backend/internal/modules/user/infra/persistence/postgres"
 	usergetprofile "github.com/english-coach/backend/internal/modules/user/usecase/get_profile"
 	userlogin "github.com/english-coach/backend/internal/modules/user/usecase/login"
@@ -16,6 +30,8 @@ import (
 	vocabgameadapter "github.com/english-coach/backend/internal/modules/vocabgame/adapter/http"
 	gamerepo "github.com/english-coach/backend/internal/modules/vocabgame/infra/persistence/postgres"
 	gamecreatesession "github.com/english-coach/backend/internal/modules/vocabgame/usecase/create_session"
+	gameexportsessions "github.com/english-coach/backend/internal/modules/vocabgame/usecase/export_sessions"
+	gamegetsessionreview "github.com/english-coach/backend/internal/modules/vocabgame/usecase/get_session_review"
 	gamesubmitanswer "github.com/english-coach/backend/internal/modules/vocabgame/usecase/submit_answer"
 	"github.com/english-coach/backend/internal/platform/db"
 	"github.com/english-coach/backend/internal/shared/auth"
@@ -41,10 +57,30 @@ type Container struct {
 	GameRepo       *gamerepo.GameRepository
 	UserRepo       *userrepo.UserRepository
 
+	// Background workers
+	QuestionPool    *gamecreatesession.QuestionPool
+	SuggestIndex    *dictsuggest.PrefixIndex
+	ScriptConverter *dictconvert.Converter
+	WordDetailCache *dictusecase.LRUCache
+
 	// Use Cases
 	GetWordDetailUC     *dictusecase.Handler
+	SuggestWordsUC      *dictsuggest.Handler
+	ReverseLookupUC     *dictreverse.Handler
+	SearchTextUC        *dictsearchtext.Handler
+	AnalyzeTextUC       *dictanalyze.Handler
+	GetCharacterUC      *dictcharacter.Handler
+	SearchCharactersUC  *dictsearchchars.Handler
+	GetWordGraphUC      *dictgraph.Handler
+	BrowseWordsUC       *dictbrowse.Handler
+	EditDictionaryUC    *dictedit.Handler
+	WordRevisionsUC     *dictrevisions.Handler
+	ProposalsUC         *dictproposals.Handler
+	ExportWordsUC       *dictexport.Handler
 	CreateGameSessionUC *gamecreatesession.Handler
 	SubmitAnswerUC      *gamesubmitanswer.Handler
+	GetSessionReviewUC  *gamegetsessionreview.Handler
+	ExportSessionsUC    *gameexportsessions.Handler
 	RegisterUC          *userregister.Handler
 	LoginUC             *userlogin.Handler
 	GetProfileUC        *usergetprofile.Handler
@@ -57,10 +93,13 @@ type Container struct {
 	OpenAPIHandler    *handler.OpenAPIHandler
 
 	// Middleware
-	CORSMiddleware   gin.HandlerFunc
-	ErrorMiddleware  gin.HandlerFunc
-	LoggerMiddleware gin.HandlerFunc
-	AuthMiddleware   gin.HandlerFunc
+	CORSMiddleware         gin.HandlerFunc
+	ErrorMiddleware        gin.HandlerFunc
+	LoggerMiddleware       gin.HandlerFunc
+	AuthMiddleware         gin.HandlerFunc
+	OptionalAuthMiddleware gin.HandlerFunc
+	EditorMiddleware       gin.HandlerFunc
+	AdminMiddleware        gin.HandlerFunc
 }
 
 // NewContainer creates a new dependency injection container
@@ -109,6 +148,28 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 	container.GameRepo = gamerepo.NewGameRepository(pool)
 	container.UserRepo = userrepo.NewUserRepository(pool)
 
+	// Simplified/traditional conversion is shared by search normalization, the suggest index and word detail
+	container.ScriptConverter = dictconvert.NewConverter(
+		container.DictionaryRepo.ScriptConversionRepository(),
+		dictconvert.ConverterConfig{
+			RefreshInterval: cfg.Dictionary.ScriptConversion.RefreshInterval,
+		},
+		appLogger,
+	)
+	container.DictionaryRepo.SetScriptConverter(container.ScriptConverter)
+
+	// Assembled word details are cached and evicted as words.updated_at moves
+	container.WordDetailCache = dictusecase.NewLRUCache(
+		dictusecase.CacheConfig{
+			Enabled:         cfg.Dictionary.WordDetailCache.Enabled,
+			Capacity:        cfg.Dictionary.WordDetailCache.Capacity,
+			TTL:             cfg.Dictionary.WordDetailCache.TTL,
+			RefreshInterval: cfg.Dictionary.WordDetailCache.RefreshInterval,
+		},
+		appLogger,
+	)
+	container.WordDetailCache.Start(container.DictionaryRepo.WordRepository())
+
 	// Initialize use cases
 	container.GetWordDetailUC = dictusecase.NewHandler(
 		container.DictionaryRepo.WordRepository(),
@@ -116,21 +177,147 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 		container.DictionaryRepo.LanguageRepository(),
 		container.DictionaryRepo.LevelRepository(),
 		container.DictionaryRepo.PartOfSpeechRepository(),
+		container.ScriptConverter,
+		container.WordDetailCache,
 		pool,
 		appLogger,
 	)
 
+	container.SuggestIndex = dictsuggest.NewPrefixIndex(
+		dictsuggest.IndexConfig{
+			Enabled:         cfg.Dictionary.SuggestIndex.Enabled,
+			RefreshInterval: cfg.Dictionary.SuggestIndex.RefreshInterval,
+		},
+		container.ScriptConverter,
+		appLogger,
+	)
+
+	// Rebuild suggestions and drop cached script forms when the conversion table changes,
+	// then load it before the index's first build
+	container.ScriptConverter.OnReload(container.SuggestIndex.Invalidate)
+	container.ScriptConverter.OnReload(container.WordDetailCache.Purge)
+	container.ScriptConverter.Start()
+
+	container.SuggestWordsUC = dictsuggest.NewHandler(
+		container.DictionaryRepo.WordRepository(),
+		container.SuggestIndex,
+		appLogger,
+	)
+	container.SuggestWordsUC.StartIndex()
+
+	container.ReverseLookupUC = dictreverse.NewHandler(
+		container.DictionaryRepo.WordRepository(),
+		container.DictionaryRepo.LanguageRepository(),
+		appLogger,
+	)
+
+	container.SearchTextUC = dictsearchtext.NewHandler(
+		container.DictionaryRepo.TextSearchRepository(),
+		container.DictionaryRepo.LanguageRepository(),
+		appLogger,
+	)
+
+	container.AnalyzeTextUC = dictanalyze.NewHandler(
+		container.DictionaryRepo.TextAnalysisRepository(),
+		container.DictionaryRepo.LanguageRepository(),
+		container.ScriptConverter,
+		appLogger,
+	)
+
+	container.GetCharacterUC = dictcharacter.NewHandler(
+		container.DictionaryRepo.CharacterRepository(),
+		container.DictionaryRepo.LanguageRepository(),
+		container.DictionaryRepo.LevelRepository(),
+		appLogger,
+	)
+
+	container.SearchCharactersUC = dictsearchchars.NewHandler(
+		container.DictionaryRepo.CharacterRepository(),
+		appLogger,
+	)
+
+	container.GetWordGraphUC = dictgraph.NewHandler(
+		container.DictionaryRepo.WordRepository(),
+		container.DictionaryRepo.WordGraphRepository(),
+		appLogger,
+	)
+
+	container.BrowseWordsUC = dictbrowse.NewHandler(
+		container.DictionaryRepo.WordRepository(),
+		container.DictionaryRepo.TopicRepository(),
+		container.DictionaryRepo.LevelRepository(),
+		appLogger,
+	)
+
+	container.EditDictionaryUC = dictedit.NewHandler(
+		container.DictionaryRepo.EditorRepository(),
+		container.WordDetailCache,
+		container.SuggestIndex,
+		appLogger,
+	)
+
+	container.WordRevisionsUC = dictrevisions.NewHandler(
+		container.DictionaryRepo.WordRevisionRepository(),
+		appLogger,
+	)
+
+	// Approved correction proposals are applied through the editor use case
+	container.ProposalsUC = dictproposals.NewHandler(
+		container.DictionaryRepo.ProposalRepository(),
+		container.EditDictionaryUC,
+		container.WordDetailCache,
+		appLogger,
+	)
+
+	container.ExportWordsUC = dictexport.NewHandler(
+		container.DictionaryRepo.WordExportRepository(),
+		container.DictionaryRepo.LanguageRepository(),
+		container.DictionaryRepo.LevelRepository(),
+		container.DictionaryRepo.TopicRepository(),
+		appLogger,
+	)
+
+	poolCfg := cfg.VocabGame.QuestionPool
+	container.QuestionPool = gamecreatesession.NewQuestionPool(
+		gamecreatesession.PoolConfig{
+			Enabled:        poolCfg.Enabled,
+			Size:           poolCfg.Size,
+			RefillInterval: poolCfg.RefillInterval,
+			RefillBatch:    poolCfg.RefillBatch,
+			MaxKeys:        poolCfg.MaxKeys,
+		},
+		appLogger,
+	)
+
 	container.CreateGameSessionUC = gamecreatesession.NewHandler(
 		container.GameRepo.GameSessionRepository(),
 		container.GameRepo.GameQuestionRepository(),
 		container.DictionaryRepo.WordRepository(),
+		container.QuestionPool,
 		appLogger,
 	)
+	container.CreateGameSessionUC.StartQuestionPool()
 
 	container.SubmitAnswerUC = gamesubmitanswer.NewHandler(
 		container.GameRepo.GameAnswerRepository(),
 		container.GameRepo.GameQuestionRepository(),
 		container.GameRepo.GameSessionRepository(),
+		container.GameRepo.GameSessionFlagRepository(),
+		appLogger,
+	)
+
+	container.GetSessionReviewUC = gamegetsessionreview.NewHandler(
+		container.GameRepo.GameSessionRepository(),
+		container.GameRepo.GameQuestionRepository(),
+		container.GameRepo.GameAnswerRepository(),
+		container.DictionaryRepo.WordRepository(),
+		container.DictionaryRepo.SenseRepository(),
+		container.DictionaryRepo.ExampleRepository(),
+		appLogger,
+	)
+
+	container.ExportSessionsUC = gameexportsessions.NewHandler(
+		container.GameRepo.GameSessionExportRepository(),
 		appLogger,
 	)
 
@@ -158,12 +345,27 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 		container.DictionaryRepo.LevelRepository(),
 		container.DictionaryRepo.WordRepository(),
 		container.GetWordDetailUC,
+		container.SuggestWordsUC,
+		container.ReverseLookupUC,
+		container.SearchTextUC,
+		container.GetCharacterUC,
+		container.SearchCharactersUC,
+		container.GetWordGraphUC,
+		container.BrowseWordsUC,
+		container.EditDictionaryUC,
+		container.WordRevisionsUC,
+		container.ProposalsUC,
+		container.ExportWordsUC,
+		container.AnalyzeTextUC,
+		container.UserRepo.UserProfileRepository(),
 		appLogger,
 	)
 
 	container.VocabGameHandler = vocabgameadapter.NewHandler(
 		container.CreateGameSessionUC,
 		container.SubmitAnswerUC,
+		container.GetSessionReviewUC,
+		container.ExportSessionsUC,
 		container.GameRepo.GameQuestionRepository(),
 		container.GameRepo.GameSessionRepository(),
 		container.DictionaryRepo.WordRepository(),
@@ -189,12 +391,27 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 	container.ErrorMiddleware = middleware.ErrorHandler(appLogger)
 	container.LoggerMiddleware = middleware.LoggerMiddleware(appLogger)
 	container.AuthMiddleware = middleware.AuthMiddleware(container.JWTManager)
+	container.OptionalAuthMiddleware = middleware.OptionalAuthMiddleware(container.JWTManager)
+	container.EditorMiddleware = middleware.RequireRole(userdomain.RoleEditor, userdomain.RoleAdmin)
+	container.AdminMiddleware = middleware.RequireRole(userdomain.RoleAdmin)
 
 	return container, nil
 }
 
 // Close closes all resources in the container
 func (c *Container) Close() error {
+	if c.QuestionPool != nil {
+		c.QuestionPool.Stop()
+	}
+	if c.SuggestIndex != nil {
+		c.SuggestIndex.Stop()
+	}
+	if c.WordDetailCache != nil {
+		c.WordDetailCache.Stop()
+	}
+	if c.ScriptConverter != nil {
+		c.ScriptConverter.Stop()
+	}
 	if c.DB != nil {
 		c.DB.Close()
 	}
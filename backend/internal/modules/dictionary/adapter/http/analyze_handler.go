@@ -0,0 +1,80 @@
+package http
+
+import (
+	"net/http"
+
+	dictanalyze "github.com/english-coach/backend/internal/modules/dictionary/usecase/analyze_text"
+	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/english-coach/backend/internal/shared/response"
+	"github.com/english-coach/backend/internal/transport/http/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// AnalyzeText handles POST /api/v1/dictionary/analyze
+// It cuts a pasted text into words of its language and annotates each known word with its level,
+// translation and word ID; known words are also grouped by level and unknown tokens listed apart
+func (h *Handler) AnalyzeText(c *gin.Context) {
+	var req AnalyzeTextRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	// Get request logger from context (includes request ID)
+	requestLogger, _ := c.Get("logger")
+	var appLogger logger.ILogger
+	if reqLogger, ok := requestLogger.(logger.ILogger); ok {
+		appLogger = reqLogger
+	} else {
+		appLogger = h.logger
+	}
+
+	output, err := h.analyzeTextUC.Execute(c.Request.Context(), dictanalyze.AnalyzeTextInput{
+		Text:                  req.Text,
+		LanguageID:            req.LanguageID,
+		TranslationLanguageID: req.TranslationLanguageID,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	resp := &AnalyzeTextResponse{
+		TokenCount: len(output.Tokens),
+		Tokens:     make([]*AnalyzedTokenResponse, 0, len(output.Tokens)),
+		Levels:     make([]*AnalyzedLevelGroupResponse, 0, len(output.Levels)),
+		Unknown:    make([]*UnknownTokenResponse, 0, len(output.Unknown)),
+	}
+	for _, token := range output.Tokens {
+		tokenResp := &AnalyzedTokenResponse{Text: token.Text, Start: token.Start, End: token.End}
+		if word := token.Word; word != nil {
+			resp.KnownCount++
+			tokenResp.WordID = &word.WordID
+			if word.Level != nil {
+				tokenResp.Level = &word.Level.Code
+			}
+			if word.Gloss != nil {
+				tokenResp.Translation = word.Gloss.Translation
+			}
+		}
+		resp.Tokens = append(resp.Tokens, tokenResp)
+	}
+	for _, group := range output.Levels {
+		words := make([]*AnalyzedWordResponse, 0, len(group.Words))
+		for _, occurrence := range group.Words {
+			words = append(words, &AnalyzedWordResponse{Word: occurrence.Word, Count: occurrence.Count})
+		}
+		resp.Levels = append(resp.Levels, &AnalyzedLevelGroupResponse{Level: group.Level, Words: words})
+	}
+	for _, unknown := range output.Unknown {
+		resp.Unknown = append(resp.Unknown, &UnknownTokenResponse{Text: unknown.Text, Count: unknown.Count})
+	}
+
+	appLogger.Info("text analysis completed",
+		logger.Int("language_id", int(req.LanguageID)),
+		logger.Int("tokens_count", resp.TokenCount),
+		logger.Int("known_count", resp.KnownCount),
+		logger.Int("unknown_count", len(resp.Unknown)),
+	)
+
+	response.Success(c, http.StatusOK, resp)
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/english-coach/backend/internal/modules/dictionary/domain"
@@ -55,6 +56,26 @@ func mapWordsToResponse(words []*domain.Word) []*WordResponse {
 	return result
 }
 
+// SearchWordResponse represents a search result: the word plus how it matched
+type SearchWordResponse struct {
+	WordResponse
+	MatchType string  `json:"match_type"` // exact, prefix, normalized, substring or fuzzy
+	Score     float64 `json:"score"`      // similarity to the query, 0..1
+}
+
+// mapSearchResultsToResponse maps search results to SearchWordResponse
+func mapSearchResultsToResponse(results []*domain.WordSearchResult) []*SearchWordResponse {
+	responses := make([]*SearchWordResponse, 0, len(results))
+	for _, result := range results {
+		responses = append(responses, &SearchWordResponse{
+			WordResponse: *mapWordToResponse(result.Word),
+			MatchType:    result.MatchType,
+			Score:        result.Score,
+		})
+	}
+	return responses
+}
+
 // SearchWordsRequest represents the query parameters for word search
 type SearchWordsRequest struct {
 	Query      string `form:"q" binding:"required"`
@@ -65,6 +86,66 @@ type SearchWordsRequest struct {
 	Offset     int    `form:"offset"`
 }
 
+// WordBrowseItemResponse represents a word in a word listing with its gloss
+type WordBrowseItemResponse struct {
+	WordResponse
+	Gloss *domain.WordGloss `json:"gloss,omitempty"` // first sense and its first translation
+}
+
+// SuggestWordsResponse represents autocomplete suggestions for a query
+type SuggestWordsResponse struct {
+	Suggestions []*domain.WordSuggestion `json:"suggestions"`
+	Source      string                   `json:"source"` // index or database
+}
+
+// ReverseTranslationResponse represents a source sense reached through one of its translations
+type ReverseTranslationResponse struct {
+	SourceWord       *WordResponse `json:"source_word"`
+	Sense            *domain.Sense `json:"sense"`
+	Priority         *int16        `json:"priority,omitempty"`
+	TranslatedWordID int64         `json:"translated_word_id"`
+	TranslatedLemma  string        `json:"translated_lemma"`
+}
+
+// ReverseLookupGroupResponse groups reverse translations by source language
+type ReverseLookupGroupResponse struct {
+	Language *domain.Language              `json:"language"`
+	Results  []*ReverseTranslationResponse `json:"results"`
+}
+
+// ReverseLookupResponse represents the HTTP response for a reverse translation lookup
+type ReverseLookupResponse struct {
+	Query  string                        `json:"query"`
+	Groups []*ReverseLookupGroupResponse `json:"groups"`
+}
+
+// CharacterReadingGroupResponse groups a character's readings by language
+type CharacterReadingGroupResponse struct {
+	Language *domain.Language           `json:"language"`
+	Readings []*domain.CharacterReading `json:"readings"`
+}
+
+// CharacterWordResponse represents a word containing a character
+type CharacterWordResponse struct {
+	Word      *WordResponse `json:"word"`
+	CharOrder int16         `json:"char_order"`
+}
+
+// GetCharacterResponse represents the HTTP response for a character detail
+type GetCharacterResponse struct {
+	Character  *domain.Character                `json:"character"`
+	Level      *domain.Level                    `json:"level,omitempty"`
+	Readings   []*CharacterReadingGroupResponse `json:"readings"`
+	Words      []*CharacterWordResponse         `json:"words"`
+	WordsTotal int                              `json:"words_total"`
+}
+
+// CharacterSearchResultResponse represents a character matched by radical or stroke count
+type CharacterSearchResultResponse struct {
+	*domain.Character
+	Readings []*domain.CharacterReading `json:"readings"`
+}
+
 // GetLevelsRequest represents the query parameters for getting levels
 type GetLevelsRequest struct {
 	LanguageID *int16 `form:"languageId"`
@@ -84,23 +165,170 @@ type WordRelationResponse struct {
 
 // SenseDetailResponse represents detailed information about a sense for HTTP response.
 type SenseDetailResponse struct {
-	ID                   int64           `json:"id"`
-	SenseOrder           int16           `json:"sense_order"`
-	PartOfSpeechID       int16           `json:"part_of_speech_id"`
-	PartOfSpeechName     *string         `json:"part_of_speech_name,omitempty"`
-	Definition           string          `json:"definition"`
-	DefinitionLanguageID int16           `json:"definition_language_id"`
-	LevelID              *int64          `json:"level_id,omitempty"`
-	LevelName            *string         `json:"level_name,omitempty"`
-	Note                 *string         `json:"note,omitempty"`
-	Translations         []*WordResponse `json:"translations,omitempty"`
-	Examples             interface{}     `json:"examples,omitempty"`
+	ID                     int64           `json:"id"`
+	SenseOrder             int16           `json:"sense_order"`
+	PartOfSpeechID         int16           `json:"part_of_speech_id"`
+	PartOfSpeechName       *string         `json:"part_of_speech_name,omitempty"`
+	Definition             string          `json:"definition"`
+	DefinitionLanguageID   int16           `json:"definition_language_id"`
+	DefinitionLanguageName *string         `json:"definition_language_name,omitempty"`
+	LevelID                *int64          `json:"level_id,omitempty"`
+	LevelName              *string         `json:"level_name,omitempty"`
+	Note                   *string         `json:"note,omitempty"`
+	Translations           []*WordResponse `json:"translations,omitempty"`
+	Examples               interface{}     `json:"examples,omitempty"`
 }
 
 // GetWordDetailResponse represents the HTTP response for getting word detail.
 type GetWordDetailResponse struct {
-	Word           *WordResponse           `json:"word"`
-	Senses         []SenseDetailResponse   `json:"senses"`
-	Pronunciations interface{}             `json:"pronunciations"`
-	Relations      []*WordRelationResponse `json:"relations,omitempty"`
+	Word             *WordResponse           `json:"word"`
+	Senses           []SenseDetailResponse   `json:"senses"`
+	Pronunciations   interface{}             `json:"pronunciations"`
+	Relations        []*WordRelationResponse `json:"relations,omitempty"`
+	ScriptForms      *domain.ScriptForms     `json:"script_forms,omitempty"`  // Chinese words only
+	DisplayLemma     *string                 `json:"display_lemma,omitempty"` // lemma in the requested script
+	PendingProposals int                     `json:"pending_proposals"`       // correction proposals awaiting moderation
+}
+
+// WordGraphNodeResponse is a word in the word graph. Nodes and edges use flat id/source/target fields
+// so the payload can be handed to graph libraries (d3-force, Cytoscape, vis-network) with little mapping.
+type WordGraphNodeResponse struct {
+	ID            int64   `json:"id"`
+	Label         string  `json:"label"`
+	LanguageID    int16   `json:"language_id"`
+	Romanization  *string `json:"romanization,omitempty"`
+	FrequencyRank *int    `json:"frequency_rank,omitempty"`
+	Depth         int     `json:"depth"` // hops from the root word
+}
+
+// WordGraphEdgeResponse is an edge in the word graph
+type WordGraphEdgeResponse struct {
+	ID          string  `json:"id"`
+	Source      int64   `json:"source"`
+	Target      int64   `json:"target"`
+	Type        string  `json:"type"` // synonym, antonym, related, translation or shared_character
+	Note        *string `json:"note,omitempty"`
+	Priority    *int16  `json:"priority,omitempty"`
+	CharacterID *int64  `json:"character_id,omitempty"`
+	Character   *string `json:"character,omitempty"`
+}
+
+// WordGraphResponse represents the HTTP response for a word graph
+type WordGraphResponse struct {
+	RootID    int64                    `json:"root_id"`
+	Depth     int                      `json:"depth"`
+	Nodes     []*WordGraphNodeResponse `json:"nodes"`
+	Edges     []*WordGraphEdgeResponse `json:"edges"`
+	Truncated bool                     `json:"truncated"` // the node cap was hit
+}
+
+// Editor requests. Words, senses, translations, examples, pronunciations and relations are created
+// from the same JSON documents the seeder reads (domain.WordJSON and its parts).
+
+// UpdateWordRequest represents the body for updating a word
+type UpdateWordRequest struct {
+	Lemma         string   `json:"lemma" binding:"required"`
+	Romanization  *string  `json:"romanization,omitempty"`
+	ScriptCode    *string  `json:"script_code,omitempty"`
+	FrequencyRank *int     `json:"frequency_rank,omitempty"`
+	Note          *string  `json:"note,omitempty"`
+	Topics        []string `json:"topics"` // replaces the word's topics when present
+}
+
+// UpdateSenseRequest represents the body for updating a sense
+type UpdateSenseRequest struct {
+	Order              int     `json:"order" binding:"required"`
+	PartOfSpeech       string  `json:"part_of_speech" binding:"required"`
+	DefinitionLanguage string  `json:"definition_language" binding:"required"`
+	Definition         string  `json:"definition" binding:"required"`
+	UsageLabel         *string `json:"usage_label,omitempty"`
+	Level              *string `json:"level,omitempty"`
+	Note               *string `json:"note,omitempty"`
+}
+
+// TopicRequest represents the body for creating or updating a topic
+type TopicRequest struct {
+	Code string `json:"code" binding:"required"`
+	Name string `json:"name" binding:"required"`
+}
+
+// EditResponse identifies the entry written by an editor request and the word it belongs to
+type EditResponse struct {
+	ID     int64 `json:"id"`
+	WordID int64 `json:"word_id"`
+}
+
+// SubmitProposalRequest represents a correction proposed by a user. Payload is a sense, a sense
+// translation or an example in the WordJSON seed format depending on kind; deletes take none.
+type SubmitProposalRequest struct {
+	Kind         string          `json:"kind" binding:"required"`
+	SenseID      *int64          `json:"sense_id,omitempty"`
+	ExampleID    *int64          `json:"example_id,omitempty"`
+	TargetWordID *int64          `json:"target_word_id,omitempty"`
+	Payload      json.RawMessage `json:"payload,omitempty"`
+	Comment      *string         `json:"comment,omitempty"`
+}
+
+// RejectProposalRequest carries the moderator's reason for rejecting a proposal
+type RejectProposalRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// MergeProposalRequest names the proposal a duplicate is merged into
+type MergeProposalRequest struct {
+	IntoID int64 `json:"into_id" binding:"required"`
+}
+
+// ExportWordsRequest represents the query parameters for exporting dictionary entries
+type ExportWordsRequest struct {
+	Format   string `form:"format"`   // jsonl (default), csv or apkg
+	Language string `form:"language"` // language code
+	Level    string `form:"level"`    // level code, matches words with any sense at the level
+	Topic    string `form:"topic"`    // topic code
+	WordIDs  string `form:"wordIds"`  // comma-separated word IDs, e.g. a saved word list
+}
+
+// AnalyzeTextRequest represents a text whose vocabulary is annotated
+type AnalyzeTextRequest struct {
+	Text                  string `json:"text" binding:"required"`
+	LanguageID            int16  `json:"language_id" binding:"required"`
+	TranslationLanguageID *int16 `json:"translation_language_id,omitempty"`
+}
+
+// AnalyzedTokenResponse is a word of an analyzed text, located by character offsets (end exclusive).
+// The word fields are absent for unknown tokens.
+type AnalyzedTokenResponse struct {
+	Text        string  `json:"text"`
+	Start       int     `json:"start"`
+	End         int     `json:"end"`
+	WordID      *int64  `json:"word_id,omitempty"`
+	Level       *string `json:"level,omitempty"` // level code
+	Translation *string `json:"translation,omitempty"`
+}
+
+// AnalyzedWordResponse is a known word of an analyzed text with the number of times it appears
+type AnalyzedWordResponse struct {
+	Word  *domain.AnalyzedWord `json:"word"`
+	Count int                  `json:"count"`
+}
+
+// AnalyzedLevelGroupResponse groups the known words of a text at one level (null for words without one)
+type AnalyzedLevelGroupResponse struct {
+	Level *domain.Level           `json:"level"`
+	Words []*AnalyzedWordResponse `json:"words"`
+}
+
+// UnknownTokenResponse is a token not found in the dictionary with the number of times it appears
+type UnknownTokenResponse struct {
+	Text  string `json:"text"`
+	Count int    `json:"count"`
+}
+
+// AnalyzeTextResponse represents the HTTP response for a text analysis
+type AnalyzeTextResponse struct {
+	TokenCount int                           `json:"token_count"`
+	KnownCount int                           `json:"known_count"`
+	Tokens     []*AnalyzedTokenResponse      `json:"tokens"`
+	Levels     []*AnalyzedLevelGroupResponse `json:"levels"`
+	Unknown    []*UnknownTokenResponse       `json:"unknown"`
 }
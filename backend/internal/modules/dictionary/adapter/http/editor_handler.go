@@ -0,0 +1,480 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	dictedit "github.com/english-coach/backend/internal/modules/dictionary/usecase/edit_dictionary"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/response"
+	"github.com/english-coach/backend/internal/transport/http/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// idParam parses a positive int64 path parameter, setting an invalid parameter error when it is not one
+func idParam(c *gin.Context, name string) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param(name), 10, 64)
+	if err != nil || id <= 0 {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid "+name))
+		return 0, false
+	}
+	return id, true
+}
+
+// bindJSON binds the request body, setting an invalid request error when it does not match
+func bindJSON(c *gin.Context, req any) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidRequest.WithDetails(err.Error()))
+		return false
+	}
+	return true
+}
+
+// editorID returns the ID of the authenticated editor, setting an unauthorized error when missing
+func editorID(c *gin.Context) (int64, bool) {
+	userID, exists := c.Get("user_id")
+	id, ok := userID.(int64)
+	if !exists || !ok {
+		middleware.SetError(c, sharederrors.ErrUnauthorized)
+		return 0, false
+	}
+	return id, true
+}
+
+// editResponse maps an editor use case result to EditResponse
+func editResponse(output *dictedit.EditOutput) *EditResponse {
+	return &EditResponse{ID: output.ID, WordID: output.WordID}
+}
+
+// CreateWord handles POST /api/v1/dictionary/words
+func (h *Handler) CreateWord(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	var req domain.WordJSON
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	output, err := h.editorUC.CreateWord(c.Request.Context(), editor, req)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, editResponse(output))
+}
+
+// UpdateWord handles PUT /api/v1/dictionary/words/:wordId
+func (h *Handler) UpdateWord(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	wordID, ok := idParam(c, "wordId")
+	if !ok {
+		return
+	}
+	var req UpdateWordRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	output, err := h.editorUC.UpdateWord(c.Request.Context(), editor, dictedit.UpdateWordInput{
+		WordID: wordID,
+		Word: domain.WordEdit{
+			Lemma:         req.Lemma,
+			Romanization:  req.Romanization,
+			ScriptCode:    req.ScriptCode,
+			FrequencyRank: req.FrequencyRank,
+			Note:          req.Note,
+			Topics:        req.Topics,
+		},
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, editResponse(output))
+}
+
+// DeleteWord handles DELETE /api/v1/dictionary/words/:wordId
+func (h *Handler) DeleteWord(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	wordID, ok := idParam(c, "wordId")
+	if !ok {
+		return
+	}
+
+	if err := h.editorUC.DeleteWord(c.Request.Context(), editor, wordID); err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil)
+}
+
+// CreateSense handles POST /api/v1/dictionary/words/:wordId/senses
+func (h *Handler) CreateSense(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	wordID, ok := idParam(c, "wordId")
+	if !ok {
+		return
+	}
+	var req domain.SenseJSON
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	output, err := h.editorUC.CreateSense(c.Request.Context(), editor, dictedit.CreateSenseInput{WordID: wordID, Sense: req})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, editResponse(output))
+}
+
+// UpdateSense handles PUT /api/v1/dictionary/senses/:senseId
+func (h *Handler) UpdateSense(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	senseID, ok := idParam(c, "senseId")
+	if !ok {
+		return
+	}
+	var req UpdateSenseRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	output, err := h.editorUC.UpdateSense(c.Request.Context(), editor, dictedit.UpdateSenseInput{
+		SenseID: senseID,
+		Sense: domain.SenseEdit{
+			Order:              req.Order,
+			PartOfSpeech:       req.PartOfSpeech,
+			DefinitionLanguage: req.DefinitionLanguage,
+			Definition:         req.Definition,
+			UsageLabel:         req.UsageLabel,
+			Level:              req.Level,
+			Note:               req.Note,
+		},
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, editResponse(output))
+}
+
+// DeleteSense handles DELETE /api/v1/dictionary/senses/:senseId
+func (h *Handler) DeleteSense(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	senseID, ok := idParam(c, "senseId")
+	if !ok {
+		return
+	}
+
+	if err := h.editorUC.DeleteSense(c.Request.Context(), editor, senseID); err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil)
+}
+
+// UpsertSenseTranslation handles POST /api/v1/dictionary/senses/:senseId/translations
+func (h *Handler) UpsertSenseTranslation(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	senseID, ok := idParam(c, "senseId")
+	if !ok {
+		return
+	}
+	var req domain.SenseTranslationJSON
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	output, err := h.editorUC.UpsertSenseTranslation(c.Request.Context(), editor, dictedit.UpsertSenseTranslationInput{
+		SenseID:     senseID,
+		Translation: req,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, editResponse(output))
+}
+
+// DeleteSenseTranslation handles DELETE /api/v1/dictionary/senses/:senseId/translations/:targetWordId
+func (h *Handler) DeleteSenseTranslation(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	senseID, ok := idParam(c, "senseId")
+	if !ok {
+		return
+	}
+	targetWordID, ok := idParam(c, "targetWordId")
+	if !ok {
+		return
+	}
+
+	if err := h.editorUC.DeleteSenseTranslation(c.Request.Context(), editor, dictedit.DeleteSenseTranslationInput{
+		SenseID:      senseID,
+		TargetWordID: targetWordID,
+	}); err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil)
+}
+
+// CreateExample handles POST /api/v1/dictionary/senses/:senseId/examples
+func (h *Handler) CreateExample(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	senseID, ok := idParam(c, "senseId")
+	if !ok {
+		return
+	}
+	var req domain.ExampleJSON
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	output, err := h.editorUC.CreateExample(c.Request.Context(), editor, dictedit.CreateExampleInput{SenseID: senseID, Example: req})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, editResponse(output))
+}
+
+// UpdateExample handles PUT /api/v1/dictionary/examples/:exampleId
+func (h *Handler) UpdateExample(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	exampleID, ok := idParam(c, "exampleId")
+	if !ok {
+		return
+	}
+	var req domain.ExampleJSON
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	output, err := h.editorUC.UpdateExample(c.Request.Context(), editor, dictedit.UpdateExampleInput{ExampleID: exampleID, Example: req})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, editResponse(output))
+}
+
+// DeleteExample handles DELETE /api/v1/dictionary/examples/:exampleId
+func (h *Handler) DeleteExample(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	exampleID, ok := idParam(c, "exampleId")
+	if !ok {
+		return
+	}
+
+	if err := h.editorUC.DeleteExample(c.Request.Context(), editor, exampleID); err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil)
+}
+
+// UpsertPronunciation handles PUT /api/v1/dictionary/words/:wordId/pronunciations
+func (h *Handler) UpsertPronunciation(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	wordID, ok := idParam(c, "wordId")
+	if !ok {
+		return
+	}
+	var req domain.PronunciationJSON
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	output, err := h.editorUC.UpsertPronunciation(c.Request.Context(), editor, dictedit.UpsertPronunciationInput{
+		WordID:        wordID,
+		Pronunciation: req,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, editResponse(output))
+}
+
+// DeletePronunciation handles DELETE /api/v1/dictionary/pronunciations/:pronunciationId
+func (h *Handler) DeletePronunciation(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	pronunciationID, ok := idParam(c, "pronunciationId")
+	if !ok {
+		return
+	}
+
+	if err := h.editorUC.DeletePronunciation(c.Request.Context(), editor, pronunciationID); err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil)
+}
+
+// UpsertRelation handles POST /api/v1/dictionary/words/:wordId/relations
+func (h *Handler) UpsertRelation(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	wordID, ok := idParam(c, "wordId")
+	if !ok {
+		return
+	}
+	var req domain.WordRelationJSON
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	output, err := h.editorUC.UpsertRelation(c.Request.Context(), editor, dictedit.UpsertRelationInput{WordID: wordID, Relation: req})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, editResponse(output))
+}
+
+// DeleteRelation handles DELETE /api/v1/dictionary/words/:wordId/relations/:targetWordId?type=...
+func (h *Handler) DeleteRelation(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	wordID, ok := idParam(c, "wordId")
+	if !ok {
+		return
+	}
+	targetWordID, ok := idParam(c, "targetWordId")
+	if !ok {
+		return
+	}
+	relationType := c.Query("type")
+	if relationType == "" {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("type is required"))
+		return
+	}
+
+	if err := h.editorUC.DeleteRelation(c.Request.Context(), editor, dictedit.DeleteRelationInput{
+		WordID:       wordID,
+		TargetWordID: targetWordID,
+		RelationType: relationType,
+	}); err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil)
+}
+
+// CreateTopic handles POST /api/v1/dictionary/topics
+func (h *Handler) CreateTopic(c *gin.Context) {
+	var req TopicRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	topic, err := h.editorUC.CreateTopic(c.Request.Context(), dictedit.TopicInput{Code: req.Code, Name: req.Name})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, topic)
+}
+
+// UpdateTopic handles PUT /api/v1/dictionary/topics/:topicId
+func (h *Handler) UpdateTopic(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	topicID, ok := idParam(c, "topicId")
+	if !ok {
+		return
+	}
+	var req TopicRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	topic, err := h.editorUC.UpdateTopic(c.Request.Context(), editor, dictedit.TopicInput{ID: topicID, Code: req.Code, Name: req.Name})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, topic)
+}
+
+// DeleteTopic handles DELETE /api/v1/dictionary/topics/:topicId
+func (h *Handler) DeleteTopic(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	topicID, ok := idParam(c, "topicId")
+	if !ok {
+		return
+	}
+
+	if err := h.editorUC.DeleteTopic(c.Request.Context(), editor, topicID); err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, nil)
+}
@@ -0,0 +1,97 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	dictexport "github.com/english-coach/backend/internal/modules/dictionary/usecase/export_words"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/english-coach/backend/internal/shared/response"
+	"github.com/english-coach/backend/internal/transport/http/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// exportContentTypes maps export formats to their response content types
+var exportContentTypes = map[string]string{
+	dictexport.FormatJSONL: "application/x-ndjson",
+	dictexport.FormatCSV:   "text/csv; charset=utf-8",
+	dictexport.FormatAPKG:  "application/octet-stream",
+}
+
+// ExportWords handles GET /api/v1/dictionary/export?format=...&language=...&level=...&topic=...&wordIds=...
+func (h *Handler) ExportWords(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req ExportWordsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidRequest.WithDetails(err.Error()))
+		return
+	}
+	if req.Format == "" {
+		req.Format = dictexport.FormatJSONL
+	}
+
+	input := dictexport.ExportWordsInput{
+		Format:   req.Format,
+		Language: strings.TrimSpace(req.Language),
+		Level:    strings.TrimSpace(req.Level),
+		Topic:    strings.TrimSpace(req.Topic),
+	}
+	if req.WordIDs != "" {
+		for _, part := range strings.Split(req.WordIDs, ",") {
+			id, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+			if err != nil || id <= 0 {
+				middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid wordIds"))
+				return
+			}
+			input.WordIDs = append(input.WordIDs, id)
+		}
+	}
+
+	// Get request logger from context (includes request ID)
+	requestLogger, _ := c.Get("logger")
+	var appLogger logger.ILogger
+	if reqLogger, ok := requestLogger.(logger.ILogger); ok {
+		appLogger = reqLogger
+	} else {
+		appLogger = h.logger
+	}
+
+	contentType, ok := exportContentTypes[req.Format]
+	if !ok {
+		contentType = "application/octet-stream"
+	}
+	filename := "dictionary"
+	if input.Language != "" {
+		filename += "-" + input.Language
+	}
+	stream := response.NewExportStream(c, contentType, filename+"."+req.Format)
+
+	// Large exports may outlive the server write timeout
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	_, err := h.exportWordsUC.Execute(ctx, input, stream)
+	if err != nil {
+		if !stream.Started() {
+			middleware.SetError(c, err)
+			return
+		}
+		// Headers are already sent; the truncated body is all the client can get
+		appLogger.Error("dictionary export aborted",
+			logger.Error(err),
+			logger.String("format", req.Format),
+		)
+		c.Abort()
+		return
+	}
+
+	if err := stream.Close(); err != nil {
+		appLogger.Error("failed to finish dictionary export",
+			logger.Error(err),
+			logger.String("format", req.Format),
+		)
+	}
+}
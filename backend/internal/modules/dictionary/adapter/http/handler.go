@@ -1,11 +1,28 @@
 package http
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	dictanalyze "github.com/english-coach/backend/internal/modules/dictionary/usecase/analyze_text"
+	dictbrowse "github.com/english-coach/backend/internal/modules/dictionary/usecase/browse_words"
+	dictproposals "github.com/english-coach/backend/internal/modules/dictionary/usecase/correction_proposals"
+	dictedit "github.com/english-coach/backend/internal/modules/dictionary/usecase/edit_dictionary"
+	dictexport "github.com/english-coach/backend/internal/modules/dictionary/usecase/export_words"
+	dictcharacter "github.com/english-coach/backend/internal/modules/dictionary/usecase/get_character"
 	dictusecase "github.com/english-coach/backend/internal/modules/dictionary/usecase/get_word_detail"
+	dictgraph "github.com/english-coach/backend/internal/modules/dictionary/usecase/get_word_graph"
+	dictreverse "github.com/english-coach/backend/internal/modules/dictionary/usecase/reverse_lookup"
+	dictsearchchars "github.com/english-coach/backend/internal/modules/dictionary/usecase/search_characters"
+	dictsearchtext "github.com/english-coach/backend/internal/modules/dictionary/usecase/search_text"
+	dictsuggest "github.com/english-coach/backend/internal/modules/dictionary/usecase/suggest_words"
+	dictrevisions "github.com/english-coach/backend/internal/modules/dictionary/usecase/word_revisions"
+	userdomain "github.com/english-coach/backend/internal/modules/user/domain"
 	sharederrors "github.com/english-coach/backend/internal/shared/errors"
 	"github.com/english-coach/backend/internal/shared/logger"
 	"github.com/english-coach/backend/internal/shared/pagination"
@@ -14,6 +31,12 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// Script names accepted by the script query parameter
+const (
+	scriptSimplified  = "simplified"
+	scriptTraditional = "traditional"
+)
+
 // Handler handles dictionary-related HTTP requests
 type Handler struct {
 	languageRepo    domain.LanguageRepository
@@ -21,6 +44,19 @@ type Handler struct {
 	levelRepo       domain.LevelRepository
 	wordRepo        domain.WordRepository
 	getWordDetailUC *dictusecase.Handler
+	suggestWordsUC  *dictsuggest.Handler
+	reverseLookupUC *dictreverse.Handler
+	searchTextUC    *dictsearchtext.Handler
+	getCharacterUC  *dictcharacter.Handler
+	searchCharsUC   *dictsearchchars.Handler
+	getWordGraphUC  *dictgraph.Handler
+	browseWordsUC   *dictbrowse.Handler
+	editorUC        *dictedit.Handler
+	revisionsUC     *dictrevisions.Handler
+	proposalsUC     *dictproposals.Handler
+	exportWordsUC   *dictexport.Handler
+	analyzeTextUC   *dictanalyze.Handler
+	profileRepo     userdomain.UserProfileRepository
 	logger          logger.ILogger
 }
 
@@ -31,6 +67,19 @@ func NewHandler(
 	levelRepo domain.LevelRepository,
 	wordRepo domain.WordRepository,
 	getWordDetailUC *dictusecase.Handler,
+	suggestWordsUC *dictsuggest.Handler,
+	reverseLookupUC *dictreverse.Handler,
+	searchTextUC *dictsearchtext.Handler,
+	getCharacterUC *dictcharacter.Handler,
+	searchCharsUC *dictsearchchars.Handler,
+	getWordGraphUC *dictgraph.Handler,
+	browseWordsUC *dictbrowse.Handler,
+	editorUC *dictedit.Handler,
+	revisionsUC *dictrevisions.Handler,
+	proposalsUC *dictproposals.Handler,
+	exportWordsUC *dictexport.Handler,
+	analyzeTextUC *dictanalyze.Handler,
+	profileRepo userdomain.UserProfileRepository,
 	logger logger.ILogger,
 ) *Handler {
 	return &Handler{
@@ -39,6 +88,19 @@ func NewHandler(
 		levelRepo:       levelRepo,
 		wordRepo:        wordRepo,
 		getWordDetailUC: getWordDetailUC,
+		suggestWordsUC:  suggestWordsUC,
+		reverseLookupUC: reverseLookupUC,
+		searchTextUC:    searchTextUC,
+		getCharacterUC:  getCharacterUC,
+		searchCharsUC:   searchCharsUC,
+		getWordGraphUC:  getWordGraphUC,
+		browseWordsUC:   browseWordsUC,
+		editorUC:        editorUC,
+		revisionsUC:     revisionsUC,
+		proposalsUC:     proposalsUC,
+		exportWordsUC:   exportWordsUC,
+		analyzeTextUC:   analyzeTextUC,
+		profileRepo:     profileRepo,
 		logger:          logger,
 	}
 }
@@ -151,41 +213,242 @@ func (h *Handler) SearchWords(c *gin.Context) {
 		logger.Int("pageSize", paginationParams.Size),
 	)
 
-	// Search words
-	words, err := h.wordRepo.SearchWords(ctx, query, langID, paginationParams.Limit, paginationParams.Offset)
+	// Search words; the total match count comes back with the page
+	results, totalCount, err := h.wordRepo.SearchWords(ctx, query, langID, paginationParams.Limit, paginationParams.Offset)
 	if err != nil {
 		middleware.SetError(c, err)
 		return
 	}
 
-	// Get total count for pagination
-	totalCount, err := h.wordRepo.CountSearchWords(ctx, query, langID)
-	if err != nil {
-		h.logger.Error("failed to count search words",
-			logger.Error(err),
-			logger.String("query", query),
-		)
-		// Continue without total count
-		totalCount = len(words)
-	}
-
 	total := int64(totalCount)
 
 	// Log successful search
 	appLogger.Info("dictionary search completed",
 		logger.String("query", query),
-		logger.Int("results_count", len(words)),
+		logger.Int("results_count", len(results)),
 		logger.Int64("total", total),
 	)
 
-	// Map domain words to response DTOs
-	wordResponses := mapWordsToResponse(words)
+	// Map search results to response DTOs
+	resultResponses := mapSearchResultsToResponse(results)
 
 	// Return paginated response
-	response.Paginated(c, http.StatusOK, wordResponses, paginationParams, total)
+	response.Paginated(c, http.StatusOK, resultResponses, paginationParams, total)
+}
+
+// SearchText handles GET /api/v1/dictionary/search/text?q=...&languageId=...&sources=...&page=...&pageSize=...
+// It searches definitions and example sentences written in languageId and returns highlighted snippets
+func (h *Handler) SearchText(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Query("q")
+	if query == "" {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("query parameter (q) is required"))
+		return
+	}
+
+	languageIDStr := c.Query("languageId")
+	if languageIDStr == "" {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("languageId parameter is required"))
+		return
+	}
+
+	languageID, err := strconv.ParseInt(languageIDStr, 10, 16)
+	if err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid languageId"))
+		return
+	}
+
+	var sources []string
+	if sourcesStr := c.Query("sources"); sourcesStr != "" {
+		for _, source := range strings.Split(sourcesStr, ",") {
+			if source = strings.TrimSpace(source); source != "" {
+				sources = append(sources, source)
+			}
+		}
+	}
+
+	paginationParams, err := pagination.ParseFromQuery(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	// Get request logger from context (includes request ID)
+	requestLogger, _ := c.Get("logger")
+	var appLogger logger.ILogger
+	if reqLogger, ok := requestLogger.(logger.ILogger); ok {
+		appLogger = reqLogger
+	} else {
+		appLogger = h.logger
+	}
+
+	output, err := h.searchTextUC.Execute(ctx, dictsearchtext.SearchTextInput{
+		Query:      query,
+		LanguageID: int16(languageID),
+		Sources:    sources,
+		Limit:      paginationParams.Limit,
+		Offset:     paginationParams.Offset,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	appLogger.Info("text search completed",
+		logger.String("query", query),
+		logger.Int("language_id", int(languageID)),
+		logger.Int("results_count", len(output.Hits)),
+		logger.Int("total", output.Total),
+	)
+
+	response.Paginated(c, http.StatusOK, output.Hits, paginationParams, int64(output.Total))
+}
+
+// SuggestWords handles GET /api/v1/dictionary/suggest?q=...&languageId=...&limit=...
+func (h *Handler) SuggestWords(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Query("q")
+	if query == "" {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("query parameter (q) is required"))
+		return
+	}
+
+	languageIDStr := c.Query("languageId")
+	if languageIDStr == "" {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("languageId parameter is required"))
+		return
+	}
+
+	languageID, err := strconv.ParseInt(languageIDStr, 10, 16)
+	if err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid languageId"))
+		return
+	}
+
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid limit"))
+			return
+		}
+	}
+
+	output, err := h.suggestWordsUC.Execute(ctx, dictsuggest.SuggestWordsInput{
+		Query:      query,
+		LanguageID: int16(languageID),
+		Limit:      limit,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, &SuggestWordsResponse{
+		Suggestions: output.Suggestions,
+		Source:      output.Source,
+	})
+}
+
+// ReverseLookup handles GET /api/v1/dictionary/reverse?q=...&languageId=...&sourceLanguageId=...&limit=...
+// languageId is the language of the queried word; results are the source words it translates
+func (h *Handler) ReverseLookup(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	query := c.Query("q")
+	if query == "" {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("query parameter (q) is required"))
+		return
+	}
+
+	languageIDStr := c.Query("languageId")
+	if languageIDStr == "" {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("languageId parameter is required"))
+		return
+	}
+
+	languageID, err := strconv.ParseInt(languageIDStr, 10, 16)
+	if err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid languageId"))
+		return
+	}
+
+	var sourceLanguageID int64
+	if sourceLanguageIDStr := c.Query("sourceLanguageId"); sourceLanguageIDStr != "" {
+		sourceLanguageID, err = strconv.ParseInt(sourceLanguageIDStr, 10, 16)
+		if err != nil || sourceLanguageID < 1 {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid sourceLanguageId"))
+			return
+		}
+	}
+
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid limit"))
+			return
+		}
+	}
+
+	// Get request logger from context (includes request ID)
+	requestLogger, _ := c.Get("logger")
+	var appLogger logger.ILogger
+	if reqLogger, ok := requestLogger.(logger.ILogger); ok {
+		appLogger = reqLogger
+	} else {
+		appLogger = h.logger
+	}
+
+	output, err := h.reverseLookupUC.Execute(ctx, dictreverse.ReverseLookupInput{
+		Query:            query,
+		LanguageID:       int16(languageID),
+		SourceLanguageID: int16(sourceLanguageID),
+		Limit:            limit,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	groups := make([]*ReverseLookupGroupResponse, 0, len(output.Groups))
+	resultCount := 0
+	for _, group := range output.Groups {
+		results := make([]*ReverseTranslationResponse, 0, len(group.Results))
+		for _, r := range group.Results {
+			results = append(results, &ReverseTranslationResponse{
+				SourceWord:       mapWordToResponse(r.SourceWord),
+				Sense:            r.Sense,
+				Priority:         r.Priority,
+				TranslatedWordID: r.TranslatedWordID,
+				TranslatedLemma:  r.TranslatedLemma,
+			})
+		}
+		resultCount += len(results)
+		groups = append(groups, &ReverseLookupGroupResponse{
+			Language: group.Language,
+			Results:  results,
+		})
+	}
+
+	appLogger.Info("reverse lookup completed",
+		logger.String("query", query),
+		logger.Int("language_id", int(languageID)),
+		logger.Int("groups_count", len(groups)),
+		logger.Int("results_count", resultCount),
+	)
+
+	response.Success(c, http.StatusOK, &ReverseLookupResponse{
+		Query:  query,
+		Groups: groups,
+	})
 }
 
-// GetWordDetail handles GET /api/v1/dictionary/words/:wordId
+// GetWordDetail handles GET /api/v1/dictionary/words/:wordId?script=simplified|traditional
+// script picks the form returned as display_lemma for Chinese words; without it, a logged-in
+// user's preferred script is used
 func (h *Handler) GetWordDetail(c *gin.Context) {
 	ctx := c.Request.Context()
 
@@ -196,6 +459,12 @@ func (h *Handler) GetWordDetail(c *gin.Context) {
 		return
 	}
 
+	script := c.Query("script")
+	if script != "" && script != scriptSimplified && script != scriptTraditional {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("script must be simplified or traditional"))
+		return
+	}
+
 	// Get request logger from context (includes request ID)
 	requestLogger, _ := c.Get("logger")
 	var appLogger logger.ILogger
@@ -235,17 +504,18 @@ func (h *Handler) GetWordDetail(c *gin.Context) {
 	senseDTOs := make([]SenseDetailResponse, len(wordDetail.Senses))
 	for i, s := range wordDetail.Senses {
 		senseDTOs[i] = SenseDetailResponse{
-			ID:                   s.ID,
-			SenseOrder:           s.SenseOrder,
-			PartOfSpeechID:       s.PartOfSpeechID,
-			PartOfSpeechName:     s.PartOfSpeechName,
-			Definition:           s.Definition,
-			DefinitionLanguageID: s.DefinitionLanguageID,
-			LevelID:              s.LevelID,
-			LevelName:            s.LevelName,
-			Note:                 s.Note,
-			Translations:         mapWordsToResponse(s.Translations),
-			Examples:             s.Examples,
+			ID:                     s.ID,
+			SenseOrder:             s.SenseOrder,
+			PartOfSpeechID:         s.PartOfSpeechID,
+			PartOfSpeechName:       s.PartOfSpeechName,
+			Definition:             s.Definition,
+			DefinitionLanguageID:   s.DefinitionLanguageID,
+			DefinitionLanguageName: s.DefinitionLanguageName,
+			LevelID:                s.LevelID,
+			LevelName:              s.LevelName,
+			Note:                   s.Note,
+			Translations:           mapWordsToResponse(s.Translations),
+			Examples:               s.Examples,
 		}
 	}
 
@@ -263,11 +533,330 @@ func (h *Handler) GetWordDetail(c *gin.Context) {
 	}
 
 	resp := GetWordDetailResponse{
-		Word:           wordResp,
-		Senses:         senseDTOs,
-		Pronunciations: wordDetail.Pronunciations,
-		Relations:      relationDTOs,
+		Word:             wordResp,
+		Senses:           senseDTOs,
+		Pronunciations:   wordDetail.Pronunciations,
+		Relations:        relationDTOs,
+		ScriptForms:      wordDetail.ScriptForms,
+		PendingProposals: wordDetail.PendingProposals,
+	}
+	if forms := wordDetail.ScriptForms; forms != nil {
+		if script == "" {
+			script = h.preferredScript(c, appLogger)
+		}
+		switch script {
+		case scriptSimplified:
+			resp.DisplayLemma = &forms.Simplified
+		case scriptTraditional:
+			resp.DisplayLemma = &forms.Traditional
+		}
 	}
 
 	response.Success(c, http.StatusOK, resp)
 }
+
+// preferredScript returns the preferred script of the logged-in user, or "" for anonymous
+// requests and users without one. The display form is optional, so lookup errors are only logged.
+func (h *Handler) preferredScript(c *gin.Context, appLogger logger.ILogger) string {
+	userID, ok := c.Get("user_id")
+	id, isID := userID.(int64)
+	if !ok || !isID || h.profileRepo == nil {
+		return ""
+	}
+	profile, err := h.profileRepo.FindUserProfileByUserID(c.Request.Context(), id)
+	if err != nil {
+		if !errors.Is(err, userdomain.ErrProfileNotFound) {
+			appLogger.Warn("failed to fetch preferred script", logger.Error(err), logger.Int64("user_id", id))
+		}
+		return ""
+	}
+	if profile == nil || profile.PreferredScript == nil {
+		return ""
+	}
+	return *profile.PreferredScript
+}
+
+// GetCharacter handles GET /api/v1/dictionary/characters/:literal?wordsLimit=...
+// literal may also be the simplified or traditional form of a stored character
+func (h *Handler) GetCharacter(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	literal := strings.TrimSpace(c.Param("literal"))
+	if n := utf8.RuneCountInString(literal); n < 1 || n > 2 {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("literal must be a single character"))
+		return
+	}
+
+	wordsLimit := 0
+	if limitStr := c.Query("wordsLimit"); limitStr != "" {
+		var err error
+		wordsLimit, err = strconv.Atoi(limitStr)
+		if err != nil || wordsLimit < 1 {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid wordsLimit"))
+			return
+		}
+	}
+
+	// Get request logger from context (includes request ID)
+	requestLogger, _ := c.Get("logger")
+	var appLogger logger.ILogger
+	if reqLogger, ok := requestLogger.(logger.ILogger); ok {
+		appLogger = reqLogger
+	} else {
+		appLogger = h.logger
+	}
+
+	output, err := h.getCharacterUC.Execute(ctx, dictcharacter.GetCharacterInput{
+		Literal:    literal,
+		WordsLimit: wordsLimit,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	readings := make([]*CharacterReadingGroupResponse, 0, len(output.Readings))
+	for _, group := range output.Readings {
+		readings = append(readings, &CharacterReadingGroupResponse{
+			Language: group.Language,
+			Readings: group.Readings,
+		})
+	}
+
+	words := make([]*CharacterWordResponse, 0, len(output.Words))
+	for _, w := range output.Words {
+		words = append(words, &CharacterWordResponse{
+			Word:      mapWordToResponse(w.Word),
+			CharOrder: w.CharOrder,
+		})
+	}
+
+	appLogger.Info("character lookup completed",
+		logger.String("literal", literal),
+		logger.Int64("character_id", output.Character.ID),
+		logger.Int("words_count", len(words)),
+		logger.Int("words_total", output.WordsTotal),
+	)
+
+	response.Success(c, http.StatusOK, &GetCharacterResponse{
+		Character:  output.Character,
+		Level:      output.Level,
+		Readings:   readings,
+		Words:      words,
+		WordsTotal: output.WordsTotal,
+	})
+}
+
+// SearchCharacters handles GET /api/v1/dictionary/characters?radical=...&strokes=...&page=...&pageSize=...
+// At least one of radical and strokes is required
+func (h *Handler) SearchCharacters(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var input dictsearchchars.SearchCharactersInput
+	if radical := c.Query("radical"); radical != "" {
+		input.Radical = &radical
+	}
+	if strokesStr := c.Query("strokes"); strokesStr != "" {
+		strokes, err := strconv.ParseInt(strokesStr, 10, 16)
+		if err != nil || strokes < 1 {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid strokes"))
+			return
+		}
+		value := int16(strokes)
+		input.Strokes = &value
+	}
+
+	paginationParams, err := pagination.ParseFromQuery(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+	input.Limit = paginationParams.Limit
+	input.Offset = paginationParams.Offset
+
+	output, err := h.searchCharsUC.Execute(ctx, input)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	results := make([]*CharacterSearchResultResponse, 0, len(output.Results))
+	for _, r := range output.Results {
+		results = append(results, &CharacterSearchResultResponse{
+			Character: r.Character,
+			Readings:  r.Readings,
+		})
+	}
+
+	response.Paginated(c, http.StatusOK, results, paginationParams, int64(output.Total))
+}
+
+// BrowseWords handles GET /api/v1/dictionary/words?topicId=...&levelId=...&languageId=...&pos=...&sort=...&page=...&pageSize=...
+// It lists words matching the filters, each with a gloss from its first sense and that sense's first translation
+func (h *Handler) BrowseWords(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var input dictbrowse.BrowseWordsInput
+	if languageIDStr := c.Query("languageId"); languageIDStr != "" {
+		languageID, err := strconv.ParseInt(languageIDStr, 10, 16)
+		if err != nil {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid languageId"))
+			return
+		}
+		value := int16(languageID)
+		input.LanguageID = &value
+	}
+	if topicIDStr := c.Query("topicId"); topicIDStr != "" {
+		topicID, err := strconv.ParseInt(topicIDStr, 10, 64)
+		if err != nil {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid topicId"))
+			return
+		}
+		input.TopicID = &topicID
+	}
+	if levelIDStr := c.Query("levelId"); levelIDStr != "" {
+		levelID, err := strconv.ParseInt(levelIDStr, 10, 64)
+		if err != nil {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid levelId"))
+			return
+		}
+		input.LevelID = &levelID
+	}
+	if pos := strings.TrimSpace(c.Query("pos")); pos != "" {
+		input.PartOfSpeech = &pos
+	}
+	if translationIDStr := c.Query("translationLanguageId"); translationIDStr != "" {
+		translationID, err := strconv.ParseInt(translationIDStr, 10, 16)
+		if err != nil {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid translationLanguageId"))
+			return
+		}
+		value := int16(translationID)
+		input.TranslationLanguageID = &value
+	}
+	input.Sort = c.Query("sort")
+
+	paginationParams, err := pagination.ParseFromQuery(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+	input.Limit = paginationParams.Limit
+	input.Offset = paginationParams.Offset
+
+	output, err := h.browseWordsUC.Execute(ctx, input)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	items := make([]*WordBrowseItemResponse, 0, len(output.Items))
+	for _, item := range output.Items {
+		items = append(items, &WordBrowseItemResponse{
+			WordResponse: *mapWordToResponse(item.Word),
+			Gloss:        item.Gloss,
+		})
+	}
+
+	response.Paginated(c, http.StatusOK, items, paginationParams, int64(output.Total))
+}
+
+// GetWordGraph handles GET /api/v1/dictionary/words/:wordId/graph?depth=...&types=...
+// It returns the words reachable from a word through relations, translations and shared characters
+func (h *Handler) GetWordGraph(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	wordID, err := strconv.ParseInt(c.Param("wordId"), 10, 64)
+	if err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid wordId"))
+		return
+	}
+
+	depth := 0
+	if depthStr := c.Query("depth"); depthStr != "" {
+		depth, err = strconv.Atoi(depthStr)
+		if err != nil || depth < 1 {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid depth"))
+			return
+		}
+	}
+
+	var types []string
+	if typesStr := c.Query("types"); typesStr != "" {
+		for _, t := range strings.Split(typesStr, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				types = append(types, t)
+			}
+		}
+	}
+
+	// Get request logger from context (includes request ID)
+	requestLogger, _ := c.Get("logger")
+	var appLogger logger.ILogger
+	if reqLogger, ok := requestLogger.(logger.ILogger); ok {
+		appLogger = reqLogger
+	} else {
+		appLogger = h.logger
+	}
+
+	output, err := h.getWordGraphUC.Execute(ctx, dictgraph.GetWordGraphInput{
+		WordID: wordID,
+		Depth:  depth,
+		Types:  types,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	appLogger.Info("word graph lookup completed",
+		logger.Int64("word_id", wordID),
+		logger.Int("depth", output.Depth),
+		logger.Int("nodes_count", len(output.Nodes)),
+		logger.Int("edges_count", len(output.Edges)),
+		logger.Bool("truncated", output.Truncated),
+	)
+
+	response.Success(c, http.StatusOK, mapWordGraphToResponse(output))
+}
+
+// mapWordGraphToResponse maps the word graph use case output to WordGraphResponse
+func mapWordGraphToResponse(output *dictgraph.GetWordGraphOutput) *WordGraphResponse {
+	nodes := make([]*WordGraphNodeResponse, 0, len(output.Nodes))
+	for _, node := range output.Nodes {
+		nodes = append(nodes, &WordGraphNodeResponse{
+			ID:            node.Word.ID,
+			Label:         node.Word.Lemma,
+			LanguageID:    node.Word.LanguageID,
+			Romanization:  node.Word.Romanization,
+			FrequencyRank: node.Word.FrequencyRank,
+			Depth:         node.Depth,
+		})
+	}
+
+	edges := make([]*WordGraphEdgeResponse, 0, len(output.Edges))
+	for _, edge := range output.Edges {
+		id := fmt.Sprintf("%s:%d-%d", edge.Type, edge.FromWordID, edge.ToWordID)
+		if edge.CharacterID != nil {
+			id = fmt.Sprintf("%s:%d", id, *edge.CharacterID)
+		}
+		edges = append(edges, &WordGraphEdgeResponse{
+			ID:          id,
+			Source:      edge.FromWordID,
+			Target:      edge.ToWordID,
+			Type:        edge.Type,
+			Note:        edge.Note,
+			Priority:    edge.Priority,
+			CharacterID: edge.CharacterID,
+			Character:   edge.Character,
+		})
+	}
+
+	return &WordGraphResponse{
+		RootID:    output.RootWordID,
+		Depth:     output.Depth,
+		Nodes:     nodes,
+		Edges:     edges,
+		Truncated: output.Truncated,
+	}
+}
@@ -0,0 +1,148 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	dictproposals "github.com/english-coach/backend/internal/modules/dictionary/usecase/correction_proposals"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/pagination"
+	"github.com/english-coach/backend/internal/shared/response"
+	"github.com/english-coach/backend/internal/transport/http/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// SubmitProposal handles POST /api/v1/dictionary/words/:wordId/proposals
+func (h *Handler) SubmitProposal(c *gin.Context) {
+	// Any logged-in user may propose a correction; editorID only reads the authenticated user
+	userID, ok := editorID(c)
+	if !ok {
+		return
+	}
+	wordID, ok := idParam(c, "wordId")
+	if !ok {
+		return
+	}
+	var req SubmitProposalRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	output, err := h.proposalsUC.Submit(c.Request.Context(), userID, dictproposals.SubmitProposalInput{
+		WordID:       wordID,
+		Kind:         req.Kind,
+		SenseID:      req.SenseID,
+		ExampleID:    req.ExampleID,
+		TargetWordID: req.TargetWordID,
+		Payload:      req.Payload,
+		Comment:      req.Comment,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, output)
+}
+
+// ListProposals handles GET /api/v1/dictionary/proposals?status=...&wordId=...&page=...&pageSize=...
+func (h *Handler) ListProposals(c *gin.Context) {
+	input := dictproposals.ListProposalsInput{Status: c.Query("status")}
+	if wordIDStr := c.Query("wordId"); wordIDStr != "" {
+		wordID, err := strconv.ParseInt(wordIDStr, 10, 64)
+		if err != nil {
+			middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid wordId"))
+			return
+		}
+		input.WordID = &wordID
+	}
+	paginationParams, err := pagination.ParseFromQuery(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+	input.Limit = paginationParams.Limit
+	input.Offset = paginationParams.Offset
+
+	output, err := h.proposalsUC.ListProposals(c.Request.Context(), input)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Paginated(c, http.StatusOK, output.Items, paginationParams, int64(output.Total))
+}
+
+// ApproveProposal handles POST /api/v1/dictionary/proposals/:proposalId/approve
+func (h *Handler) ApproveProposal(c *gin.Context) {
+	moderator, ok := editorID(c)
+	if !ok {
+		return
+	}
+	proposalID, ok := idParam(c, "proposalId")
+	if !ok {
+		return
+	}
+
+	output, err := h.proposalsUC.Approve(c.Request.Context(), moderator, proposalID)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, output)
+}
+
+// RejectProposal handles POST /api/v1/dictionary/proposals/:proposalId/reject
+func (h *Handler) RejectProposal(c *gin.Context) {
+	moderator, ok := editorID(c)
+	if !ok {
+		return
+	}
+	proposalID, ok := idParam(c, "proposalId")
+	if !ok {
+		return
+	}
+	var req RejectProposalRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	output, err := h.proposalsUC.Reject(c.Request.Context(), moderator, dictproposals.RejectProposalInput{
+		ProposalID: proposalID,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, output)
+}
+
+// MergeProposal handles POST /api/v1/dictionary/proposals/:proposalId/merge
+func (h *Handler) MergeProposal(c *gin.Context) {
+	moderator, ok := editorID(c)
+	if !ok {
+		return
+	}
+	proposalID, ok := idParam(c, "proposalId")
+	if !ok {
+		return
+	}
+	var req MergeProposalRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	output, err := h.proposalsUC.Merge(c.Request.Context(), moderator, dictproposals.MergeProposalInput{
+		ProposalID: proposalID,
+		IntoID:     req.IntoID,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, output)
+}
@@ -0,0 +1,127 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	dictedit "github.com/english-coach/backend/internal/modules/dictionary/usecase/edit_dictionary"
+	dictrevisions "github.com/english-coach/backend/internal/modules/dictionary/usecase/word_revisions"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/pagination"
+	"github.com/english-coach/backend/internal/shared/response"
+	"github.com/english-coach/backend/internal/transport/http/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// revisionNumber parses a positive revision number, setting an invalid parameter error when it is not one
+func revisionNumber(c *gin.Context, name, value string) (int, bool) {
+	revision, err := strconv.Atoi(value)
+	if err != nil || revision <= 0 {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("invalid "+name))
+		return 0, false
+	}
+	return revision, true
+}
+
+// ListWordRevisions handles GET /api/v1/dictionary/words/:wordId/revisions
+func (h *Handler) ListWordRevisions(c *gin.Context) {
+	wordID, ok := idParam(c, "wordId")
+	if !ok {
+		return
+	}
+	paginationParams, err := pagination.ParseFromQuery(c)
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	output, err := h.revisionsUC.ListRevisions(c.Request.Context(), dictrevisions.ListRevisionsInput{
+		WordID: wordID,
+		Limit:  paginationParams.Limit,
+		Offset: paginationParams.Offset,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Paginated(c, http.StatusOK, output.Items, paginationParams, int64(output.Total))
+}
+
+// GetWordRevision handles GET /api/v1/dictionary/words/:wordId/revisions/:revision
+func (h *Handler) GetWordRevision(c *gin.Context) {
+	wordID, ok := idParam(c, "wordId")
+	if !ok {
+		return
+	}
+	revision, ok := revisionNumber(c, "revision", c.Param("revision"))
+	if !ok {
+		return
+	}
+
+	output, err := h.revisionsUC.GetRevision(c.Request.Context(), dictrevisions.GetRevisionInput{
+		WordID:   wordID,
+		Revision: revision,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, output)
+}
+
+// DiffWordRevisions handles GET /api/v1/dictionary/words/:wordId/revisions/diff?from=...&to=...
+func (h *Handler) DiffWordRevisions(c *gin.Context) {
+	wordID, ok := idParam(c, "wordId")
+	if !ok {
+		return
+	}
+	from, ok := revisionNumber(c, "from", c.Query("from"))
+	if !ok {
+		return
+	}
+	to, ok := revisionNumber(c, "to", c.Query("to"))
+	if !ok {
+		return
+	}
+
+	output, err := h.revisionsUC.DiffRevisions(c.Request.Context(), dictrevisions.DiffRevisionsInput{
+		WordID: wordID,
+		From:   from,
+		To:     to,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, output)
+}
+
+// RestoreWordRevision handles POST /api/v1/dictionary/words/:wordId/revisions/:revision/restore
+func (h *Handler) RestoreWordRevision(c *gin.Context) {
+	editor, ok := editorID(c)
+	if !ok {
+		return
+	}
+	wordID, ok := idParam(c, "wordId")
+	if !ok {
+		return
+	}
+	revision, ok := revisionNumber(c, "revision", c.Param("revision"))
+	if !ok {
+		return
+	}
+
+	output, err := h.editorUC.RestoreRevision(c.Request.Context(), editor, dictedit.RestoreRevisionInput{
+		WordID:   wordID,
+		Revision: revision,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, output)
+}
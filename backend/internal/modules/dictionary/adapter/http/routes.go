@@ -5,7 +5,7 @@ import (
 )
 
 // RegisterRoutes registers dictionary-related HTTP routes
-func RegisterRoutes(router *gin.RouterGroup, handler *Handler) {
+func RegisterRoutes(router *gin.RouterGroup, handler *Handler, authMiddleware, optionalAuthMiddleware, editorMiddleware gin.HandlerFunc) {
 	// Reference routes: /api/v1/reference/... (public)
 	referenceGroup := router.Group("/reference")
 	{
@@ -18,7 +18,59 @@ func RegisterRoutes(router *gin.RouterGroup, handler *Handler) {
 	dictionaryGroup := router.Group("/dictionary")
 	{
 		dictionaryGroup.GET("/search", handler.SearchWords)
-		dictionaryGroup.GET("/words/:wordId", handler.GetWordDetail)
+		dictionaryGroup.GET("/search/text", handler.SearchText)
+		dictionaryGroup.POST("/analyze", handler.AnalyzeText)
+		dictionaryGroup.GET("/suggest", handler.SuggestWords)
+		dictionaryGroup.GET("/reverse", handler.ReverseLookup)
+		dictionaryGroup.GET("/words", handler.BrowseWords)
+		dictionaryGroup.GET("/words/:wordId", optionalAuthMiddleware, handler.GetWordDetail) // logged-in users get their preferred script
+		dictionaryGroup.GET("/words/:wordId/graph", handler.GetWordGraph)
+		dictionaryGroup.GET("/characters", handler.SearchCharacters)
+		dictionaryGroup.GET("/characters/:literal", handler.GetCharacter)
+	}
+
+	// User routes: /api/v1/dictionary/... (protected - any logged-in user)
+	userGroup := router.Group("/dictionary")
+	userGroup.Use(authMiddleware)
+	{
+		userGroup.GET("/export", handler.ExportWords)
+		userGroup.POST("/words/:wordId/proposals", handler.SubmitProposal)
+	}
+
+	// Editor routes: /api/v1/dictionary/... (protected - requires the editor or admin role)
+	editorGroup := router.Group("/dictionary")
+	editorGroup.Use(authMiddleware, editorMiddleware)
+	{
+		editorGroup.POST("/words", handler.CreateWord)
+		editorGroup.PUT("/words/:wordId", handler.UpdateWord)
+		editorGroup.DELETE("/words/:wordId", handler.DeleteWord)
+		editorGroup.POST("/words/:wordId/senses", handler.CreateSense)
+		editorGroup.PUT("/words/:wordId/pronunciations", handler.UpsertPronunciation)
+		editorGroup.POST("/words/:wordId/relations", handler.UpsertRelation)
+		editorGroup.DELETE("/words/:wordId/relations/:targetWordId", handler.DeleteRelation)
+		editorGroup.GET("/words/:wordId/revisions", handler.ListWordRevisions)
+		editorGroup.GET("/words/:wordId/revisions/diff", handler.DiffWordRevisions)
+		editorGroup.GET("/words/:wordId/revisions/:revision", handler.GetWordRevision)
+		editorGroup.POST("/words/:wordId/revisions/:revision/restore", handler.RestoreWordRevision)
+
+		editorGroup.PUT("/senses/:senseId", handler.UpdateSense)
+		editorGroup.DELETE("/senses/:senseId", handler.DeleteSense)
+		editorGroup.POST("/senses/:senseId/translations", handler.UpsertSenseTranslation)
+		editorGroup.DELETE("/senses/:senseId/translations/:targetWordId", handler.DeleteSenseTranslation)
+		editorGroup.POST("/senses/:senseId/examples", handler.CreateExample)
+
+		editorGroup.PUT("/examples/:exampleId", handler.UpdateExample)
+		editorGroup.DELETE("/examples/:exampleId", handler.DeleteExample)
+		editorGroup.DELETE("/pronunciations/:pronunciationId", handler.DeletePronunciation)
+
+		editorGroup.POST("/topics", handler.CreateTopic)
+		editorGroup.PUT("/topics/:topicId", handler.UpdateTopic)
+		editorGroup.DELETE("/topics/:topicId", handler.DeleteTopic)
+
+		editorGroup.GET("/proposals", handler.ListProposals)
+		editorGroup.POST("/proposals/:proposalId/approve", handler.ApproveProposal)
+		editorGroup.POST("/proposals/:proposalId/reject", handler.RejectProposal)
+		editorGroup.POST("/proposals/:proposalId/merge", handler.MergeProposal)
 	}
 }
 
@@ -0,0 +1,37 @@
+package domain
+
+// Character represents a single Han character (hanzi, kanji, hanja)
+type Character struct {
+	ID          int64   `json:"id"`
+	Literal     string  `json:"literal"`
+	Simplified  *string `json:"simplified,omitempty"`
+	Traditional *string `json:"traditional,omitempty"`
+	ScriptCode  string  `json:"script_code"`
+	Strokes     *int16  `json:"strokes,omitempty"`
+	Radical     *string `json:"radical,omitempty"`
+	LevelID     *int64  `json:"level_id,omitempty"`
+}
+
+// CharacterReading is one reading of a character in a language (e.g., pinyin, on'yomi, Hán Việt)
+type CharacterReading struct {
+	ID          int64   `json:"id"`
+	CharacterID int64   `json:"character_id"`
+	LanguageID  int16   `json:"language_id"`
+	Reading     string  `json:"reading"`
+	ReadingType *string `json:"reading_type,omitempty"`
+	Note        *string `json:"note,omitempty"`
+}
+
+// CharacterWord is a word containing a character, with the character's first position in it
+type CharacterWord struct {
+	Word      *Word `json:"word"`
+	CharOrder int16 `json:"char_order"`
+}
+
+// CharacterSearchQuery filters characters by radical and/or stroke count
+type CharacterSearchQuery struct {
+	Radical *string
+	Strokes *int16
+	Limit   int
+	Offset  int
+}
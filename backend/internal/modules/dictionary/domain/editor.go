@@ -0,0 +1,23 @@
+package domain
+
+// WordEdit holds the editable fields of a word. Search keys are recomputed from the lemma and
+// romanization on every edit.
+type WordEdit struct {
+	Lemma         string
+	Romanization  *string
+	ScriptCode    *string
+	FrequencyRank *int
+	Note          *string
+	Topics        []string // replaces the word's topics when not nil
+}
+
+// SenseEdit holds the editable fields of a sense. Translations and examples are edited separately.
+type SenseEdit struct {
+	Order              int
+	PartOfSpeech       string
+	DefinitionLanguage string
+	Definition         string
+	UsageLabel         *string
+	Level              *string
+	Note               *string
+}
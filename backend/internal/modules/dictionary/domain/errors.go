@@ -10,4 +10,26 @@ var (
 	ErrLanguageNotFound    = errors.New("Language not found")
 	ErrPartOfSpeechNotFound = errors.New("Part of speech not found")
 	ErrSenseNotFound       = errors.New("Sense not found")
+	ErrCharacterNotFound   = errors.New("Character not found")
+)
+
+// Dictionary editing errors
+var (
+	ErrWordExists               = errors.New("Word already exists")
+	ErrSenseOrderTaken          = errors.New("Sense order is already used by this word")
+	ErrSelfRelation             = errors.New("A word cannot be related to itself")
+	ErrExampleNotFound          = errors.New("Example not found")
+	ErrPronunciationNotFound    = errors.New("Pronunciation not found")
+	ErrSenseTranslationNotFound = errors.New("Sense translation not found")
+	ErrRelationNotFound         = errors.New("Word relation not found")
+	ErrTopicExists              = errors.New("Topic already exists")
+	ErrEntryInUse               = errors.New("Entry is referenced by learning history")
+	ErrRevisionNotFound         = errors.New("Revision not found")
+)
+
+// Correction proposal errors
+var (
+	ErrProposalNotFound     = errors.New("Proposal not found")
+	ErrProposalNotPending   = errors.New("Proposal has already been reviewed")
+	ErrProposalMergeInvalid = errors.New("Proposals can only be merged into another pending proposal for the same word")
 )
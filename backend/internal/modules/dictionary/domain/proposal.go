@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Proposal kinds stored in word_proposals.kind
+const (
+	ProposalKindSenseCreate       = "sense_create"
+	ProposalKindSenseUpdate       = "sense_update"
+	ProposalKindSenseDelete       = "sense_delete"
+	ProposalKindTranslationUpsert = "translation_upsert"
+	ProposalKindTranslationDelete = "translation_delete"
+	ProposalKindExampleCreate     = "example_create"
+	ProposalKindExampleUpdate     = "example_update"
+	ProposalKindExampleDelete     = "example_delete"
+)
+
+// Proposal statuses stored in word_proposals.status
+const (
+	ProposalStatusPending  = "pending"
+	ProposalStatusApproved = "approved"
+	ProposalStatusRejected = "rejected"
+	ProposalStatusMerged   = "merged"
+)
+
+// Proposal is a change to a dictionary entry suggested by a user and waiting for, or settled by,
+// a moderator. Payload holds the proposed content in the WordJSON seed format: a SenseJSON for
+// sense proposals, a SenseTranslationJSON for translation upserts and an ExampleJSON for example
+// proposals. Deletes carry no payload.
+type Proposal struct {
+	ID           int64           `json:"id"`
+	WordID       int64           `json:"word_id"`
+	WordLemma    string          `json:"word_lemma,omitempty"`
+	Kind         string          `json:"kind"`
+	SenseID      *int64          `json:"sense_id,omitempty"`
+	ExampleID    *int64          `json:"example_id,omitempty"`
+	TargetWordID *int64          `json:"target_word_id,omitempty"` // translation to delete
+	Payload      json.RawMessage `json:"payload,omitempty"`
+	Comment      *string         `json:"comment,omitempty"`
+	Status       string          `json:"status"`
+	UserID       int64           `json:"user_id"`
+	Username     string          `json:"username,omitempty"`
+	ModeratorID  *int64          `json:"moderator_id,omitempty"`
+	Reason       *string         `json:"reason,omitempty"`
+	MergedIntoID *int64          `json:"merged_into_id,omitempty"`
+	Duplicates   int             `json:"duplicates"` // proposals merged into this one
+	CreatedAt    time.Time       `json:"created_at"`
+	ReviewedAt   *time.Time      `json:"reviewed_at,omitempty"`
+}
+
+// ProposalQuery filters the moderation queue. Nil filters are not applied.
+type ProposalQuery struct {
+	Status *string
+	WordID *int64
+	UserID *int64
+	Limit  int
+	Offset int
+}
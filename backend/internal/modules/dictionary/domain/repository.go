@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 // LanguageRepository defines operations for language data access
@@ -12,6 +13,8 @@ type LanguageRepository interface {
 	FindLanguageByID(ctx context.Context, id int16) (*Language, error)
 	// FindLanguageByCode returns a language by code
 	FindLanguageByCode(ctx context.Context, code string) (*Language, error)
+	// FindLanguagesByIDs returns languages keyed by ID
+	FindLanguagesByIDs(ctx context.Context, ids []int16) (map[int16]*Language, error)
 }
 
 // TopicRepository defines operations for topic data access
@@ -34,6 +37,8 @@ type LevelRepository interface {
 	FindLevelByCode(ctx context.Context, code string) (*Level, error)
 	// FindLevelsByLanguageID returns all levels for a specific language
 	FindLevelsByLanguageID(ctx context.Context, languageID int16) ([]*Level, error)
+	// FindLevelsByIDs returns levels keyed by ID
+	FindLevelsByIDs(ctx context.Context, ids []int64) (map[int64]*Level, error)
 }
 
 // WordRepository defines operations for word data access
@@ -49,12 +54,26 @@ type WordRepository interface {
 	// FindWordsByLevelAndTopicsAndLanguages finds words filtered by level, optional topics, and language pair
 	// If topicIDs is nil or empty, returns all words for the level (no topic filter)
 	FindWordsByLevelAndTopicsAndLanguages(ctx context.Context, levelID int64, topicIDs []int64, sourceLanguageID, targetLanguageID int16, limit int) ([]*Word, error)
+	// FindWordsUpdatedSince returns the IDs and updated_at of words changed at or after since, oldest first
+	FindWordsUpdatedSince(ctx context.Context, since time.Time) ([]*WordUpdate, error)
+	// BrowseWords returns one page of words matching the filters with their glosses, plus the total match count
+	BrowseWords(ctx context.Context, query WordBrowseQuery) ([]*WordBrowseItem, int, error)
 	// FindTranslationsForWord finds translation words for a given source word and target language
 	FindTranslationsForWord(ctx context.Context, sourceWordID int64, targetLanguageID int16, limit int) ([]*Word, error)
-	// SearchWords searches for words using multiple strategies (lemma, normalized, search_key)
-	SearchWords(ctx context.Context, query string, languageID int16, limit, offset int) ([]*Word, error)
+	// SearchWords searches for words by exact, prefix, normalized, substring and fuzzy matching,
+	// ranked in that order, and returns one page of results with the total match count
+	SearchWords(ctx context.Context, query string, languageID int16, limit, offset int) ([]*WordSearchResult, int, error)
 	// CountSearchWords returns the total count of words matching the search query
 	CountSearchWords(ctx context.Context, query string, languageID int16) (int, error)
+	// FindReverseTranslations finds source senses translated by the word matching query in targetLanguageID,
+	// ordered by source language then priority. sourceLanguageID 0 means any language
+	FindReverseTranslations(ctx context.Context, query string, targetLanguageID, sourceLanguageID int16, limit int) ([]*ReverseTranslation, error)
+	// FindWordSuggestEntries returns every word with the fields the suggest index needs
+	FindWordSuggestEntries(ctx context.Context) ([]*WordSuggestEntry, error)
+	// GetWordsVersion returns the word count and latest updated_at of the words table
+	GetWordsVersion(ctx context.Context) (*WordsVersion, error)
+	// SuggestWords returns prefix matches on lemma, search_key and romanization from the database
+	SuggestWords(ctx context.Context, query string, languageID int16, limit int) ([]*WordSuggestion, error)
 }
 
 // SenseRepository defines operations for sense data access
@@ -65,6 +84,60 @@ type SenseRepository interface {
 	FindSensesByWordIDs(ctx context.Context, wordIDs []int64) (map[int64][]*Sense, error)
 }
 
+// ExampleRepository defines operations for example sentence data access
+type ExampleRepository interface {
+	// FindExamplesBySenseIDs returns examples with their translations, keyed by sense ID
+	FindExamplesBySenseIDs(ctx context.Context, senseIDs []int64) (map[int64][]*Example, error)
+}
+
+// TextSearchRepository defines full-text search over definitions and example sentences
+type TextSearchRepository interface {
+	// SearchText returns one page of matching definitions and examples with highlighted snippets,
+	// plus the total match count
+	SearchText(ctx context.Context, query TextSearchQuery) ([]*TextSearchHit, int, error)
+}
+
+// TextAnalysisRepository defines the dictionary lookups of the text analyzer
+type TextAnalysisRepository interface {
+	// FindAnalyzerWords returns the words of a language whose lemma_normalized is one of the
+	// query forms, most frequent first, with their easiest level and gloss
+	FindAnalyzerWords(ctx context.Context, query AnalyzerWordQuery) ([]*AnalyzedWord, error)
+}
+
+// CharacterRepository defines operations for Han character data access
+type CharacterRepository interface {
+	// FindCharacterByLiteral returns the character with the given literal, falling back to
+	// the character whose simplified or traditional form it is
+	FindCharacterByLiteral(ctx context.Context, literal string) (*Character, error)
+	// FindReadingsByCharacterIDs returns readings keyed by character ID, ordered by language
+	FindReadingsByCharacterIDs(ctx context.Context, characterIDs []int64) (map[int64][]*CharacterReading, error)
+	// FindWordsByCharacterID returns up to limit words containing the character, most frequent first,
+	// plus the total number of such words
+	FindWordsByCharacterID(ctx context.Context, characterID int64, limit int) ([]*CharacterWord, int, error)
+	// SearchCharacters returns one page of characters matching the radical and stroke filters,
+	// plus the total match count
+	SearchCharacters(ctx context.Context, query CharacterSearchQuery) ([]*Character, int, error)
+}
+
+// WordGraphRepository defines the traversal steps of the word graph. Each method returns the edges
+// touching any of the given words, in either direction.
+type WordGraphRepository interface {
+	// FindRelationEdges returns word_relations edges of the given relation types
+	FindRelationEdges(ctx context.Context, wordIDs []int64, relationTypes []string) ([]*WordGraphEdge, error)
+	// FindTranslationEdges returns word-to-word translation edges, from source word to target word
+	FindTranslationEdges(ctx context.Context, wordIDs []int64) ([]*WordGraphEdge, error)
+	// FindSharedCharacterEdges links each word to up to neighborLimit other words per shared character
+	FindSharedCharacterEdges(ctx context.Context, wordIDs []int64, neighborLimit int) ([]*WordGraphEdge, error)
+}
+
+// ScriptConversionRepository defines operations for simplified/traditional conversion data access
+type ScriptConversionRepository interface {
+	// FindAllScriptConversions returns every conversion pair, imported pairs first
+	FindAllScriptConversions(ctx context.Context) ([]*ScriptConversion, error)
+	// GetScriptConversionsVersion returns the pair count and latest updated_at of the conversion table
+	GetScriptConversionsVersion(ctx context.Context) (*ScriptConversionsVersion, error)
+}
+
 // PartOfSpeechRepository defines operations for part of speech data access
 type PartOfSpeechRepository interface {
 	// FindAllPartsOfSpeech returns all parts of speech
@@ -77,3 +150,95 @@ type PartOfSpeechRepository interface {
 	FindPartsOfSpeechByIDs(ctx context.Context, ids []int16) (map[int16]*PartOfSpeech, error)
 }
 
+// EditorRepository defines transactional writes used by dictionary editors. Each method runs in its
+// own transaction, locks the word it changes and bumps updated_at of every word whose detail changed.
+// Methods editing an entry of a word return the ID of that word. editorID is the user making the
+// change; a revision of every changed word is recorded with it.
+type EditorRepository interface {
+	// CreateWord writes a new word with everything it carries. Translation and relation targets are
+	// created when missing.
+	CreateWord(ctx context.Context, editorID int64, word *WordJSON) (int64, error)
+	// UpdateWord updates the fields of a word
+	UpdateWord(ctx context.Context, editorID, wordID int64, edit *WordEdit) error
+	// DeleteWord deletes a word with its senses, pronunciations, relations, topics and the
+	// translations pointing to it
+	DeleteWord(ctx context.Context, editorID, wordID int64) error
+
+	// CreateSense adds a sense with its translations and examples to a word
+	CreateSense(ctx context.Context, editorID, wordID int64, sense *SenseJSON) (int64, error)
+	// UpdateSense updates the fields of a sense
+	UpdateSense(ctx context.Context, editorID, senseID int64, edit *SenseEdit) (int64, error)
+	// DeleteSense deletes a sense with its translations and examples
+	DeleteSense(ctx context.Context, editorID, senseID int64) (int64, error)
+
+	// UpsertSenseTranslation links a sense to a translation, creating the target word when missing,
+	// and returns the word ID and the target word ID
+	UpsertSenseTranslation(ctx context.Context, editorID, senseID int64, translation *SenseTranslationJSON) (int64, int64, error)
+	// DeleteSenseTranslation unlinks a translation from a sense
+	DeleteSenseTranslation(ctx context.Context, editorID, senseID, targetWordID int64) (int64, error)
+
+	// CreateExample adds an example with its translations to a sense and returns the word ID and the
+	// example ID
+	CreateExample(ctx context.Context, editorID, senseID int64, example *ExampleJSON) (int64, int64, error)
+	// UpdateExample replaces the content and translations of an example
+	UpdateExample(ctx context.Context, editorID, exampleID int64, example *ExampleJSON) (int64, error)
+	// DeleteExample deletes an example with its translations
+	DeleteExample(ctx context.Context, editorID, exampleID int64) (int64, error)
+
+	// UpsertPronunciation writes the pronunciation of a word for a dialect and returns its ID
+	UpsertPronunciation(ctx context.Context, editorID, wordID int64, pronunciation *PronunciationJSON) (int64, error)
+	// DeletePronunciation deletes a pronunciation
+	DeletePronunciation(ctx context.Context, editorID, pronunciationID int64) (int64, error)
+
+	// UpsertRelation relates a word to a target word, creating the target when missing, and returns
+	// the target word ID
+	UpsertRelation(ctx context.Context, editorID, wordID int64, relation *WordRelationJSON) (int64, error)
+	// DeleteRelation deletes a relation of a word
+	DeleteRelation(ctx context.Context, editorID, wordID, targetWordID int64, relationType string) error
+
+	// CreateTopic creates a topic and sets its ID
+	CreateTopic(ctx context.Context, topic *Topic) error
+	// UpdateTopic renames a topic and returns the words tagged with it
+	UpdateTopic(ctx context.Context, editorID int64, topic *Topic) ([]int64, error)
+	// DeleteTopic deletes a topic and returns the words that were tagged with it
+	DeleteTopic(ctx context.Context, editorID, topicID int64) ([]int64, error)
+
+	// RestoreRevision brings a word back to the snapshot of one of its revisions, recreating the word
+	// when it was deleted. It returns the word ID, which differs from wordID for recreated words, and
+	// the number of the restore revision.
+	RestoreRevision(ctx context.Context, editorID, wordID int64, revision int) (int64, int, error)
+}
+
+// WordRevisionRepository defines read access to the revision history of words
+type WordRevisionRepository interface {
+	// FindWordRevisions returns one page of revisions of a word, newest first and without snapshots,
+	// plus the total revision count
+	FindWordRevisions(ctx context.Context, wordID int64, limit, offset int) ([]*WordRevision, int, error)
+	// FindWordRevision returns one revision of a word with its snapshot
+	FindWordRevision(ctx context.Context, wordID int64, revision int) (*WordRevision, error)
+}
+
+// ProposalRepository defines storage of user-submitted correction proposals
+type ProposalRepository interface {
+	// CreateProposal stores a pending proposal and returns its ID. The sense or example it targets
+	// must belong to the proposal's word.
+	CreateProposal(ctx context.Context, proposal *Proposal) (int64, error)
+	// FindProposalByID returns a proposal with its word lemma, author and duplicate count
+	FindProposalByID(ctx context.Context, id int64) (*Proposal, error)
+	// FindProposals returns one page of proposals, oldest first, plus the total count
+	FindProposals(ctx context.Context, query ProposalQuery) ([]*Proposal, int, error)
+	// ReviewProposal settles a pending proposal as approved or rejected
+	ReviewProposal(ctx context.Context, id, moderatorID int64, status string, reason *string) error
+	// MergeProposal marks a pending proposal as a duplicate of another pending proposal for the
+	// same word. Duplicates already merged into it move to the target.
+	MergeProposal(ctx context.Context, id, intoID, moderatorID int64) error
+	// ReopenProposal puts a reviewed proposal back in the queue
+	ReopenProposal(ctx context.Context, id int64) error
+}
+
+// WordExportRepository defines keyset reads of full entries for exports
+type WordExportRepository interface {
+	// FindExportWords returns up to limit entries selected by the filter with word IDs above
+	// afterID, ordered by word ID
+	FindExportWords(ctx context.Context, filter WordExportFilter, afterID int64, limit int) ([]*ExportedWord, error)
+}
@@ -0,0 +1,10 @@
+package domain
+
+// ReverseTranslation is a source sense that lists the queried word among its translations
+type ReverseTranslation struct {
+	SourceWord       *Word  `json:"source_word"`
+	Sense            *Sense `json:"sense"`
+	Priority         *int16 `json:"priority,omitempty"` // sense_translations.priority, 1 = highest
+	TranslatedWordID int64  `json:"translated_word_id"`
+	TranslatedLemma  string `json:"translated_lemma"`
+}
@@ -0,0 +1,57 @@
+package domain
+
+import "time"
+
+// Revision actions stored in word_revisions.action
+const (
+	RevisionActionCreate  = "create"
+	RevisionActionUpdate  = "update"
+	RevisionActionDelete  = "delete"
+	RevisionActionRestore = "restore"
+	RevisionActionSeed    = "seed"
+)
+
+// Revision sources stored in word_revisions.source
+const (
+	RevisionSourceEditor = "editor"
+	RevisionSourceSeeder = "seeder"
+)
+
+// RevisionMeta describes who made a change and why
+type RevisionMeta struct {
+	Action  string
+	Source  string
+	UserID  *int64 // nil for the seeder
+	Summary string
+}
+
+// WordRevision is one entry of a word's revision history. Snapshot holds the whole entry after the
+// change (before it for deletes) and is only loaded for single revisions.
+type WordRevision struct {
+	ID        int64     `json:"id"`
+	WordID    int64     `json:"word_id"`
+	Revision  int       `json:"revision"`
+	Action    string    `json:"action"`
+	Source    string    `json:"source"`
+	UserID    *int64    `json:"user_id,omitempty"`
+	Username  *string   `json:"username,omitempty"`
+	Summary   *string   `json:"summary,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	Snapshot  *WordJSON `json:"snapshot,omitempty"`
+}
+
+// Revision change kinds
+const (
+	RevisionChangeAdded   = "added"
+	RevisionChangeRemoved = "removed"
+	RevisionChangeChanged = "changed"
+)
+
+// RevisionChange is one difference between two snapshots. Path addresses the changed value, e.g.
+// "senses[order=2].definition"; list items are identified by their natural key where they have one.
+type RevisionChange struct {
+	Path string `json:"path"`
+	Kind string `json:"kind"`
+	Old  any    `json:"old,omitempty"`
+	New  any    `json:"new,omitempty"`
+}
@@ -0,0 +1,57 @@
+package domain
+
+import "time"
+
+// Script conversion sources
+const (
+	ScriptSourceCharacters = "characters" // derived from characters.simplified/traditional
+	ScriptSourceFile       = "file"       // imported from a conversion file
+)
+
+// ScriptConversion maps one simplified Chinese character to one traditional character
+type ScriptConversion struct {
+	Simplified  string `json:"simplified"`
+	Traditional string `json:"traditional"`
+	Source      string `json:"source"`
+}
+
+// ScriptConversionsVersion fingerprints the conversion table so the in-memory converter can tell when it changed
+type ScriptConversionsVersion struct {
+	PairCount     int64
+	LastUpdatedAt time.Time
+}
+
+// ScriptForms holds a Chinese text in both scripts
+type ScriptForms struct {
+	Simplified  string `json:"simplified"`
+	Traditional string `json:"traditional"`
+}
+
+// ScriptConverter converts Chinese text between simplified and traditional script
+type ScriptConverter interface {
+	// ToSimplified replaces traditional characters with their simplified forms
+	ToSimplified(s string) string
+	// ToTraditional replaces simplified characters with their traditional forms
+	ToTraditional(s string) string
+}
+
+// NewScriptForms returns s in both scripts, or nil when conv is nil or the two forms are the same.
+// When s is already traditional it is kept as the traditional form, since simplification is many-to-one
+// (髮 and 發 both simplify to 发).
+func NewScriptForms(conv ScriptConverter, s string) *ScriptForms {
+	if conv == nil {
+		return nil
+	}
+	simplified := conv.ToSimplified(s)
+	traditional := s
+	if simplified == s {
+		traditional = conv.ToTraditional(s)
+	}
+	if simplified == traditional {
+		return nil
+	}
+	return &ScriptForms{
+		Simplified:  simplified,
+		Traditional: traditional,
+	}
+}
@@ -0,0 +1,19 @@
+package domain
+
+// AnalyzerWordQuery asks for the words whose lemma_normalized is one of the forms cut from an
+// analyzed text
+type AnalyzerWordQuery struct {
+	LanguageID            int16
+	Forms                 []string
+	TranslationLanguageID *int16 // restricts the gloss translation to a language
+}
+
+// AnalyzedWord is a dictionary word found in an analyzed text
+type AnalyzedWord struct {
+	WordID          int64      `json:"word_id"`
+	Lemma           string     `json:"lemma"`
+	LemmaNormalized string     `json:"lemma_normalized"`
+	Romanization    *string    `json:"romanization,omitempty"`
+	Level           *Level     `json:"level,omitempty"` // easiest level among the word's senses
+	Gloss           *WordGloss `json:"gloss,omitempty"`
+}
@@ -0,0 +1,34 @@
+package domain
+
+// Text search sources
+const (
+	TextSourceDefinition         = "definition"          // senses.definition
+	TextSourceExample            = "example"             // examples.content
+	TextSourceExampleTranslation = "example_translation" // example_translations.content
+)
+
+// TextSearchQuery describes a full-text search over definitions and example sentences
+type TextSearchQuery struct {
+	Query        string
+	LanguageID   int16  // language the searched text is written in
+	LanguageCode string // picks the tokenizer: stemmed English, simple, or substring for zh/ja/ko
+	Definitions  bool
+	Examples     bool
+	Translations bool
+	Limit        int
+	Offset       int
+}
+
+// TextSearchHit is one definition or example sentence that matched, with the word and sense it belongs to
+type TextSearchHit struct {
+	Source         string  `json:"source"`
+	WordID         int64   `json:"word_id"`
+	WordLanguageID int16   `json:"word_language_id"`
+	Lemma          string  `json:"lemma"`
+	Romanization   *string `json:"romanization,omitempty"`
+	SenseID        int64   `json:"sense_id"`
+	SenseOrder     int16   `json:"sense_order"`
+	ExampleID      *int64  `json:"example_id,omitempty"`
+	Snippet        string  `json:"snippet"` // HTML-escaped, matches wrapped in <mark>
+	Rank           float64 `json:"rank"`
+}
@@ -0,0 +1,33 @@
+package domain
+
+// Word list sort orders
+const (
+	WordSortFrequency = "frequency" // most frequent first
+	WordSortAlpha     = "alpha"     // by lemma_normalized, falling back to lemma
+)
+
+// WordBrowseQuery filters a paginated word listing. Nil filters are not applied.
+type WordBrowseQuery struct {
+	LanguageID            *int16
+	TopicID               *int64
+	LevelID               *int64  // matches words with any sense at this level
+	PartOfSpeech          *string // part of speech code, matches words with any sense of it
+	TranslationLanguageID *int16  // restricts the gloss translation to a language
+	Sort                  string
+	Limit                 int
+	Offset                int
+}
+
+// WordGloss is a one-line summary of a word: its first sense and that sense's first translation
+type WordGloss struct {
+	SenseID           int64   `json:"sense_id"`
+	Definition        string  `json:"definition"`
+	TranslationWordID *int64  `json:"translation_word_id,omitempty"`
+	Translation       *string `json:"translation,omitempty"`
+}
+
+// WordBrowseItem is a word in a word listing with its gloss (nil for words without senses)
+type WordBrowseItem struct {
+	Word  *Word      `json:"word"`
+	Gloss *WordGloss `json:"gloss,omitempty"`
+}
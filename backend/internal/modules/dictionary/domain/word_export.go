@@ -0,0 +1,15 @@
+package domain
+
+// WordExportFilter selects the words included in an export. Nil filters are not applied.
+type WordExportFilter struct {
+	LanguageID *int16
+	LevelID    *int64 // matches words with any sense at this level
+	TopicID    *int64
+	WordIDs    []int64 // an explicit selection such as a user's word list; nil exports every word
+}
+
+// ExportedWord is an entry in an export with the ID of its word
+type ExportedWord struct {
+	ID   int64
+	Word *WordJSON
+}
@@ -0,0 +1,24 @@
+package domain
+
+// Word graph edge types besides the word_relations relation types
+const (
+	GraphEdgeTranslation     = "translation"      // sense_translations, collapsed to word level
+	GraphEdgeSharedCharacter = "shared_character" // words sharing a character through word_characters
+)
+
+// WordGraphEdge connects two words in the word graph
+type WordGraphEdge struct {
+	FromWordID  int64   `json:"from_word_id"`
+	ToWordID    int64   `json:"to_word_id"`
+	Type        string  `json:"type"`                   // relation type, translation or shared_character
+	Note        *string `json:"note,omitempty"`         // relation note
+	Priority    *int16  `json:"priority,omitempty"`     // translation priority (1 = highest)
+	CharacterID *int64  `json:"character_id,omitempty"` // shared character
+	Character   *string `json:"character,omitempty"`    // shared character literal
+}
+
+// WordGraphNode is a word reached by the traversal, with its distance from the root word
+type WordGraphNode struct {
+	Word  *Word `json:"word"`
+	Depth int   `json:"depth"`
+}
@@ -0,0 +1,94 @@
+package domain
+
+// WordJSON is one line of the word JSONL seed files (0002/0003/0004_word_*.jsonl).
+// The seeder and the editor API both write words through this format.
+type WordJSON struct {
+	Language        string              `json:"language"`
+	Lemma           string              `json:"lemma"`
+	LemmaNormalized *string             `json:"lemma_normalized,omitempty"`
+	SearchKey       *string             `json:"search_key,omitempty"`
+	Romanization    *string             `json:"romanization,omitempty"`
+	ScriptCode      *string             `json:"script_code,omitempty"`
+	FrequencyRank   *int                `json:"frequency_rank,omitempty"`
+	Note            *string             `json:"note,omitempty"`
+	Topics          []string            `json:"topics,omitempty"`
+	Pronunciations  []PronunciationJSON `json:"pronunciations,omitempty"`
+	Relations       []WordRelationJSON  `json:"relations,omitempty"`
+	Senses          []SenseJSON         `json:"senses,omitempty"`
+	Characters      []CharacterJSON     `json:"characters,omitempty"` // used mainly for Chinese words
+}
+
+type PronunciationJSON struct {
+	Dialect  string  `json:"dialect"`
+	IPA      *string `json:"ipa,omitempty"`
+	Phonetic *string `json:"phonetic,omitempty"`
+	AudioURL *string `json:"audio_url,omitempty"`
+}
+
+type WordRelationJSON struct {
+	RelationType string          `json:"relation_type"`
+	Note         *string         `json:"note,omitempty"`
+	TargetWord   RelatedWordJSON `json:"target_word"`
+}
+
+type RelatedWordJSON struct {
+	Language        string   `json:"language"`
+	Lemma           string   `json:"lemma"`
+	LemmaNormalized *string  `json:"lemma_normalized,omitempty"`
+	SearchKey       *string  `json:"search_key,omitempty"`
+	PartOfSpeech    string   `json:"part_of_speech"`
+	Romanization    *string  `json:"romanization,omitempty"`
+	ScriptCode      *string  `json:"script_code,omitempty"`
+	FrequencyRank   *int     `json:"frequency_rank,omitempty"`
+	Note            *string  `json:"note,omitempty"`
+	Topics          []string `json:"topics,omitempty"`
+}
+
+type SenseJSON struct {
+	Order              int                    `json:"order"`
+	PartOfSpeech       string                 `json:"part_of_speech"`
+	DefinitionLanguage string                 `json:"definition_language"`
+	Definition         string                 `json:"definition"`
+	UsageLabel         *string                `json:"usage_label,omitempty"`
+	Level              *string                `json:"level,omitempty"`
+	Note               *string                `json:"note,omitempty"`
+	Translations       []SenseTranslationJSON `json:"translations,omitempty"`
+	Examples           []ExampleJSON          `json:"examples,omitempty"`
+}
+
+type SenseTranslationJSON struct {
+	Priority   int             `json:"priority"`
+	Note       *string         `json:"note,omitempty"`
+	TargetWord RelatedWordJSON `json:"target_word"`
+}
+
+type ExampleJSON struct {
+	Language     string                   `json:"language"`
+	Content      string                   `json:"content"`
+	AudioURL     *string                  `json:"audio_url,omitempty"`
+	Translations []ExampleTranslationJSON `json:"translations,omitempty"`
+}
+
+type ExampleTranslationJSON struct {
+	Language string `json:"language"`
+	Content  string `json:"content"`
+}
+
+type CharacterJSON struct {
+	Literal     string                 `json:"literal"`
+	Simplified  *string                `json:"simplified,omitempty"`
+	Traditional *string                `json:"traditional,omitempty"`
+	ScriptCode  string                 `json:"script_code"`
+	Strokes     *int                   `json:"strokes,omitempty"`
+	Radical     *string                `json:"radical,omitempty"`
+	Level       *string                `json:"level,omitempty"` // level code will be converted to level_id
+	CharOrder   int                    `json:"char_order"`
+	Readings    []CharacterReadingJSON `json:"readings,omitempty"`
+}
+
+type CharacterReadingJSON struct {
+	Language    string  `json:"language"`
+	Reading     string  `json:"reading"`
+	ReadingType *string `json:"reading_type,omitempty"`
+	Note        *string `json:"note,omitempty"`
+}
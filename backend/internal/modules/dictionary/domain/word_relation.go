@@ -1,5 +1,15 @@
 package domain
 
+// Word relation types stored in word_relations.relation_type
+const (
+	RelationTypeSynonym = "synonym"
+	RelationTypeAntonym = "antonym"
+	RelationTypeRelated = "related"
+)
+
+// RelationTypes lists every word relation type
+var RelationTypes = []string{RelationTypeSynonym, RelationTypeAntonym, RelationTypeRelated}
+
 // WordRelation represents a relationship between words
 type WordRelation struct {
 	RelationType string  `json:"relation_type"` // 'synonym', 'antonym', 'related'
@@ -0,0 +1,17 @@
+package domain
+
+// Search match types, from most to least relevant
+const (
+	MatchTypeExact      = "exact"      // lemma equals the query
+	MatchTypePrefix     = "prefix"     // lemma starts with the query
+	MatchTypeNormalized = "normalized" // lemma_normalized or search_key starts with the query
+	MatchTypeSubstring  = "substring"  // lemma, lemma_normalized or search_key contains the query
+	MatchTypeFuzzy      = "fuzzy"      // trigram similarity above the threshold
+)
+
+// WordSearchResult is a word matched by a search with how it matched
+type WordSearchResult struct {
+	Word      *Word   `json:"word"`
+	MatchType string  `json:"match_type"`
+	Score     float64 `json:"score"` // trigram similarity between the query and the closest field, 0..1
+}
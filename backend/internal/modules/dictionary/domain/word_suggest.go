@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+// Suggestion match fields, from most to least relevant
+const (
+	SuggestMatchLemma        = "lemma"        // lemma (or its folded form) starts with the query
+	SuggestMatchSearchKey    = "search_key"   // search_key or lemma_normalized starts with the query
+	SuggestMatchRomanization = "romanization" // romanization starts with the query
+)
+
+// WordSuggestEntry is the subset of a word the suggest index is built from
+type WordSuggestEntry struct {
+	ID              int64
+	LanguageID      int16
+	Lemma           string
+	LemmaNormalized *string
+	SearchKey       *string
+	Romanization    *string
+	FrequencyRank   *int
+}
+
+// WordSuggestion is a word offered as a prefix completion
+type WordSuggestion struct {
+	WordID        int64   `json:"word_id"`
+	LanguageID    int16   `json:"language_id"`
+	Lemma         string  `json:"lemma"`
+	Romanization  *string `json:"romanization,omitempty"`
+	FrequencyRank *int    `json:"frequency_rank,omitempty"`
+	MatchedOn     string  `json:"matched_on"`
+}
+
+// WordsVersion fingerprints the words table so cached indexes can tell when it changed
+type WordsVersion struct {
+	WordCount     int64
+	LastUpdatedAt time.Time
+}
+
+// WordUpdate is a word changed after a point in time
+type WordUpdate struct {
+	ID        int64
+	UpdatedAt time.Time
+}
@@ -0,0 +1,154 @@
+package dictionary
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// characterRepository implements CharacterRepository using sqlc
+type characterRepository struct {
+	*DictionaryRepository
+}
+
+// FindCharacterByLiteral returns the character with the given literal, falling back to
+// the character whose simplified or traditional form it is
+func (r *characterRepository) FindCharacterByLiteral(ctx context.Context, literal string) (*domain.Character, error) {
+	row, err := r.queries.FindCharacterByLiteral(ctx, literal)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindCharacterByLiteral")
+	}
+
+	return mapCharacterRow(row), nil
+}
+
+// FindReadingsByCharacterIDs returns readings keyed by character ID, ordered by language
+func (r *characterRepository) FindReadingsByCharacterIDs(ctx context.Context, characterIDs []int64) (map[int64][]*domain.CharacterReading, error) {
+	result := make(map[int64][]*domain.CharacterReading)
+	if len(characterIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.queries.FindCharacterReadingsByCharacterIDs(ctx, characterIDs)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindCharacterReadingsByCharacterIDs")
+	}
+
+	for _, row := range rows {
+		result[row.CharacterID] = append(result[row.CharacterID], &domain.CharacterReading{
+			ID:          row.ID,
+			CharacterID: row.CharacterID,
+			LanguageID:  row.LanguageID,
+			Reading:     row.Reading,
+			ReadingType: textPtr(row.ReadingType),
+			Note:        textPtr(row.Note),
+		})
+	}
+
+	return result, nil
+}
+
+// FindWordsByCharacterID returns up to limit words containing the character, most frequent first,
+// plus the total number of such words
+func (r *characterRepository) FindWordsByCharacterID(ctx context.Context, characterID int64, limit int) ([]*domain.CharacterWord, int, error) {
+	total, err := r.queries.CountWordsByCharacterID(ctx, characterID)
+	if err != nil {
+		return nil, 0, sharederrors.MapDictionaryRepositoryError(err, "FindWordsByCharacterID")
+	}
+	if total == 0 {
+		return []*domain.CharacterWord{}, 0, nil
+	}
+
+	rows, err := r.queries.FindWordsByCharacterID(ctx, db.FindWordsByCharacterIDParams{
+		CharacterID: characterID,
+		Limit:       int32(limit),
+	})
+	if err != nil {
+		return nil, 0, sharederrors.MapDictionaryRepositoryError(err, "FindWordsByCharacterID")
+	}
+
+	words := &wordRepository{DictionaryRepository: r.DictionaryRepository}
+	results := make([]*domain.CharacterWord, 0, len(rows))
+	for _, row := range rows {
+		results = append(results, &domain.CharacterWord{
+			Word: words.mapWordRow(db.Word{
+				ID:              row.ID,
+				LanguageID:      row.LanguageID,
+				Lemma:           row.Lemma,
+				LemmaNormalized: row.LemmaNormalized,
+				SearchKey:       row.SearchKey,
+				Romanization:    row.Romanization,
+				ScriptCode:      row.ScriptCode,
+				FrequencyRank:   row.FrequencyRank,
+				Note:            row.Note,
+				CreatedAt:       row.CreatedAt,
+				UpdatedAt:       row.UpdatedAt,
+			}),
+			CharOrder: row.CharOrder,
+		})
+	}
+
+	return results, int(total), nil
+}
+
+// SearchCharacters returns one page of characters matching the radical and stroke filters,
+// plus the total match count
+func (r *characterRepository) SearchCharacters(ctx context.Context, query domain.CharacterSearchQuery) ([]*domain.Character, int, error) {
+	params := db.SearchCharactersParams{
+		Limit:  int32(query.Limit),
+		Offset: int32(query.Offset),
+	}
+	if query.Radical != nil {
+		params.Radical = pgtype.Text{String: *query.Radical, Valid: true}
+	}
+	if query.Strokes != nil {
+		params.Strokes = pgtype.Int2{Int16: *query.Strokes, Valid: true}
+	}
+
+	rows, err := r.queries.SearchCharacters(ctx, params)
+	if err != nil {
+		return nil, 0, sharederrors.MapDictionaryRepositoryError(err, "SearchCharacters")
+	}
+
+	characters := make([]*domain.Character, 0, len(rows))
+	total := 0
+	for _, row := range rows {
+		total = int(row.TotalCount)
+		characters = append(characters, mapCharacterRow(db.Character{
+			ID:          row.ID,
+			Literal:     row.Literal,
+			Simplified:  row.Simplified,
+			Traditional: row.Traditional,
+			ScriptCode:  row.ScriptCode,
+			Strokes:     row.Strokes,
+			Radical:     row.Radical,
+			LevelID:     row.LevelID,
+		}))
+	}
+
+	return characters, total, nil
+}
+
+// mapCharacterRow converts a characters row to a domain character
+func mapCharacterRow(row db.Character) *domain.Character {
+	var strokes *int16
+	if row.Strokes.Valid {
+		val := row.Strokes.Int16
+		strokes = &val
+	}
+
+	return &domain.Character{
+		ID:          row.ID,
+		Literal:     row.Literal,
+		Simplified:  textPtr(row.Simplified),
+		Traditional: textPtr(row.Traditional),
+		ScriptCode:  row.ScriptCode,
+		Strokes:     strokes,
+		Radical:     textPtr(row.Radical),
+		LevelID:     int8Ptr(row.LevelID),
+	}
+}
@@ -5,12 +5,14 @@ import (
 
 	"github.com/english-coach/backend/internal/modules/dictionary/domain"
 	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+	"github.com/english-coach/backend/internal/shared/normalize"
 )
 
 // DictionaryRepository implements dictionary repository interfaces using sqlc
 type DictionaryRepository struct {
 	pool    *pgxpool.Pool
 	queries *db.Queries
+	scripts domain.ScriptConverter // optional, simplifies Chinese search queries
 }
 
 // NewDictionaryRepository creates a new dictionary repository
@@ -21,6 +23,20 @@ func NewDictionaryRepository(pool *pgxpool.Pool) *DictionaryRepository {
 	}
 }
 
+// SetScriptConverter makes search queries match simplified lemma_normalized values when written in
+// traditional script. It must be called before the repositories are used.
+func (r *DictionaryRepository) SetScriptConverter(scripts domain.ScriptConverter) {
+	r.scripts = scripts
+}
+
+// simplifier returns the script converter for query normalization, or nil when none is set
+func (r *DictionaryRepository) simplifier() normalize.Simplifier {
+	if r.scripts == nil {
+		return nil
+	}
+	return r.scripts
+}
+
 // LanguageRepository returns a LanguageRepository implementation
 func (r *DictionaryRepository) LanguageRepository() domain.LanguageRepository {
 	return &languageRepository{
@@ -56,9 +72,79 @@ func (r *DictionaryRepository) SenseRepository() domain.SenseRepository {
 	}
 }
 
+// ExampleRepository returns an ExampleRepository implementation
+func (r *DictionaryRepository) ExampleRepository() domain.ExampleRepository {
+	return &exampleRepository{
+		DictionaryRepository: r,
+	}
+}
+
+// TextSearchRepository returns a TextSearchRepository implementation
+func (r *DictionaryRepository) TextSearchRepository() domain.TextSearchRepository {
+	return &textSearchRepository{
+		DictionaryRepository: r,
+	}
+}
+
+// TextAnalysisRepository returns a TextAnalysisRepository implementation
+func (r *DictionaryRepository) TextAnalysisRepository() domain.TextAnalysisRepository {
+	return &textAnalysisRepository{
+		DictionaryRepository: r,
+	}
+}
+
+// CharacterRepository returns a CharacterRepository implementation
+func (r *DictionaryRepository) CharacterRepository() domain.CharacterRepository {
+	return &characterRepository{
+		DictionaryRepository: r,
+	}
+}
+
+// WordGraphRepository returns a WordGraphRepository implementation
+func (r *DictionaryRepository) WordGraphRepository() domain.WordGraphRepository {
+	return &wordGraphRepository{
+		DictionaryRepository: r,
+	}
+}
+
+// ScriptConversionRepository returns a ScriptConversionRepository implementation
+func (r *DictionaryRepository) ScriptConversionRepository() domain.ScriptConversionRepository {
+	return &scriptConversionRepository{
+		DictionaryRepository: r,
+	}
+}
+
 // PartOfSpeechRepository returns a PartOfSpeechRepository implementation
 func (r *DictionaryRepository) PartOfSpeechRepository() domain.PartOfSpeechRepository {
 	return &partOfSpeechRepository{
 		DictionaryRepository: r,
 	}
 }
+
+// EditorRepository returns an EditorRepository implementation
+func (r *DictionaryRepository) EditorRepository() domain.EditorRepository {
+	return &editorRepository{
+		DictionaryRepository: r,
+	}
+}
+
+// WordRevisionRepository returns a WordRevisionRepository implementation
+func (r *DictionaryRepository) WordRevisionRepository() domain.WordRevisionRepository {
+	return &wordRevisionRepository{
+		DictionaryRepository: r,
+	}
+}
+
+// ProposalRepository returns a ProposalRepository implementation
+func (r *DictionaryRepository) ProposalRepository() domain.ProposalRepository {
+	return &proposalRepository{
+		DictionaryRepository: r,
+	}
+}
+
+// WordExportRepository returns a WordExportRepository implementation
+func (r *DictionaryRepository) WordExportRepository() domain.WordExportRepository {
+	return &wordExportRepository{
+		DictionaryRepository: r,
+	}
+}
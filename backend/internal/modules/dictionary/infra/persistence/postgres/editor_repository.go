@@ -0,0 +1,726 @@
+package dictionary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// editorRepository implements EditorRepository using sqlc and the seeder's WordUpserter
+type editorRepository struct {
+	*DictionaryRepository
+}
+
+// editorTx is the state shared by the steps of one editor transaction
+type editorTx struct {
+	tx       pgx.Tx
+	queries  *db.Queries
+	upserter *WordUpserter
+	editorID int64
+}
+
+// inTx runs fn in a transaction and commits it when fn succeeds
+func (r *editorRepository) inTx(ctx context.Context, editorID int64, operation string, fn func(etx *editorTx) error) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return sharederrors.MapDictionaryRepositoryError(err, operation)
+	}
+	defer tx.Rollback(ctx)
+
+	etx := &editorTx{
+		tx:       tx,
+		queries:  r.queries.WithTx(tx),
+		upserter: NewWordUpserter(r.simplifier()),
+		editorID: editorID,
+	}
+	if err := fn(etx); err != nil {
+		return sharederrors.MapDictionaryRepositoryError(err, operation)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return sharederrors.MapDictionaryRepositoryError(err, operation)
+	}
+	return nil
+}
+
+// lockWord locks a word for the rest of the transaction
+func (t *editorTx) lockWord(ctx context.Context, wordID int64) (db.LockWordRow, error) {
+	row, err := t.queries.LockWord(ctx, wordID)
+	if err != nil {
+		return row, sharederrors.MapDictionaryRepositoryError(err, "LockWord")
+	}
+	return row, nil
+}
+
+// lockSenseWord locks the word owning a sense and returns its ID
+func (t *editorTx) lockSenseWord(ctx context.Context, senseID int64) (int64, error) {
+	wordID, err := t.queries.FindSenseWordID(ctx, senseID)
+	if err != nil {
+		return 0, sharederrors.MapDictionaryRepositoryError(err, "FindSenseWordID")
+	}
+	if _, err := t.lockWord(ctx, wordID); err != nil {
+		return 0, err
+	}
+	return wordID, nil
+}
+
+// lockExampleWord locks the word owning an example and returns its ID
+func (t *editorTx) lockExampleWord(ctx context.Context, exampleID int64) (int64, error) {
+	wordID, err := t.queries.FindExampleWordID(ctx, exampleID)
+	if err != nil {
+		return 0, sharederrors.MapDictionaryRepositoryError(err, "FindExampleWordID")
+	}
+	if _, err := t.lockWord(ctx, wordID); err != nil {
+		return 0, err
+	}
+	return wordID, nil
+}
+
+// checkSenseOrder fails when another sense of the word already uses order
+func (t *editorTx) checkSenseOrder(ctx context.Context, wordID int64, order int, excludeSenseID int64) error {
+	taken, err := t.queries.ExistsSenseOrder(ctx, db.ExistsSenseOrderParams{
+		WordID:         wordID,
+		SenseOrder:     int16(order),
+		ExcludeSenseID: excludeSenseID,
+	})
+	if err != nil {
+		return err
+	}
+	if taken {
+		return domain.ErrSenseOrderTaken
+	}
+	return nil
+}
+
+// deleteSenses deletes senses together with their translations and examples
+func (t *editorTx) deleteSenses(ctx context.Context, senseIDs []int64) (int64, error) {
+	return deleteSenses(ctx, t.queries, senseIDs)
+}
+
+// touch bumps updated_at of the given words so cached details are refreshed
+func (t *editorTx) touch(ctx context.Context, wordIDs ...int64) error {
+	if len(wordIDs) == 0 {
+		return nil
+	}
+	return t.queries.TouchWords(ctx, wordIDs)
+}
+
+// record appends a revision of a word made by the editor
+func (t *editorTx) record(ctx context.Context, wordID int64, action, summary string) (int, error) {
+	return RecordRevision(ctx, t.tx, wordID, domain.RevisionMeta{
+		Action:  action,
+		Source:  domain.RevisionSourceEditor,
+		UserID:  &t.editorID,
+		Summary: summary,
+	})
+}
+
+// changed touches words whose entry changed and records an update revision of each. Touching locks
+// the word rows, so words changed as a side effect get their revisions numbered safely too.
+func (t *editorTx) changed(ctx context.Context, summary string, wordIDs ...int64) error {
+	if err := t.touch(ctx, wordIDs...); err != nil {
+		return err
+	}
+	for _, wordID := range wordIDs {
+		if _, err := t.record(ctx, wordID, domain.RevisionActionUpdate, summary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// referencingOthers returns the other words whose translations or relations point to wordID
+func (t *editorTx) referencingOthers(ctx context.Context, wordID int64) ([]int64, error) {
+	referencing, err := t.queries.FindWordsReferencingWord(ctx, wordID)
+	if err != nil {
+		return nil, err
+	}
+	others := make([]int64, 0, len(referencing))
+	for _, id := range referencing {
+		if id != wordID {
+			others = append(others, id)
+		}
+	}
+	return others, nil
+}
+
+// CreateWord writes a new word with everything it carries
+func (r *editorRepository) CreateWord(ctx context.Context, editorID int64, word *domain.WordJSON) (int64, error) {
+	var wordID int64
+	err := r.inTx(ctx, editorID, "CreateWord", func(t *editorTx) error {
+		languageID, err := t.upserter.LanguageID(ctx, t.tx, word.Language)
+		if err != nil {
+			return err
+		}
+		existingID, err := t.upserter.FindWordID(ctx, t.tx, languageID, word.Lemma)
+		if err != nil {
+			return err
+		}
+		if existingID != 0 {
+			return domain.ErrWordExists
+		}
+
+		wordID, err = t.upserter.UpsertWord(ctx, t.tx, word.Language, *word)
+		if err != nil {
+			return err
+		}
+		_, err = t.record(ctx, wordID, domain.RevisionActionCreate, "created word")
+		return err
+	})
+	return wordID, err
+}
+
+// UpdateWord updates the fields of a word and refreshes the words showing it
+func (r *editorRepository) UpdateWord(ctx context.Context, editorID, wordID int64, edit *domain.WordEdit) error {
+	return r.inTx(ctx, editorID, "UpdateWord", func(t *editorTx) error {
+		word, err := t.lockWord(ctx, wordID)
+		if err != nil {
+			return err
+		}
+
+		if edit.Lemma != word.Lemma {
+			existingID, err := t.upserter.FindWordID(ctx, t.tx, word.LanguageID, edit.Lemma)
+			if err != nil {
+				return err
+			}
+			if existingID != 0 && existingID != wordID {
+				return domain.ErrWordExists
+			}
+		}
+
+		lemmaNormalized, searchKey := t.upserter.FillSearchKeys(word.LanguageCode, edit.Lemma, edit.Romanization, nil, nil)
+		if err := t.queries.UpdateWord(ctx, db.UpdateWordParams{
+			ID:              wordID,
+			Lemma:           edit.Lemma,
+			LemmaNormalized: pgText(lemmaNormalized),
+			SearchKey:       pgText(searchKey),
+			Romanization:    pgText(edit.Romanization),
+			ScriptCode:      pgText(edit.ScriptCode),
+			FrequencyRank:   pgInt4(edit.FrequencyRank),
+			Note:            pgText(edit.Note),
+		}); err != nil {
+			return err
+		}
+
+		if edit.Topics != nil {
+			if err := t.queries.DeleteWordTopics(ctx, wordID); err != nil {
+				return err
+			}
+			if err := t.upserter.AddWordTopics(ctx, t.tx, wordID, edit.Topics); err != nil {
+				return err
+			}
+		}
+
+		if err := t.changed(ctx, "updated word", wordID); err != nil {
+			return err
+		}
+
+		// Translations and relations of other words show the lemma
+		referencing, err := t.referencingOthers(ctx, wordID)
+		if err != nil {
+			return err
+		}
+		return t.changed(ctx, fmt.Sprintf("linked word %q updated", edit.Lemma), referencing...)
+	})
+}
+
+// DeleteWord deletes a word and every entry attached to it
+func (r *editorRepository) DeleteWord(ctx context.Context, editorID, wordID int64) error {
+	return r.inTx(ctx, editorID, "DeleteWord", func(t *editorTx) error {
+		word, err := t.lockWord(ctx, wordID)
+		if err != nil {
+			return err
+		}
+		// The delete revision keeps the entry so it can be restored
+		if _, err := t.record(ctx, wordID, domain.RevisionActionDelete, "deleted word"); err != nil {
+			return err
+		}
+
+		referencing, err := t.referencingOthers(ctx, wordID)
+		if err != nil {
+			return err
+		}
+
+		if err := ClearWordEntry(ctx, t.queries, wordID); err != nil {
+			return err
+		}
+		if err := t.queries.DeleteSenseTranslationsToWord(ctx, wordID); err != nil {
+			return err
+		}
+		if err := t.queries.DeleteWordRelationsOfWord(ctx, wordID); err != nil {
+			return err
+		}
+		if _, err := t.queries.DeleteWord(ctx, wordID); err != nil {
+			return err
+		}
+
+		return t.changed(ctx, fmt.Sprintf("linked word %q deleted", word.Lemma), referencing...)
+	})
+}
+
+// CreateSense adds a sense with its translations and examples to a word
+func (r *editorRepository) CreateSense(ctx context.Context, editorID, wordID int64, sense *domain.SenseJSON) (int64, error) {
+	var senseID int64
+	err := r.inTx(ctx, editorID, "CreateSense", func(t *editorTx) error {
+		if _, err := t.lockWord(ctx, wordID); err != nil {
+			return err
+		}
+		if err := t.checkSenseOrder(ctx, wordID, sense.Order, 0); err != nil {
+			return err
+		}
+
+		var err error
+		senseID, err = t.upserter.UpsertSense(ctx, t.tx, wordID, *sense)
+		if err != nil {
+			return err
+		}
+		return t.changed(ctx, fmt.Sprintf("added sense %d", sense.Order), wordID)
+	})
+	return senseID, err
+}
+
+// UpdateSense updates the fields of a sense
+func (r *editorRepository) UpdateSense(ctx context.Context, editorID, senseID int64, edit *domain.SenseEdit) (int64, error) {
+	var wordID int64
+	err := r.inTx(ctx, editorID, "UpdateSense", func(t *editorTx) error {
+		var err error
+		wordID, err = t.lockSenseWord(ctx, senseID)
+		if err != nil {
+			return err
+		}
+		if err := t.checkSenseOrder(ctx, wordID, edit.Order, senseID); err != nil {
+			return err
+		}
+
+		posID, err := t.upserter.PartOfSpeechID(ctx, t.tx, edit.PartOfSpeech)
+		if err != nil {
+			return err
+		}
+		definitionLanguageID, err := t.upserter.LanguageID(ctx, t.tx, edit.DefinitionLanguage)
+		if err != nil {
+			return err
+		}
+		levelID, err := t.upserter.LevelID(ctx, t.tx, edit.Level)
+		if err != nil {
+			return err
+		}
+
+		if err := t.queries.UpdateSense(ctx, db.UpdateSenseParams{
+			ID:                   senseID,
+			SenseOrder:           int16(edit.Order),
+			PartOfSpeechID:       posID,
+			Definition:           edit.Definition,
+			DefinitionLanguageID: definitionLanguageID,
+			UsageLabel:           pgText(edit.UsageLabel),
+			LevelID:              pgInt8(levelID),
+			Note:                 pgText(edit.Note),
+		}); err != nil {
+			return err
+		}
+		return t.changed(ctx, fmt.Sprintf("updated sense %d", edit.Order), wordID)
+	})
+	return wordID, err
+}
+
+// DeleteSense deletes a sense with its translations and examples
+func (r *editorRepository) DeleteSense(ctx context.Context, editorID, senseID int64) (int64, error) {
+	var wordID int64
+	err := r.inTx(ctx, editorID, "DeleteSense", func(t *editorTx) error {
+		var err error
+		wordID, err = t.lockSenseWord(ctx, senseID)
+		if err != nil {
+			return err
+		}
+		if _, err := t.deleteSenses(ctx, []int64{senseID}); err != nil {
+			return err
+		}
+		return t.changed(ctx, "deleted a sense", wordID)
+	})
+	return wordID, err
+}
+
+// UpsertSenseTranslation links a sense to a translation, creating the target word when missing
+func (r *editorRepository) UpsertSenseTranslation(ctx context.Context, editorID, senseID int64, translation *domain.SenseTranslationJSON) (int64, int64, error) {
+	var wordID, targetWordID int64
+	err := r.inTx(ctx, editorID, "UpsertSenseTranslation", func(t *editorTx) error {
+		var err error
+		wordID, err = t.lockSenseWord(ctx, senseID)
+		if err != nil {
+			return err
+		}
+		targetWordID, err = t.upserter.UpsertSenseTranslation(ctx, t.tx, senseID, *translation)
+		if err != nil {
+			return err
+		}
+		target := translation.TargetWord
+		return t.changed(ctx, fmt.Sprintf("linked translation %s:%s", target.Language, target.Lemma), wordID)
+	})
+	return wordID, targetWordID, err
+}
+
+// DeleteSenseTranslation unlinks a translation from a sense
+func (r *editorRepository) DeleteSenseTranslation(ctx context.Context, editorID, senseID, targetWordID int64) (int64, error) {
+	var wordID int64
+	err := r.inTx(ctx, editorID, "DeleteSenseTranslation", func(t *editorTx) error {
+		var err error
+		wordID, err = t.lockSenseWord(ctx, senseID)
+		if err != nil {
+			return err
+		}
+		deleted, err := t.queries.DeleteSenseTranslation(ctx, db.DeleteSenseTranslationParams{
+			SourceSenseID: senseID,
+			TargetWordID:  targetWordID,
+		})
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return domain.ErrSenseTranslationNotFound
+		}
+		return t.changed(ctx, "unlinked a translation", wordID)
+	})
+	return wordID, err
+}
+
+// CreateExample adds an example with its translations to a sense
+func (r *editorRepository) CreateExample(ctx context.Context, editorID, senseID int64, example *domain.ExampleJSON) (int64, int64, error) {
+	var wordID, exampleID int64
+	err := r.inTx(ctx, editorID, "CreateExample", func(t *editorTx) error {
+		var err error
+		wordID, err = t.lockSenseWord(ctx, senseID)
+		if err != nil {
+			return err
+		}
+		exampleID, err = t.upserter.UpsertExample(ctx, t.tx, senseID, *example)
+		if err != nil {
+			return err
+		}
+		return t.changed(ctx, "added an example", wordID)
+	})
+	return wordID, exampleID, err
+}
+
+// UpdateExample replaces the content and translations of an example
+func (r *editorRepository) UpdateExample(ctx context.Context, editorID, exampleID int64, example *domain.ExampleJSON) (int64, error) {
+	var wordID int64
+	err := r.inTx(ctx, editorID, "UpdateExample", func(t *editorTx) error {
+		var err error
+		wordID, err = t.lockExampleWord(ctx, exampleID)
+		if err != nil {
+			return err
+		}
+		languageID, err := t.upserter.LanguageID(ctx, t.tx, example.Language)
+		if err != nil {
+			return err
+		}
+
+		if err := t.queries.UpdateExample(ctx, db.UpdateExampleParams{
+			ID:         exampleID,
+			LanguageID: languageID,
+			Content:    example.Content,
+			AudioUrl:   pgText(example.AudioURL),
+		}); err != nil {
+			return err
+		}
+		if err := t.queries.DeleteExampleTranslations(ctx, exampleID); err != nil {
+			return err
+		}
+		if err := t.upserter.UpsertExampleTranslations(ctx, t.tx, exampleID, example.Translations); err != nil {
+			return err
+		}
+		return t.changed(ctx, "updated an example", wordID)
+	})
+	return wordID, err
+}
+
+// DeleteExample deletes an example with its translations
+func (r *editorRepository) DeleteExample(ctx context.Context, editorID, exampleID int64) (int64, error) {
+	var wordID int64
+	err := r.inTx(ctx, editorID, "DeleteExample", func(t *editorTx) error {
+		var err error
+		wordID, err = t.lockExampleWord(ctx, exampleID)
+		if err != nil {
+			return err
+		}
+		if err := t.queries.DeleteExampleTranslations(ctx, exampleID); err != nil {
+			return err
+		}
+		if _, err := t.queries.DeleteExample(ctx, exampleID); err != nil {
+			return err
+		}
+		return t.changed(ctx, "deleted an example", wordID)
+	})
+	return wordID, err
+}
+
+// UpsertPronunciation writes the pronunciation of a word for a dialect
+func (r *editorRepository) UpsertPronunciation(ctx context.Context, editorID, wordID int64, pronunciation *domain.PronunciationJSON) (int64, error) {
+	var pronunciationID int64
+	err := r.inTx(ctx, editorID, "UpsertPronunciation", func(t *editorTx) error {
+		if _, err := t.lockWord(ctx, wordID); err != nil {
+			return err
+		}
+		var err error
+		pronunciationID, err = t.upserter.UpsertPronunciation(ctx, t.tx, wordID, *pronunciation)
+		if err != nil {
+			return err
+		}
+		return t.changed(ctx, fmt.Sprintf("updated %s pronunciation", pronunciation.Dialect), wordID)
+	})
+	return pronunciationID, err
+}
+
+// DeletePronunciation deletes a pronunciation
+func (r *editorRepository) DeletePronunciation(ctx context.Context, editorID, pronunciationID int64) (int64, error) {
+	var wordID int64
+	err := r.inTx(ctx, editorID, "DeletePronunciation", func(t *editorTx) error {
+		var err error
+		wordID, err = t.queries.FindPronunciationWordID(ctx, pronunciationID)
+		if err != nil {
+			return sharederrors.MapDictionaryRepositoryError(err, "FindPronunciationWordID")
+		}
+		if _, err := t.lockWord(ctx, wordID); err != nil {
+			return err
+		}
+		if _, err := t.queries.DeletePronunciation(ctx, pronunciationID); err != nil {
+			return err
+		}
+		return t.changed(ctx, "deleted a pronunciation", wordID)
+	})
+	return wordID, err
+}
+
+// UpsertRelation relates a word to a target word, creating the target when missing
+func (r *editorRepository) UpsertRelation(ctx context.Context, editorID, wordID int64, relation *domain.WordRelationJSON) (int64, error) {
+	var targetWordID int64
+	err := r.inTx(ctx, editorID, "UpsertRelation", func(t *editorTx) error {
+		if _, err := t.lockWord(ctx, wordID); err != nil {
+			return err
+		}
+		var err error
+		targetWordID, err = t.upserter.UpsertRelation(ctx, t.tx, wordID, *relation)
+		if err != nil {
+			return err
+		}
+		return t.changed(ctx, fmt.Sprintf("added %s relation to %s", relation.RelationType, relation.TargetWord.Lemma), wordID)
+	})
+	return targetWordID, err
+}
+
+// DeleteRelation deletes a relation of a word
+func (r *editorRepository) DeleteRelation(ctx context.Context, editorID, wordID, targetWordID int64, relationType string) error {
+	return r.inTx(ctx, editorID, "DeleteRelation", func(t *editorTx) error {
+		if _, err := t.lockWord(ctx, wordID); err != nil {
+			return err
+		}
+		deleted, err := t.queries.DeleteWordRelation(ctx, db.DeleteWordRelationParams{
+			FromWordID:   wordID,
+			ToWordID:     targetWordID,
+			RelationType: relationType,
+		})
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return domain.ErrRelationNotFound
+		}
+		return t.changed(ctx, fmt.Sprintf("deleted %s relation", relationType), wordID)
+	})
+}
+
+// CreateTopic creates a topic and sets its ID
+func (r *editorRepository) CreateTopic(ctx context.Context, topic *domain.Topic) error {
+	row, err := r.queries.CreateTopic(ctx, db.CreateTopicParams{
+		Code: topic.Code,
+		Name: topic.Name,
+	})
+	if err != nil {
+		return sharederrors.MapDictionaryRepositoryError(err, "CreateTopic")
+	}
+	topic.ID = row.ID
+	return nil
+}
+
+// UpdateTopic renames a topic and refreshes the words tagged with it
+func (r *editorRepository) UpdateTopic(ctx context.Context, editorID int64, topic *domain.Topic) ([]int64, error) {
+	var wordIDs []int64
+	err := r.inTx(ctx, editorID, "UpdateTopic", func(t *editorTx) error {
+		updated, err := t.queries.UpdateTopic(ctx, db.UpdateTopicParams{
+			ID:   topic.ID,
+			Code: topic.Code,
+			Name: topic.Name,
+		})
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			return domain.ErrTopicNotFound
+		}
+
+		wordIDs, err = t.queries.FindWordIDsByTopicID(ctx, topic.ID)
+		if err != nil {
+			return err
+		}
+		return t.changed(ctx, fmt.Sprintf("topic %s updated", topic.Code), wordIDs...)
+	})
+	return wordIDs, err
+}
+
+// DeleteTopic deletes a topic, untagging its words first
+func (r *editorRepository) DeleteTopic(ctx context.Context, editorID, topicID int64) ([]int64, error) {
+	var wordIDs []int64
+	err := r.inTx(ctx, editorID, "DeleteTopic", func(t *editorTx) error {
+		var err error
+		wordIDs, err = t.queries.FindWordIDsByTopicID(ctx, topicID)
+		if err != nil {
+			return err
+		}
+		if err := t.queries.DeleteWordTopicsOfTopic(ctx, topicID); err != nil {
+			return err
+		}
+		deleted, err := t.queries.DeleteTopic(ctx, topicID)
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return domain.ErrTopicNotFound
+		}
+		return t.changed(ctx, "deleted a topic", wordIDs...)
+	})
+	return wordIDs, err
+}
+
+// RestoreRevision brings a word back to a revision's snapshot. A word that still exists is cleared
+// and rewritten in place; a deleted word is recreated and its history moved over to the new ID.
+func (r *editorRepository) RestoreRevision(ctx context.Context, editorID, wordID int64, revision int) (int64, int, error) {
+	var restoredID int64
+	var restoreRevision int
+	err := r.inTx(ctx, editorID, "RestoreRevision", func(t *editorTx) error {
+		row, err := t.queries.GetWordRevision(ctx, db.GetWordRevisionParams{
+			WordID:   wordID,
+			Revision: int32(revision),
+		})
+		if err != nil {
+			return sharederrors.MapDictionaryRepositoryError(err, "GetWordRevision")
+		}
+		var snapshot domain.WordJSON
+		if err := json.Unmarshal(row.Snapshot, &snapshot); err != nil {
+			return fmt.Errorf("decode revision %d of word %d: %w", revision, wordID, err)
+		}
+
+		word, err := t.queries.LockWord(ctx, wordID)
+		exists := err == nil
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		languageID, err := t.upserter.LanguageID(ctx, t.tx, snapshot.Language)
+		if err != nil {
+			return err
+		}
+		existingID, err := t.upserter.FindWordID(ctx, t.tx, languageID, snapshot.Lemma)
+		if err != nil {
+			return err
+		}
+		if existingID != 0 && existingID != wordID {
+			return domain.ErrWordExists
+		}
+
+		restoredID = wordID
+		if exists {
+			if err := t.clearEntry(ctx, wordID); err != nil {
+				return err
+			}
+			// UpsertWord finds words by lemma, so the lemma is put back first
+			lemmaNormalized, searchKey := t.upserter.FillSearchKeys(word.LanguageCode, snapshot.Lemma, snapshot.Romanization, snapshot.LemmaNormalized, snapshot.SearchKey)
+			if err := t.queries.UpdateWord(ctx, db.UpdateWordParams{
+				ID:              wordID,
+				Lemma:           snapshot.Lemma,
+				LemmaNormalized: pgText(lemmaNormalized),
+				SearchKey:       pgText(searchKey),
+				Romanization:    pgText(snapshot.Romanization),
+				ScriptCode:      pgText(snapshot.ScriptCode),
+				FrequencyRank:   pgInt4(snapshot.FrequencyRank),
+				Note:            pgText(snapshot.Note),
+			}); err != nil {
+				return err
+			}
+		}
+
+		restoredID, err = t.upserter.UpsertWord(ctx, t.tx, snapshot.Language, snapshot)
+		if err != nil {
+			return err
+		}
+		if restoredID != wordID {
+			if err := t.queries.MoveWordRevisions(ctx, db.MoveWordRevisionsParams{
+				ToWordID:   restoredID,
+				FromWordID: wordID,
+			}); err != nil {
+				return err
+			}
+		}
+
+		restoreRevision, err = t.record(ctx, restoredID, domain.RevisionActionRestore, fmt.Sprintf("restored revision %d", revision))
+		if err != nil {
+			return err
+		}
+
+		referencing, err := t.referencingOthers(ctx, restoredID)
+		if err != nil {
+			return err
+		}
+		return t.changed(ctx, fmt.Sprintf("linked word %q restored", snapshot.Lemma), referencing...)
+	})
+	return restoredID, restoreRevision, err
+}
+
+// clearEntry deletes what a snapshot holds besides the word row: senses, pronunciations, the
+// word's own relations and its topics
+func (t *editorTx) clearEntry(ctx context.Context, wordID int64) error {
+	senseIDs, err := t.queries.FindSenseIDsByWordID(ctx, wordID)
+	if err != nil {
+		return err
+	}
+	if _, err := t.deleteSenses(ctx, senseIDs); err != nil {
+		return err
+	}
+	if err := t.queries.DeleteWordRelationsFromWord(ctx, wordID); err != nil {
+		return err
+	}
+	if err := t.queries.DeletePronunciationsOfWord(ctx, wordID); err != nil {
+		return err
+	}
+	return t.queries.DeleteWordTopics(ctx, wordID)
+}
+
+// pgText converts an optional string to a nullable text value
+func pgText(s *string) pgtype.Text {
+	if s == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *s, Valid: true}
+}
+
+// pgInt4 converts an optional int to a nullable integer value
+func pgInt4(i *int) pgtype.Int4 {
+	if i == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(*i), Valid: true}
+}
+
+// pgInt8 converts an optional int64 to a nullable bigint value
+func pgInt8(i *int64) pgtype.Int8 {
+	if i == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: *i, Valid: true}
+}
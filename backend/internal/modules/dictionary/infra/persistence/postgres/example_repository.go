@@ -0,0 +1,69 @@
+package dictionary
+
+import (
+	"context"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// exampleRepository implements ExampleRepository using sqlc
+type exampleRepository struct {
+	*DictionaryRepository
+}
+
+// FindExamplesBySenseIDs returns examples with their translations, keyed by sense ID
+func (r *exampleRepository) FindExamplesBySenseIDs(ctx context.Context, senseIDs []int64) (map[int64][]*domain.Example, error) {
+	result := make(map[int64][]*domain.Example)
+	if len(senseIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.queries.FindExamplesBySenseIDs(ctx, senseIDs)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindExamplesBySenseIDs")
+	}
+	if len(rows) == 0 {
+		return result, nil
+	}
+
+	examples := make(map[int64]*domain.Example, len(rows))
+	exampleIDs := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		var audioURL, source *string
+		if row.AudioUrl.Valid {
+			audioURL = &row.AudioUrl.String
+		}
+		if row.Source.Valid {
+			source = &row.Source.String
+		}
+
+		example := &domain.Example{
+			ID:            row.ID,
+			SourceSenseID: row.SourceSenseID,
+			LanguageID:    row.LanguageID,
+			Content:       row.Content,
+			AudioURL:      audioURL,
+			Source:        source,
+			Translations:  []domain.ExampleTranslationSimple{},
+		}
+		examples[example.ID] = example
+		exampleIDs = append(exampleIDs, example.ID)
+		result[example.SourceSenseID] = append(result[example.SourceSenseID], example)
+	}
+
+	translationRows, err := r.queries.FindExampleTranslationsByExampleIDs(ctx, exampleIDs)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindExamplesBySenseIDs")
+	}
+	for _, row := range translationRows {
+		if example, ok := examples[row.ExampleID]; ok {
+			example.Translations = append(example.Translations, domain.ExampleTranslationSimple{
+				Language: row.LanguageCode,
+				Content:  row.Content,
+			})
+		}
+	}
+
+	return result, nil
+}
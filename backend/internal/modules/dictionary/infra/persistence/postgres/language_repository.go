@@ -58,3 +58,26 @@ func (r *languageRepository) FindLanguageByCode(ctx context.Context, code string
 		Name: row.Name,
 	}, nil
 }
+
+// FindLanguagesByIDs returns languages keyed by ID
+func (r *languageRepository) FindLanguagesByIDs(ctx context.Context, ids []int16) (map[int16]*domain.Language, error) {
+	result := make(map[int16]*domain.Language, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.queries.FindLanguagesByIDs(ctx, ids)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindLanguagesByIDs")
+	}
+
+	for _, row := range rows {
+		result[row.ID] = &domain.Language{
+			ID:   row.ID,
+			Code: row.Code,
+			Name: row.Name,
+		}
+	}
+
+	return result, nil
+}
@@ -156,3 +156,45 @@ func (r *levelRepository) FindLevelsByLanguageID(ctx context.Context, languageID
 
 	return levels, nil
 }
+
+// FindLevelsByIDs returns levels keyed by ID
+func (r *levelRepository) FindLevelsByIDs(ctx context.Context, ids []int64) (map[int64]*domain.Level, error) {
+	result := make(map[int64]*domain.Level, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.queries.FindLevelsByIDs(ctx, ids)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindLevelsByIDs")
+	}
+
+	for _, row := range rows {
+		var description *string
+		var languageID *int16
+		var difficultyOrder *int16
+
+		if row.Description.Valid {
+			description = &row.Description.String
+		}
+		if row.LanguageID.Valid {
+			val := row.LanguageID.Int16
+			languageID = &val
+		}
+		if row.DifficultyOrder.Valid {
+			val := row.DifficultyOrder.Int16
+			difficultyOrder = &val
+		}
+
+		result[row.ID] = &domain.Level{
+			ID:              row.ID,
+			Code:            row.Code,
+			Name:            row.Name,
+			Description:     description,
+			LanguageID:      languageID,
+			DifficultyOrder: difficultyOrder,
+		}
+	}
+
+	return result, nil
+}
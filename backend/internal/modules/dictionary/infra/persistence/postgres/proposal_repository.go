@@ -0,0 +1,209 @@
+package dictionary
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// proposalRepository implements ProposalRepository using sqlc
+type proposalRepository struct {
+	*DictionaryRepository
+}
+
+// CreateProposal stores a pending proposal after checking its sense or example belongs to its word
+func (r *proposalRepository) CreateProposal(ctx context.Context, proposal *domain.Proposal) (int64, error) {
+	switch {
+	case proposal.ExampleID != nil:
+		wordID, err := r.queries.FindExampleWordID(ctx, *proposal.ExampleID)
+		if err != nil {
+			return 0, sharederrors.MapDictionaryRepositoryError(err, "FindExampleWordID")
+		}
+		if wordID != proposal.WordID {
+			return 0, domain.ErrExampleNotFound
+		}
+	case proposal.SenseID != nil:
+		wordID, err := r.queries.FindSenseWordID(ctx, *proposal.SenseID)
+		if err != nil {
+			return 0, sharederrors.MapDictionaryRepositoryError(err, "FindSenseWordID")
+		}
+		if wordID != proposal.WordID {
+			return 0, domain.ErrSenseNotFound
+		}
+	}
+
+	id, err := r.queries.CreateProposal(ctx, db.CreateProposalParams{
+		WordID:       proposal.WordID,
+		Kind:         proposal.Kind,
+		SenseID:      pgInt8(proposal.SenseID),
+		ExampleID:    pgInt8(proposal.ExampleID),
+		TargetWordID: pgInt8(proposal.TargetWordID),
+		Payload:      proposal.Payload,
+		Comment:      pgText(proposal.Comment),
+		UserID:       proposal.UserID,
+	})
+	if err != nil {
+		return 0, sharederrors.MapDictionaryRepositoryError(err, "CreateProposal")
+	}
+	return id, nil
+}
+
+// FindProposalByID returns a proposal with its word lemma, author and duplicate count
+func (r *proposalRepository) FindProposalByID(ctx context.Context, id int64) (*domain.Proposal, error) {
+	row, err := r.queries.GetProposal(ctx, id)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "GetProposal")
+	}
+	return toProposal(db.FindProposalsRow(row)), nil
+}
+
+// FindProposals returns one page of proposals, oldest first, plus the total count
+func (r *proposalRepository) FindProposals(ctx context.Context, query domain.ProposalQuery) ([]*domain.Proposal, int, error) {
+	rows, err := r.queries.FindProposals(ctx, db.FindProposalsParams{
+		Status: pgText(query.Status),
+		WordID: pgInt8(query.WordID),
+		UserID: pgInt8(query.UserID),
+		Limit:  int32(query.Limit),
+		Offset: int32(query.Offset),
+	})
+	if err != nil {
+		return nil, 0, sharederrors.MapDictionaryRepositoryError(err, "FindProposals")
+	}
+	total, err := r.queries.CountProposals(ctx, db.CountProposalsParams{
+		Status: pgText(query.Status),
+		WordID: pgInt8(query.WordID),
+		UserID: pgInt8(query.UserID),
+	})
+	if err != nil {
+		return nil, 0, sharederrors.MapDictionaryRepositoryError(err, "CountProposals")
+	}
+
+	proposals := make([]*domain.Proposal, len(rows))
+	for i, row := range rows {
+		proposals[i] = toProposal(row)
+	}
+	return proposals, int(total), nil
+}
+
+// ReviewProposal settles a pending proposal as approved or rejected
+func (r *proposalRepository) ReviewProposal(ctx context.Context, id, moderatorID int64, status string, reason *string) error {
+	updated, err := r.queries.ReviewProposal(ctx, db.ReviewProposalParams{
+		Status:      status,
+		ModeratorID: pgInt8(&moderatorID),
+		Reason:      pgText(reason),
+		ID:          id,
+	})
+	if err != nil {
+		return sharederrors.MapDictionaryRepositoryError(err, "ReviewProposal")
+	}
+	if updated == 0 {
+		// Either the proposal does not exist or it was reviewed already
+		if _, err := r.queries.GetProposal(ctx, id); err != nil {
+			return sharederrors.MapDictionaryRepositoryError(err, "GetProposal")
+		}
+		return domain.ErrProposalNotPending
+	}
+	return nil
+}
+
+// MergeProposal marks a pending proposal as a duplicate of another pending proposal for the same
+// word and moves the duplicates already merged into it to the target
+func (r *proposalRepository) MergeProposal(ctx context.Context, id, intoID, moderatorID int64) error {
+	if id == intoID {
+		return domain.ErrProposalMergeInvalid
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return sharederrors.MapDictionaryRepositoryError(err, "MergeProposal")
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	// Lock both proposals in ID order so concurrent merges cannot deadlock
+	first, second := id, intoID
+	if first > second {
+		first, second = second, first
+	}
+	locked := make(map[int64]db.LockProposalRow, 2)
+	for _, lockID := range []int64{first, second} {
+		row, err := q.LockProposal(ctx, lockID)
+		if err != nil {
+			return sharederrors.MapDictionaryRepositoryError(err, "LockProposal")
+		}
+		locked[lockID] = row
+	}
+
+	proposal, target := locked[id], locked[intoID]
+	if proposal.Status != domain.ProposalStatusPending {
+		return domain.ErrProposalNotPending
+	}
+	if target.Status != domain.ProposalStatusPending || target.WordID != proposal.WordID {
+		return domain.ErrProposalMergeInvalid
+	}
+
+	if err := q.MoveMergedProposals(ctx, db.MoveMergedProposalsParams{
+		ToID:   intoID,
+		FromID: id,
+	}); err != nil {
+		return sharederrors.MapDictionaryRepositoryError(err, "MoveMergedProposals")
+	}
+	if _, err := q.ReviewProposal(ctx, db.ReviewProposalParams{
+		Status:       domain.ProposalStatusMerged,
+		ModeratorID:  pgInt8(&moderatorID),
+		MergedIntoID: pgInt8(&intoID),
+		ID:           id,
+	}); err != nil {
+		return sharederrors.MapDictionaryRepositoryError(err, "ReviewProposal")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return sharederrors.MapDictionaryRepositoryError(err, "MergeProposal")
+	}
+	return nil
+}
+
+// ReopenProposal puts a reviewed proposal back in the queue
+func (r *proposalRepository) ReopenProposal(ctx context.Context, id int64) error {
+	if err := r.queries.ReopenProposal(ctx, id); err != nil {
+		return sharederrors.MapDictionaryRepositoryError(err, "ReopenProposal")
+	}
+	return nil
+}
+
+// toProposal maps a proposal row to a Proposal
+func toProposal(row db.FindProposalsRow) *domain.Proposal {
+	return &domain.Proposal{
+		ID:           row.ID,
+		WordID:       row.WordID,
+		WordLemma:    row.Lemma,
+		Kind:         row.Kind,
+		SenseID:      int8Ptr(row.SenseID),
+		ExampleID:    int8Ptr(row.ExampleID),
+		TargetWordID: int8Ptr(row.TargetWordID),
+		Payload:      row.Payload,
+		Comment:      textPtr(row.Comment),
+		Status:       row.Status,
+		UserID:       row.UserID,
+		Username:     row.Username,
+		ModeratorID:  int8Ptr(row.ModeratorID),
+		Reason:       textPtr(row.Reason),
+		MergedIntoID: int8Ptr(row.MergedIntoID),
+		Duplicates:   int(row.Duplicates),
+		CreatedAt:    row.CreatedAt.Time,
+		ReviewedAt:   timestampPtr(row.ReviewedAt),
+	}
+}
+
+// timestampPtr converts a nullable timestamp to a pointer
+func timestampPtr(t pgtype.Timestamp) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,84 @@
+package dictionary
+
+import (
+	"context"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/normalize"
+)
+
+// FindReverseTranslations finds source senses translated by the word matching query in targetLanguageID,
+// ordered by source language then priority. sourceLanguageID 0 means any language
+func (r *wordRepository) FindReverseTranslations(ctx context.Context, query string, targetLanguageID, sourceLanguageID int16, limit int) ([]*domain.ReverseTranslation, error) {
+	forms := normalize.Query(query, r.simplifier())
+	if forms.Text == "" || forms.Folded == "" {
+		return []*domain.ReverseTranslation{}, nil
+	}
+
+	rows, err := r.queries.FindReverseTranslations(ctx, db.FindReverseTranslationsParams{
+		Query:            forms.Text,
+		TargetLanguageID: targetLanguageID,
+		Folded:           forms.Folded,
+		Numbered:         forms.Numbered,
+		Toneless:         forms.Toneless,
+		SourceLanguageID: sourceLanguageID,
+		Limit:            int32(limit),
+	})
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindReverseTranslations")
+	}
+
+	// A word with several matching senses shares one *Word across its results
+	words := make(map[int64]*domain.Word)
+	results := make([]*domain.ReverseTranslation, 0, len(rows))
+	for _, row := range rows {
+		word, ok := words[row.ID]
+		if !ok {
+			word = r.mapWordRow(db.Word{
+				ID:              row.ID,
+				LanguageID:      row.LanguageID,
+				Lemma:           row.Lemma,
+				LemmaNormalized: row.LemmaNormalized,
+				SearchKey:       row.SearchKey,
+				Romanization:    row.Romanization,
+				ScriptCode:      row.ScriptCode,
+				FrequencyRank:   row.FrequencyRank,
+				Note:            row.Note,
+				CreatedAt:       row.CreatedAt,
+				UpdatedAt:       row.UpdatedAt,
+			})
+			words[row.ID] = word
+		}
+
+		var levelID *int64
+		if row.LevelID.Valid {
+			levelID = &row.LevelID.Int64
+		}
+		var priority *int16
+		if row.Priority.Valid {
+			priority = &row.Priority.Int16
+		}
+
+		results = append(results, &domain.ReverseTranslation{
+			SourceWord: word,
+			Sense: &domain.Sense{
+				ID:                   row.SenseID,
+				WordID:               row.ID,
+				SenseOrder:           row.SenseOrder,
+				PartOfSpeechID:       row.PartOfSpeechID,
+				Definition:           row.Definition,
+				DefinitionLanguageID: row.DefinitionLanguageID,
+				UsageLabel:           textPtr(row.UsageLabel),
+				LevelID:              levelID,
+				Note:                 textPtr(row.SenseNote),
+			},
+			Priority:         priority,
+			TranslatedWordID: row.TargetWordID,
+			TranslatedLemma:  row.TargetLemma,
+		})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,45 @@
+package dictionary
+
+import (
+	"context"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// scriptConversionRepository implements ScriptConversionRepository using sqlc
+type scriptConversionRepository struct {
+	*DictionaryRepository
+}
+
+// FindAllScriptConversions returns every conversion pair, imported pairs first
+func (r *scriptConversionRepository) FindAllScriptConversions(ctx context.Context) ([]*domain.ScriptConversion, error) {
+	rows, err := r.queries.FindAllScriptConversions(ctx)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindAllScriptConversions")
+	}
+
+	conversions := make([]*domain.ScriptConversion, 0, len(rows))
+	for _, row := range rows {
+		conversions = append(conversions, &domain.ScriptConversion{
+			Simplified:  row.Simplified,
+			Traditional: row.Traditional,
+			Source:      row.Source,
+		})
+	}
+
+	return conversions, nil
+}
+
+// GetScriptConversionsVersion returns the pair count and latest updated_at of the conversion table
+func (r *scriptConversionRepository) GetScriptConversionsVersion(ctx context.Context) (*domain.ScriptConversionsVersion, error) {
+	row, err := r.queries.GetScriptConversionsVersion(ctx)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "GetScriptConversionsVersion")
+	}
+
+	return &domain.ScriptConversionsVersion{
+		PairCount:     row.PairCount,
+		LastUpdatedAt: row.LastUpdatedAt.Time,
+	}, nil
+}
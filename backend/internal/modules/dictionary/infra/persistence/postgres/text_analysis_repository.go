@@ -0,0 +1,68 @@
+package dictionary
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// textAnalysisRepository implements TextAnalysisRepository using sqlc
+type textAnalysisRepository struct {
+	*DictionaryRepository
+}
+
+// FindAnalyzerWords returns the words of a language whose lemma_normalized is one of the query
+// forms, most frequent first, with their easiest level and gloss
+func (r *textAnalysisRepository) FindAnalyzerWords(ctx context.Context, query domain.AnalyzerWordQuery) ([]*domain.AnalyzedWord, error) {
+	if len(query.Forms) == 0 {
+		return []*domain.AnalyzedWord{}, nil
+	}
+
+	params := db.FindAnalyzerWordsParams{
+		LanguageID: query.LanguageID,
+		Forms:      query.Forms,
+	}
+	if query.TranslationLanguageID != nil {
+		params.TranslationLanguageID = pgtype.Int2{Int16: *query.TranslationLanguageID, Valid: true}
+	}
+
+	rows, err := r.queries.FindAnalyzerWords(ctx, params)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindAnalyzerWords")
+	}
+
+	words := make([]*domain.AnalyzedWord, 0, len(rows))
+	for _, row := range rows {
+		word := &domain.AnalyzedWord{
+			WordID:          row.ID,
+			Lemma:           row.Lemma,
+			LemmaNormalized: row.LemmaNormalized.String,
+			Romanization:    textPtr(row.Romanization),
+		}
+		if row.LevelID.Valid {
+			word.Level = &domain.Level{
+				ID:   row.LevelID.Int64,
+				Code: row.LevelCode.String,
+				Name: row.LevelName.String,
+			}
+			if row.LevelOrder.Valid {
+				order := row.LevelOrder.Int16
+				word.Level.DifficultyOrder = &order
+			}
+		}
+		if row.GlossSenseID.Valid {
+			word.Gloss = &domain.WordGloss{
+				SenseID:           row.GlossSenseID.Int64,
+				Definition:        row.GlossDefinition.String,
+				TranslationWordID: int8Ptr(row.GlossTranslationWordID),
+				Translation:       textPtr(row.GlossTranslation),
+			}
+		}
+		words = append(words, word)
+	}
+	return words, nil
+}
@@ -0,0 +1,218 @@
+package dictionary
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/normalize"
+)
+
+// Highlight sentinels: private-use runes that survive HTML escaping and are swapped for <mark> tags afterwards,
+// so stored text can never inject markup into a snippet
+const (
+	markStart = "\uE000"
+	markStop  = "\uE001"
+)
+
+// snippetRadius is how many runes of context are kept on each side of a substring match
+const snippetRadius = 30
+
+// headlineOptions configures ts_headline for full-text snippets
+var headlineOptions = fmt.Sprintf(
+	`StartSel=%s, StopSel=%s, MaxWords=25, MinWords=8, MaxFragments=2, FragmentDelimiter=" … "`,
+	markStart, markStop,
+)
+
+// textSearchRepository implements TextSearchRepository using sqlc
+type textSearchRepository struct {
+	*DictionaryRepository
+}
+
+// textSearchConfig returns the text search configuration for a language.
+// ok is false for languages written without spaces, which are matched by substring instead.
+func textSearchConfig(languageCode string) (config string, ok bool) {
+	switch languageCode {
+	case "en":
+		return "english", true
+	case "zh", "ja", "ko":
+		return "", false
+	default:
+		return "simple", true
+	}
+}
+
+// SearchText returns one page of matching definitions and examples with highlighted snippets,
+// plus the total match count
+func (r *textSearchRepository) SearchText(ctx context.Context, query domain.TextSearchQuery) ([]*domain.TextSearchHit, int, error) {
+	if config, ok := textSearchConfig(query.LanguageCode); ok {
+		return r.searchFullText(ctx, query, config)
+	}
+	return r.searchSubstring(ctx, query)
+}
+
+// searchFullText matches tokenized text with websearch syntax ("quoted phrases", or, -exclusions)
+func (r *textSearchRepository) searchFullText(ctx context.Context, query domain.TextSearchQuery, config string) ([]*domain.TextSearchHit, int, error) {
+	text := strings.TrimSpace(normalize.Width(query.Query))
+	if text == "" {
+		return []*domain.TextSearchHit{}, 0, nil
+	}
+
+	rows, err := r.queries.SearchTextFullText(ctx, db.SearchTextFullTextParams{
+		Config:              config,
+		Query:               text,
+		IncludeDefinitions:  query.Definitions,
+		LanguageID:          query.LanguageID,
+		IncludeExamples:     query.Examples,
+		IncludeTranslations: query.Translations,
+		Limit:               int32(query.Limit),
+		Offset:              int32(query.Offset),
+		HeadlineOptions:     headlineOptions,
+	})
+	if err != nil {
+		return nil, 0, sharederrors.MapDictionaryRepositoryError(err, "SearchTextFullText")
+	}
+
+	hits := make([]*domain.TextSearchHit, 0, len(rows))
+	total := 0
+	for _, row := range rows {
+		total = int(row.TotalCount)
+		hits = append(hits, &domain.TextSearchHit{
+			Source:         row.Source,
+			WordID:         row.WordID,
+			WordLanguageID: row.WordLanguageID,
+			Lemma:          row.Lemma,
+			Romanization:   textPtr(row.Romanization),
+			SenseID:        row.SenseID,
+			SenseOrder:     row.SenseOrder,
+			ExampleID:      int8Ptr(row.ExampleID),
+			Snippet:        markSnippet(row.Snippet),
+			Rank:           float64(row.Rank),
+		})
+	}
+
+	return hits, total, nil
+}
+
+// searchSubstring matches the normalized query anywhere in the text, for languages without word boundaries
+func (r *textSearchRepository) searchSubstring(ctx context.Context, query domain.TextSearchQuery) ([]*domain.TextSearchHit, int, error) {
+	text := normalize.Text(query.Query)
+	if text == "" {
+		return []*domain.TextSearchHit{}, 0, nil
+	}
+
+	rows, err := r.queries.SearchTextSubstring(ctx, db.SearchTextSubstringParams{
+		Query:               text,
+		IncludeDefinitions:  query.Definitions,
+		LanguageID:          query.LanguageID,
+		LikeQuery:           escapeLike(text),
+		IncludeExamples:     query.Examples,
+		IncludeTranslations: query.Translations,
+		Limit:               int32(query.Limit),
+		Offset:              int32(query.Offset),
+	})
+	if err != nil {
+		return nil, 0, sharederrors.MapDictionaryRepositoryError(err, "SearchTextSubstring")
+	}
+
+	hits := make([]*domain.TextSearchHit, 0, len(rows))
+	total := 0
+	for _, row := range rows {
+		total = int(row.TotalCount)
+		hits = append(hits, &domain.TextSearchHit{
+			Source:         row.Source,
+			WordID:         row.WordID,
+			WordLanguageID: row.WordLanguageID,
+			Lemma:          row.Lemma,
+			Romanization:   textPtr(row.Romanization),
+			SenseID:        row.SenseID,
+			SenseOrder:     row.SenseOrder,
+			ExampleID:      int8Ptr(row.ExampleID),
+			Snippet:        markSnippet(substringSnippet(row.Content, text)),
+			Rank:           float64(row.Rank),
+		})
+	}
+
+	return hits, total, nil
+}
+
+// markSnippet HTML-escapes a snippet and turns the highlight sentinels into <mark> tags
+func markSnippet(snippet string) string {
+	escaped := html.EscapeString(snippet)
+	return strings.NewReplacer(markStart, "<mark>", markStop, "</mark>").Replace(escaped)
+}
+
+// substringSnippet cuts the text around the first occurrence of query and wraps every occurrence
+// inside the cut in highlight sentinels. query is already lowercased.
+func substringSnippet(content, query string) string {
+	runes := []rune(content)
+	lower := []rune(strings.ToLower(content))
+	needle := []rune(query)
+	if len(lower) != len(runes) || len(needle) == 0 {
+		// Lowercasing changed the rune count; fall back to the unhighlighted text
+		return content
+	}
+
+	first := indexRunes(lower, needle, 0)
+	if first < 0 {
+		return content
+	}
+
+	from := max(first-snippetRadius, 0)
+	to := min(first+len(needle)+snippetRadius, len(runes))
+
+	var b strings.Builder
+	b.Grow(utf8.UTFMax * (to - from + 8))
+	if from > 0 {
+		b.WriteString("…")
+	}
+	for i := from; i < to; {
+		if i+len(needle) <= to && indexRunes(lower[i:i+len(needle)], needle, 0) == 0 {
+			b.WriteString(markStart)
+			b.WriteString(string(runes[i : i+len(needle)]))
+			b.WriteString(markStop)
+			i += len(needle)
+			continue
+		}
+		b.WriteRune(runes[i])
+		i++
+	}
+	if to < len(runes) {
+		b.WriteString("…")
+	}
+
+	return b.String()
+}
+
+// indexRunes returns the index of needle in haystack at or after start, or -1
+func indexRunes(haystack, needle []rune, start int) int {
+	for i := start; i+len(needle) <= len(haystack); i++ {
+		match := true
+		for j := range needle {
+			if haystack[i+j] != needle[j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i
+		}
+	}
+	return -1
+}
+
+// int8Ptr converts a nullable int8 column to an int64 pointer
+func int8Ptr(i pgtype.Int8) *int64 {
+	if !i.Valid {
+		return nil
+	}
+	v := i.Int64
+	return &v
+}
@@ -0,0 +1,87 @@
+package dictionary
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// BrowseWords returns one page of words matching the filters with their glosses, plus the total match count
+func (r *wordRepository) BrowseWords(ctx context.Context, query domain.WordBrowseQuery) ([]*domain.WordBrowseItem, int, error) {
+	params := db.BrowseWordsParams{
+		Sort:   query.Sort,
+		Limit:  int32(query.Limit),
+		Offset: int32(query.Offset),
+	}
+	if query.LanguageID != nil {
+		params.LanguageID = pgtype.Int2{Int16: *query.LanguageID, Valid: true}
+	}
+	if query.TopicID != nil {
+		params.TopicID = pgtype.Int8{Int64: *query.TopicID, Valid: true}
+	}
+	if query.LevelID != nil {
+		params.LevelID = pgtype.Int8{Int64: *query.LevelID, Valid: true}
+	}
+	if query.PartOfSpeech != nil {
+		params.Pos = pgtype.Text{String: *query.PartOfSpeech, Valid: true}
+	}
+	if query.TranslationLanguageID != nil {
+		params.TranslationLanguageID = pgtype.Int2{Int16: *query.TranslationLanguageID, Valid: true}
+	}
+
+	rows, err := r.queries.BrowseWords(ctx, params)
+	if err != nil {
+		return nil, 0, sharederrors.MapDictionaryRepositoryError(err, "BrowseWords")
+	}
+
+	// The total comes from a window function, so a page past the end has no row to carry it
+	if len(rows) == 0 {
+		if query.Offset == 0 {
+			return []*domain.WordBrowseItem{}, 0, nil
+		}
+		total, err := r.queries.CountBrowseWords(ctx, db.CountBrowseWordsParams{
+			LanguageID: params.LanguageID,
+			TopicID:    params.TopicID,
+			LevelID:    params.LevelID,
+			Pos:        params.Pos,
+		})
+		if err != nil {
+			return nil, 0, sharederrors.MapDictionaryRepositoryError(err, "CountBrowseWords")
+		}
+		return []*domain.WordBrowseItem{}, int(total), nil
+	}
+
+	items := make([]*domain.WordBrowseItem, 0, len(rows))
+	for _, row := range rows {
+		item := &domain.WordBrowseItem{
+			Word: r.mapWordRow(db.Word{
+				ID:              row.ID,
+				LanguageID:      row.LanguageID,
+				Lemma:           row.Lemma,
+				LemmaNormalized: row.LemmaNormalized,
+				SearchKey:       row.SearchKey,
+				Romanization:    row.Romanization,
+				ScriptCode:      row.ScriptCode,
+				FrequencyRank:   row.FrequencyRank,
+				Note:            row.Note,
+				CreatedAt:       row.CreatedAt,
+				UpdatedAt:       row.UpdatedAt,
+			}),
+		}
+		if row.GlossSenseID.Valid {
+			item.Gloss = &domain.WordGloss{
+				SenseID:           row.GlossSenseID.Int64,
+				Definition:        row.GlossDefinition.String,
+				TranslationWordID: int8Ptr(row.GlossTranslationWordID),
+				Translation:       textPtr(row.GlossTranslation),
+			}
+		}
+		items = append(items, item)
+	}
+
+	return items, int(rows[0].TotalCount), nil
+}
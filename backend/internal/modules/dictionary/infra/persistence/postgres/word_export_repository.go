@@ -0,0 +1,57 @@
+package dictionary
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// wordExportRepository implements WordExportRepository using sqlc
+type wordExportRepository struct {
+	*DictionaryRepository
+}
+
+// FindExportWords returns one keyset page of selected entries, ordered by word ID. Entries are
+// assembled like revision snapshots, so an export can be fed back to the seeder.
+func (r *wordExportRepository) FindExportWords(ctx context.Context, filter domain.WordExportFilter, afterID int64, limit int) ([]*domain.ExportedWord, error) {
+	params := db.FindExportWordIDsParams{
+		AfterID: afterID,
+		WordIds: filter.WordIDs,
+		Limit:   int32(limit),
+	}
+	if filter.LanguageID != nil {
+		params.LanguageID = pgtype.Int2{Int16: *filter.LanguageID, Valid: true}
+	}
+	if filter.TopicID != nil {
+		params.TopicID = pgtype.Int8{Int64: *filter.TopicID, Valid: true}
+	}
+	if filter.LevelID != nil {
+		params.LevelID = pgtype.Int8{Int64: *filter.LevelID, Valid: true}
+	}
+
+	ids, err := r.queries.FindExportWordIDs(ctx, params)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindExportWordIDs")
+	}
+	if len(ids) == 0 {
+		return []*domain.ExportedWord{}, nil
+	}
+
+	words, err := SnapshotWords(ctx, r.queries, ids)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "SnapshotWords")
+	}
+
+	// Words deleted between the two reads are left out
+	result := make([]*domain.ExportedWord, 0, len(ids))
+	for _, id := range ids {
+		if word, ok := words[id]; ok {
+			result = append(result, &domain.ExportedWord{ID: id, Word: word})
+		}
+	}
+	return result, nil
+}
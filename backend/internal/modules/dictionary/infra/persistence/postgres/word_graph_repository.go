@@ -0,0 +1,100 @@
+package dictionary
+
+import (
+	"context"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// wordGraphRepository implements WordGraphRepository using sqlc
+type wordGraphRepository struct {
+	*DictionaryRepository
+}
+
+// FindRelationEdges returns word_relations edges of the given relation types
+func (r *wordGraphRepository) FindRelationEdges(ctx context.Context, wordIDs []int64, relationTypes []string) ([]*domain.WordGraphEdge, error) {
+	if len(wordIDs) == 0 || len(relationTypes) == 0 {
+		return []*domain.WordGraphEdge{}, nil
+	}
+
+	rows, err := r.queries.FindRelationEdgesByWordIDs(ctx, db.FindRelationEdgesByWordIDsParams{
+		WordIds:       wordIDs,
+		RelationTypes: relationTypes,
+	})
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindRelationEdgesByWordIDs")
+	}
+
+	edges := make([]*domain.WordGraphEdge, 0, len(rows))
+	for _, row := range rows {
+		edges = append(edges, &domain.WordGraphEdge{
+			FromWordID: row.FromWordID,
+			ToWordID:   row.ToWordID,
+			Type:       row.RelationType,
+			Note:       textPtr(row.Note),
+		})
+	}
+
+	return edges, nil
+}
+
+// FindTranslationEdges returns word-to-word translation edges, from source word to target word
+func (r *wordGraphRepository) FindTranslationEdges(ctx context.Context, wordIDs []int64) ([]*domain.WordGraphEdge, error) {
+	if len(wordIDs) == 0 {
+		return []*domain.WordGraphEdge{}, nil
+	}
+
+	rows, err := r.queries.FindTranslationEdgesByWordIDs(ctx, wordIDs)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindTranslationEdgesByWordIDs")
+	}
+
+	edges := make([]*domain.WordGraphEdge, 0, len(rows))
+	for _, row := range rows {
+		priority := row.Priority
+		edges = append(edges, &domain.WordGraphEdge{
+			FromWordID: row.SourceWordID,
+			ToWordID:   row.TargetWordID,
+			Type:       domain.GraphEdgeTranslation,
+			Priority:   &priority,
+		})
+	}
+
+	return edges, nil
+}
+
+// FindSharedCharacterEdges links each word to up to neighborLimit other words per shared character.
+// Shared characters are symmetric, so each edge points from the lower word ID to the higher one.
+func (r *wordGraphRepository) FindSharedCharacterEdges(ctx context.Context, wordIDs []int64, neighborLimit int) ([]*domain.WordGraphEdge, error) {
+	if len(wordIDs) == 0 || neighborLimit <= 0 {
+		return []*domain.WordGraphEdge{}, nil
+	}
+
+	rows, err := r.queries.FindSharedCharacterEdgesByWordIDs(ctx, db.FindSharedCharacterEdgesByWordIDsParams{
+		NeighborLimit: int32(neighborLimit),
+		WordIds:       wordIDs,
+	})
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindSharedCharacterEdgesByWordIDs")
+	}
+
+	edges := make([]*domain.WordGraphEdge, 0, len(rows))
+	for _, row := range rows {
+		from, to := row.WordID, row.OtherWordID
+		if from > to {
+			from, to = to, from
+		}
+		characterID, literal := row.CharacterID, row.Literal
+		edges = append(edges, &domain.WordGraphEdge{
+			FromWordID:  from,
+			ToWordID:    to,
+			Type:        domain.GraphEdgeSharedCharacter,
+			CharacterID: &characterID,
+			Character:   &literal,
+		})
+	}
+
+	return edges, nil
+}
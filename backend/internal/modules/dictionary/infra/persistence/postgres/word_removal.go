@@ -0,0 +1,123 @@
+package dictionary
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+)
+
+// ClearWordEntry deletes what a word's own entry holds: its senses with their translations and
+// examples, pronunciations, topics, characters and the relations it starts. The word row stays,
+// as do the translations and relations of other words that point to it.
+func ClearWordEntry(ctx context.Context, q *db.Queries, wordID int64) error {
+	senseIDs, err := q.FindSenseIDsByWordID(ctx, wordID)
+	if err != nil {
+		return err
+	}
+	if _, err := deleteSenses(ctx, q, senseIDs); err != nil {
+		return err
+	}
+	if err := q.DeleteWordRelationsFromWord(ctx, wordID); err != nil {
+		return err
+	}
+	if err := q.DeletePronunciationsOfWord(ctx, wordID); err != nil {
+		return err
+	}
+	if err := q.DeleteWordCharactersOfWord(ctx, wordID); err != nil {
+		return err
+	}
+	return q.DeleteWordTopics(ctx, wordID)
+}
+
+// deleteSenses deletes senses with their translations and examples and returns how many senses
+// were deleted
+func deleteSenses(ctx context.Context, q *db.Queries, senseIDs []int64) (int64, error) {
+	if len(senseIDs) == 0 {
+		return 0, nil
+	}
+	if err := q.DeleteExampleTranslationsOfSenses(ctx, senseIDs); err != nil {
+		return 0, err
+	}
+	if err := q.DeleteExamplesOfSenses(ctx, senseIDs); err != nil {
+		return 0, err
+	}
+	if err := q.DeleteSenseTranslationsOfSenses(ctx, senseIDs); err != nil {
+		return 0, err
+	}
+	return q.DeleteSenses(ctx, senseIDs)
+}
+
+// SeedWordRemoval is what RemoveSeedWord did with a word
+type SeedWordRemoval int
+
+const (
+	SeedWordDeleted SeedWordRemoval = iota // the word was deleted
+	SeedWordCleared                        // the entry was cleared, the word kept for the words linking to it
+	SeedWordInUse                          // learning history points to the word, so it was left as it is
+)
+
+// RemoveSeedWord takes the entry of a word removed from a seed file out of the dictionary. A word
+// other entries still name as a translation or relation target is cleared (see ClearWordEntry)
+// and kept, so their links survive; any other word is deleted. A word that game questions or
+// word statistics point to is left untouched, as the editor refuses to delete it too. A revision
+// records the change. Like RecordRevision it expects a single writer.
+func RemoveSeedWord(ctx context.Context, tx pgx.Tx, wordID int64, summary string) (SeedWordRemoval, error) {
+	q := db.New(tx)
+	// Checked up front: a foreign key violation would abort the caller's whole transaction
+	inUse, err := q.WordHasLearningHistory(ctx, wordID)
+	if err != nil {
+		return 0, fmt.Errorf("check learning history of word %d: %w", wordID, err)
+	}
+	if inUse {
+		return SeedWordInUse, nil
+	}
+
+	referencing, err := q.FindWordsReferencingWord(ctx, wordID)
+	if err != nil {
+		return 0, fmt.Errorf("find words referencing word %d: %w", wordID, err)
+	}
+	referenced := false
+	for _, id := range referencing {
+		referenced = referenced || id != wordID
+	}
+
+	if referenced {
+		if err := ClearWordEntry(ctx, q, wordID); err != nil {
+			return 0, fmt.Errorf("clear word %d: %w", wordID, err)
+		}
+		if err := q.TouchWords(ctx, []int64{wordID}); err != nil {
+			return 0, fmt.Errorf("touch word %d: %w", wordID, err)
+		}
+		if _, err := RecordRevision(ctx, tx, wordID, domain.RevisionMeta{
+			Action:  domain.RevisionActionSeed,
+			Source:  domain.RevisionSourceSeeder,
+			Summary: summary,
+		}); err != nil {
+			return 0, err
+		}
+		return SeedWordCleared, nil
+	}
+
+	// The delete revision keeps the entry so it can be restored
+	if _, err := RecordRevision(ctx, tx, wordID, domain.RevisionMeta{
+		Action:  domain.RevisionActionDelete,
+		Source:  domain.RevisionSourceSeeder,
+		Summary: summary,
+	}); err != nil {
+		return 0, err
+	}
+	if err := ClearWordEntry(ctx, q, wordID); err != nil {
+		return 0, fmt.Errorf("clear word %d: %w", wordID, err)
+	}
+	if err := q.DeleteWordRelationsOfWord(ctx, wordID); err != nil {
+		return 0, fmt.Errorf("delete relations of word %d: %w", wordID, err)
+	}
+	if _, err := q.DeleteWord(ctx, wordID); err != nil {
+		return 0, fmt.Errorf("delete word %d: %w", wordID, err)
+	}
+	return SeedWordDeleted, nil
+}
@@ -2,12 +2,14 @@ package dictionary
 
 import (
 	"context"
+	"strings"
 
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/english-coach/backend/internal/modules/dictionary/domain"
 	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
 	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/normalize"
 )
 
 // wordRepository implements WordRepository using sqlc
@@ -179,37 +181,83 @@ func (r *wordRepository) FindTranslationsForWord(ctx context.Context, sourceWord
 	return words, nil
 }
 
-// SearchWords searches for words using multiple strategies (lemma, normalized, search_key)
-func (r *wordRepository) SearchWords(ctx context.Context, query string, languageID int16, limit, offset int) ([]*domain.Word, error) {
-	searchPattern := "%" + query + "%"
+// SearchWords searches for words by exact, prefix, normalized, substring and fuzzy matching,
+// ranked in that order, and returns one page of results with the total match count.
+// Matching ignores Vietnamese diacritics, pinyin tones and full-width characters
+func (r *wordRepository) SearchWords(ctx context.Context, query string, languageID int16, limit, offset int) ([]*domain.WordSearchResult, int, error) {
+	forms := normalize.Query(query, r.simplifier())
+	if forms.Text == "" || forms.Folded == "" {
+		return []*domain.WordSearchResult{}, 0, nil
+	}
 
-	wordRows, err := r.queries.SearchWords(ctx, db.SearchWordsParams{
-		LanguageID:    languageID,
-		SearchPattern: searchPattern,
-		ExactMatch:    query,
-		Limit:         int32(limit),
-		Offset:        int32(offset),
+	rows, err := r.queries.SearchWords(ctx, db.SearchWordsParams{
+		Query:        forms.Text,
+		LikeQuery:    escapeLike(forms.Text),
+		LikeFolded:   escapeLike(forms.Folded),
+		LikeNumbered: escapeLike(forms.Numbered),
+		LikeToneless: escapeLike(forms.Toneless),
+		Folded:       forms.Folded,
+		Numbered:     forms.Numbered,
+		LanguageID:   languageID,
+		Limit:        int32(limit),
+		Offset:       int32(offset),
 	})
-
 	if err != nil {
-		return nil, sharederrors.MapDictionaryRepositoryError(err, "SearchWords")
+		return nil, 0, sharederrors.MapDictionaryRepositoryError(err, "SearchWords")
 	}
 
-	words := make([]*domain.Word, 0, len(wordRows))
-	for _, row := range wordRows {
-		words = append(words, r.mapWordRow(row))
+	// The total comes from a window function, so a page past the end has no row to carry it
+	if len(rows) == 0 {
+		if offset == 0 {
+			return []*domain.WordSearchResult{}, 0, nil
+		}
+		total, err := r.CountSearchWords(ctx, query, languageID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return []*domain.WordSearchResult{}, total, nil
 	}
 
-	return words, nil
+	results := make([]*domain.WordSearchResult, 0, len(rows))
+	for _, row := range rows {
+		results = append(results, &domain.WordSearchResult{
+			Word: r.mapWordRow(db.Word{
+				ID:              row.ID,
+				LanguageID:      row.LanguageID,
+				Lemma:           row.Lemma,
+				LemmaNormalized: row.LemmaNormalized,
+				SearchKey:       row.SearchKey,
+				Romanization:    row.Romanization,
+				ScriptCode:      row.ScriptCode,
+				FrequencyRank:   row.FrequencyRank,
+				Note:            row.Note,
+				CreatedAt:       row.CreatedAt,
+				UpdatedAt:       row.UpdatedAt,
+			}),
+			MatchType: matchTypeFromRank(row.MatchRank),
+			Score:     float64(row.Score),
+		})
+	}
+
+	return results, int(rows[0].TotalCount), nil
 }
 
 // CountSearchWords returns the total count of words matching the search query
 func (r *wordRepository) CountSearchWords(ctx context.Context, query string, languageID int16) (int, error) {
-	searchPattern := "%" + query + "%"
+	forms := normalize.Query(query, r.simplifier())
+	if forms.Text == "" || forms.Folded == "" {
+		return 0, nil
+	}
 
 	count, err := r.queries.CountSearchWords(ctx, db.CountSearchWordsParams{
-		LanguageID:    languageID,
-		SearchPattern: searchPattern,
+		LanguageID:   languageID,
+		LikeQuery:    escapeLike(forms.Text),
+		LikeFolded:   escapeLike(forms.Folded),
+		LikeNumbered: escapeLike(forms.Numbered),
+		LikeToneless: escapeLike(forms.Toneless),
+		Query:        forms.Text,
+		Folded:       forms.Folded,
+		Numbered:     forms.Numbered,
 	})
 
 	if err != nil {
@@ -219,6 +267,27 @@ func (r *wordRepository) CountSearchWords(ctx context.Context, query string, lan
 	return int(count), nil
 }
 
+// matchTypeFromRank maps the match_rank computed by SearchWords to a match type
+func matchTypeFromRank(rank int32) string {
+	switch rank {
+	case 1:
+		return domain.MatchTypeExact
+	case 2:
+		return domain.MatchTypePrefix
+	case 3:
+		return domain.MatchTypeNormalized
+	case 4:
+		return domain.MatchTypeSubstring
+	default:
+		return domain.MatchTypeFuzzy
+	}
+}
+
+// escapeLike escapes LIKE wildcards so user input is matched literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
 // mapWordRow maps sqlc generated row to domain model
 func (r *wordRepository) mapWordRow(row db.Word) *domain.Word {
 	var lemmaNormalized, searchKey, romanization, scriptCode, note *string
@@ -0,0 +1,285 @@
+package dictionary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+)
+
+// SnapshotWord loads the whole entry of a word in the WordJSON seed format: the word with its topics,
+// pronunciations, relations and senses with their translations and examples. Lists are sorted so
+// that equal entries produce equal snapshots. A missing word fails with pgx.ErrNoRows.
+func SnapshotWord(ctx context.Context, tx pgx.Tx, wordID int64) (*domain.WordJSON, error) {
+	words, err := SnapshotWords(ctx, db.New(tx), []int64{wordID})
+	if err != nil {
+		return nil, err
+	}
+	word, ok := words[wordID]
+	if !ok {
+		return nil, fmt.Errorf("load word %d: %w", wordID, pgx.ErrNoRows)
+	}
+	return word, nil
+}
+
+// SnapshotWords loads the entries of several words like SnapshotWord, with one query per table.
+// Words that do not exist are missing from the result.
+func SnapshotWords(ctx context.Context, q *db.Queries, wordIDs []int64) (map[int64]*domain.WordJSON, error) {
+	heads, err := q.FindSnapshotWords(ctx, wordIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load words: %w", err)
+	}
+	words := make(map[int64]*domain.WordJSON, len(heads))
+	for _, head := range heads {
+		words[head.ID] = &domain.WordJSON{
+			Language:        head.LanguageCode,
+			Lemma:           head.Lemma,
+			LemmaNormalized: textPtr(head.LemmaNormalized),
+			SearchKey:       textPtr(head.SearchKey),
+			Romanization:    textPtr(head.Romanization),
+			ScriptCode:      textPtr(head.ScriptCode),
+			FrequencyRank:   int4Ptr(head.FrequencyRank),
+			Note:            textPtr(head.Note),
+		}
+	}
+	if len(words) == 0 {
+		return words, nil
+	}
+
+	topics, err := q.FindSnapshotTopics(ctx, wordIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load topics: %w", err)
+	}
+	for _, t := range topics {
+		word := words[t.WordID]
+		word.Topics = append(word.Topics, t.Code)
+	}
+
+	pronunciations, err := q.FindSnapshotPronunciations(ctx, wordIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load pronunciations: %w", err)
+	}
+	for _, p := range pronunciations {
+		word := words[p.WordID]
+		word.Pronunciations = append(word.Pronunciations, domain.PronunciationJSON{
+			Dialect:  p.Dialect,
+			IPA:      textPtr(p.Ipa),
+			Phonetic: textPtr(p.Phonetic),
+			AudioURL: textPtr(p.AudioUrl),
+		})
+	}
+
+	relations, err := q.FindSnapshotRelations(ctx, wordIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load relations: %w", err)
+	}
+	for _, r := range relations {
+		word := words[r.FromWordID]
+		word.Relations = append(word.Relations, domain.WordRelationJSON{
+			RelationType: r.RelationType,
+			Note:         textPtr(r.Note),
+			TargetWord:   snapshotRelatedWord(r.LanguageCode, r.Lemma, r.Romanization, r.ScriptCode),
+		})
+	}
+
+	if err := snapshotSenses(ctx, q, wordIDs, words); err != nil {
+		return nil, err
+	}
+	return words, nil
+}
+
+// snapshotSenses loads the senses of words with their translations and examples
+func snapshotSenses(ctx context.Context, q *db.Queries, wordIDs []int64, words map[int64]*domain.WordJSON) error {
+	rows, err := q.FindSnapshotSenses(ctx, wordIDs)
+	if err != nil {
+		return fmt.Errorf("load senses: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	// Senses are appended in order, so a sense is addressed by its word and index
+	type senseRef struct {
+		wordID int64
+		index  int
+	}
+	senseRefs := make(map[int64]senseRef, len(rows))
+	senseIDs := make([]int64, len(rows))
+	for i, s := range rows {
+		word := words[s.WordID]
+		senseRefs[s.ID] = senseRef{wordID: s.WordID, index: len(word.Senses)}
+		senseIDs[i] = s.ID
+		word.Senses = append(word.Senses, domain.SenseJSON{
+			Order:              int(s.SenseOrder),
+			PartOfSpeech:       s.PartOfSpeechCode,
+			DefinitionLanguage: s.DefinitionLanguageCode,
+			Definition:         s.Definition,
+			UsageLabel:         textPtr(s.UsageLabel),
+			Level:              textPtr(s.LevelCode),
+			Note:               textPtr(s.Note),
+		})
+	}
+	sense := func(senseID int64) *domain.SenseJSON {
+		ref := senseRefs[senseID]
+		return &words[ref.wordID].Senses[ref.index]
+	}
+
+	translations, err := q.FindSnapshotSenseTranslations(ctx, senseIDs)
+	if err != nil {
+		return fmt.Errorf("load sense translations: %w", err)
+	}
+	for _, t := range translations {
+		s := sense(t.SourceSenseID)
+		s.Translations = append(s.Translations, domain.SenseTranslationJSON{
+			Priority:   int(t.Priority.Int16),
+			Note:       textPtr(t.Note),
+			TargetWord: snapshotRelatedWord(t.LanguageCode, t.Lemma, t.Romanization, t.ScriptCode),
+		})
+	}
+
+	examples, err := q.FindSnapshotExamples(ctx, senseIDs)
+	if err != nil {
+		return fmt.Errorf("load examples: %w", err)
+	}
+	if len(examples) == 0 {
+		return nil
+	}
+	exampleIDs := make([]int64, len(examples))
+	for i, ex := range examples {
+		exampleIDs[i] = ex.ID
+	}
+	exampleTranslations, err := q.FindSnapshotExampleTranslations(ctx, exampleIDs)
+	if err != nil {
+		return fmt.Errorf("load example translations: %w", err)
+	}
+	translationsByExample := make(map[int64][]domain.ExampleTranslationJSON)
+	for _, tr := range exampleTranslations {
+		translationsByExample[tr.ExampleID] = append(translationsByExample[tr.ExampleID], domain.ExampleTranslationJSON{
+			Language: tr.LanguageCode,
+			Content:  tr.Content,
+		})
+	}
+	for _, ex := range examples {
+		s := sense(ex.SourceSenseID)
+		s.Examples = append(s.Examples, domain.ExampleJSON{
+			Language:     ex.LanguageCode,
+			Content:      ex.Content,
+			AudioURL:     textPtr(ex.AudioUrl),
+			Translations: translationsByExample[ex.ID],
+		})
+	}
+	return nil
+}
+
+// snapshotRelatedWord identifies a translation or relation target by language and lemma
+func snapshotRelatedWord(languageCode, lemma string, romanization, scriptCode pgtype.Text) domain.RelatedWordJSON {
+	return domain.RelatedWordJSON{
+		Language:     languageCode,
+		Lemma:        lemma,
+		Romanization: textPtr(romanization),
+		ScriptCode:   textPtr(scriptCode),
+	}
+}
+
+// RecordRevision snapshots a word and appends it to the word's revision history, returning the new
+// revision number. Updates and seeds that leave the entry as the latest revision has it are not
+// recorded, in which case 0 is returned. The caller must hold the word's row lock (or be the only
+// writer, like the seeder) so revision numbers do not collide.
+func RecordRevision(ctx context.Context, tx pgx.Tx, wordID int64, meta domain.RevisionMeta) (int, error) {
+	snapshot, err := SnapshotWord(ctx, tx, wordID)
+	if err != nil {
+		return 0, err
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return 0, fmt.Errorf("encode snapshot of word %d: %w", wordID, err)
+	}
+
+	q := db.New(tx)
+	if meta.Action == domain.RevisionActionUpdate || meta.Action == domain.RevisionActionSeed {
+		unchanged, err := q.LatestWordRevisionMatches(ctx, db.LatestWordRevisionMatchesParams{
+			WordID:   wordID,
+			Snapshot: data,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("compare revision of word %d: %w", wordID, err)
+		}
+		if unchanged {
+			return 0, nil
+		}
+	}
+
+	var summary *string
+	if meta.Summary != "" {
+		summary = &meta.Summary
+	}
+	revision, err := q.CreateWordRevision(ctx, db.CreateWordRevisionParams{
+		WordID:   wordID,
+		Action:   meta.Action,
+		Source:   meta.Source,
+		UserID:   pgInt8(meta.UserID),
+		Summary:  pgText(summary),
+		Snapshot: data,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("insert revision of word %d: %w", wordID, err)
+	}
+	return int(revision), nil
+}
+
+// RecordRevisions snapshots several words like RecordRevision, with one query per table and a
+// single insert, and returns how many revisions were recorded. Words whose latest revision already
+// holds their entry are skipped whatever the action, so it suits bulk seeds and updates.
+func RecordRevisions(ctx context.Context, tx pgx.Tx, wordIDs []int64, meta domain.RevisionMeta) (int64, error) {
+	if len(wordIDs) == 0 {
+		return 0, nil
+	}
+	q := db.New(tx)
+	snapshots, err := SnapshotWords(ctx, q, wordIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	// A word listed twice would get the same revision number twice
+	ids := make([]int64, 0, len(snapshots))
+	data := make([][]byte, 0, len(snapshots))
+	seen := make(map[int64]bool, len(wordIDs))
+	for _, wordID := range wordIDs {
+		if seen[wordID] {
+			continue
+		}
+		seen[wordID] = true
+		snapshot, ok := snapshots[wordID]
+		if !ok {
+			return 0, fmt.Errorf("load word %d: %w", wordID, pgx.ErrNoRows)
+		}
+		encoded, err := json.Marshal(snapshot)
+		if err != nil {
+			return 0, fmt.Errorf("encode snapshot of word %d: %w", wordID, err)
+		}
+		ids = append(ids, wordID)
+		data = append(data, encoded)
+	}
+
+	var summary *string
+	if meta.Summary != "" {
+		summary = &meta.Summary
+	}
+	recorded, err := q.CreateWordRevisions(ctx, db.CreateWordRevisionsParams{
+		Action:    meta.Action,
+		Source:    meta.Source,
+		UserID:    pgInt8(meta.UserID),
+		Summary:   pgText(summary),
+		WordIds:   ids,
+		Snapshots: data,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("insert revisions: %w", err)
+	}
+	return recorded, nil
+}
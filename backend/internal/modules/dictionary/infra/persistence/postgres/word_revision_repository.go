@@ -0,0 +1,72 @@
+package dictionary
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// wordRevisionRepository implements WordRevisionRepository using sqlc
+type wordRevisionRepository struct {
+	*DictionaryRepository
+}
+
+// FindWordRevisions returns one page of revisions of a word, newest first, plus the total count
+func (r *wordRevisionRepository) FindWordRevisions(ctx context.Context, wordID int64, limit, offset int) ([]*domain.WordRevision, int, error) {
+	rows, err := r.queries.FindWordRevisions(ctx, db.FindWordRevisionsParams{
+		WordID: wordID,
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		return nil, 0, sharederrors.MapDictionaryRepositoryError(err, "FindWordRevisions")
+	}
+	total, err := r.queries.CountWordRevisions(ctx, wordID)
+	if err != nil {
+		return nil, 0, sharederrors.MapDictionaryRepositoryError(err, "CountWordRevisions")
+	}
+
+	revisions := make([]*domain.WordRevision, len(rows))
+	for i, row := range rows {
+		revisions[i] = toWordRevision(row.ID, row.WordID, row.Revision, row.Action, row.Source, row.UserID, row.Username, row.Summary, row.CreatedAt)
+	}
+	return revisions, int(total), nil
+}
+
+// FindWordRevision returns one revision of a word with its snapshot
+func (r *wordRevisionRepository) FindWordRevision(ctx context.Context, wordID int64, revision int) (*domain.WordRevision, error) {
+	row, err := r.queries.GetWordRevision(ctx, db.GetWordRevisionParams{
+		WordID:   wordID,
+		Revision: int32(revision),
+	})
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "GetWordRevision")
+	}
+
+	result := toWordRevision(row.ID, row.WordID, row.Revision, row.Action, row.Source, row.UserID, row.Username, row.Summary, row.CreatedAt)
+	result.Snapshot = &domain.WordJSON{}
+	if err := json.Unmarshal(row.Snapshot, result.Snapshot); err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "DecodeWordRevision")
+	}
+	return result, nil
+}
+
+// toWordRevision maps the columns shared by revision rows to a WordRevision
+func toWordRevision(id, wordID int64, revision int32, action, source string, userID pgtype.Int8, username, summary pgtype.Text, createdAt pgtype.Timestamp) *domain.WordRevision {
+	return &domain.WordRevision{
+		ID:        id,
+		WordID:    wordID,
+		Revision:  int(revision),
+		Action:    action,
+		Source:    source,
+		UserID:    int8Ptr(userID),
+		Username:  textPtr(username),
+		Summary:   textPtr(summary),
+		CreatedAt: createdAt.Time,
+	}
+}
@@ -0,0 +1,132 @@
+package dictionary
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/dictionary"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/normalize"
+)
+
+// FindWordSuggestEntries returns every word with the fields the suggest index needs
+func (r *wordRepository) FindWordSuggestEntries(ctx context.Context) ([]*domain.WordSuggestEntry, error) {
+	rows, err := r.queries.FindWordSuggestEntries(ctx)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindWordSuggestEntries")
+	}
+
+	entries := make([]*domain.WordSuggestEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, &domain.WordSuggestEntry{
+			ID:              row.ID,
+			LanguageID:      row.LanguageID,
+			Lemma:           row.Lemma,
+			LemmaNormalized: textPtr(row.LemmaNormalized),
+			SearchKey:       textPtr(row.SearchKey),
+			Romanization:    textPtr(row.Romanization),
+			FrequencyRank:   int4Ptr(row.FrequencyRank),
+		})
+	}
+
+	return entries, nil
+}
+
+// GetWordsVersion returns the word count and latest updated_at of the words table
+func (r *wordRepository) GetWordsVersion(ctx context.Context) (*domain.WordsVersion, error) {
+	row, err := r.queries.GetWordsVersion(ctx)
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "GetWordsVersion")
+	}
+
+	return &domain.WordsVersion{
+		WordCount:     row.WordCount,
+		LastUpdatedAt: row.LastUpdatedAt.Time,
+	}, nil
+}
+
+// FindWordsUpdatedSince returns the IDs and updated_at of words changed at or after since, oldest first
+func (r *wordRepository) FindWordsUpdatedSince(ctx context.Context, since time.Time) ([]*domain.WordUpdate, error) {
+	rows, err := r.queries.FindWordsUpdatedSince(ctx, pgtype.Timestamp{Time: since, Valid: true})
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "FindWordsUpdatedSince")
+	}
+
+	updates := make([]*domain.WordUpdate, 0, len(rows))
+	for _, row := range rows {
+		updates = append(updates, &domain.WordUpdate{
+			ID:        row.ID,
+			UpdatedAt: row.UpdatedAt.Time,
+		})
+	}
+
+	return updates, nil
+}
+
+// SuggestWords returns prefix matches on lemma, search_key and romanization from the database,
+// ranked by matched field, then frequency and lemma length
+func (r *wordRepository) SuggestWords(ctx context.Context, query string, languageID int16, limit int) ([]*domain.WordSuggestion, error) {
+	forms := normalize.Query(query, r.simplifier())
+	if forms.Text == "" || forms.Folded == "" {
+		return []*domain.WordSuggestion{}, nil
+	}
+
+	rows, err := r.queries.SuggestWords(ctx, db.SuggestWordsParams{
+		LikeQuery:    escapeLike(forms.Text),
+		LikeFolded:   escapeLike(forms.Folded),
+		LikeNumbered: escapeLike(forms.Numbered),
+		LikeToneless: escapeLike(forms.Toneless),
+		LanguageID:   languageID,
+		Limit:        int32(limit),
+	})
+	if err != nil {
+		return nil, sharederrors.MapDictionaryRepositoryError(err, "SuggestWords")
+	}
+
+	suggestions := make([]*domain.WordSuggestion, 0, len(rows))
+	for _, row := range rows {
+		suggestions = append(suggestions, &domain.WordSuggestion{
+			WordID:        row.ID,
+			LanguageID:    row.LanguageID,
+			Lemma:         row.Lemma,
+			Romanization:  textPtr(row.Romanization),
+			FrequencyRank: int4Ptr(row.FrequencyRank),
+			MatchedOn:     suggestMatchFromRank(row.MatchRank),
+		})
+	}
+
+	return suggestions, nil
+}
+
+// suggestMatchFromRank maps the match_rank computed by SuggestWords to a match field
+func suggestMatchFromRank(rank int32) string {
+	switch rank {
+	case 1:
+		return domain.SuggestMatchLemma
+	case 2:
+		return domain.SuggestMatchSearchKey
+	default:
+		return domain.SuggestMatchRomanization
+	}
+}
+
+// textPtr converts a nullable text column to a string pointer
+func textPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	s := t.String
+	return &s
+}
+
+// int4Ptr converts a nullable int4 column to an int pointer
+func int4Ptr(i pgtype.Int4) *int {
+	if !i.Valid {
+		return nil
+	}
+	v := int(i.Int32)
+	return &v
+}
@@ -0,0 +1,721 @@
+package dictionary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/normalize"
+)
+
+// WordUpserter writes WordJSON documents inside a caller-owned transaction. Words are keyed by
+// (language, lemma), senses by (word, order), pronunciations by (word, dialect) and examples by
+// (sense, language, content), so writing the same document twice leaves the database unchanged.
+// It caches code and word lookups and is meant to be used for a single transaction.
+type WordUpserter struct {
+	scripts normalize.Simplifier // optional, simplifies Chinese lemma_normalized
+
+	languages  map[string]int16
+	pos        map[string]int16
+	topics     map[string]int64
+	levels     map[string]int64
+	words      map[string]int64 // key: lang|lemma
+	characters map[string]int64 // key: literal|script
+}
+
+// NewWordUpserter creates an upserter. scripts may be nil.
+func NewWordUpserter(scripts normalize.Simplifier) *WordUpserter {
+	return &WordUpserter{
+		scripts:    scripts,
+		languages:  make(map[string]int16),
+		pos:        make(map[string]int16),
+		topics:     make(map[string]int64),
+		levels:     make(map[string]int64),
+		words:      make(map[string]int64),
+		characters: make(map[string]int64),
+	}
+}
+
+// LanguageID returns the ID of a language code
+func (u *WordUpserter) LanguageID(ctx context.Context, tx pgx.Tx, code string) (int16, error) {
+	if id, ok := u.languages[code]; ok {
+		return id, nil
+	}
+	const q = `SELECT id FROM languages WHERE code = $1`
+	var id int16
+	if err := tx.QueryRow(ctx, q, code).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("language %q: %w", code, domain.ErrLanguageNotFound)
+		}
+		return 0, fmt.Errorf("get language id for %s: %w", code, err)
+	}
+	u.languages[code] = id
+	return id, nil
+}
+
+// PartOfSpeechID returns the ID of a part of speech code
+func (u *WordUpserter) PartOfSpeechID(ctx context.Context, tx pgx.Tx, code string) (int16, error) {
+	if id, ok := u.pos[code]; ok {
+		return id, nil
+	}
+	const q = `SELECT id FROM parts_of_speech WHERE code = $1`
+	var id int16
+	if err := tx.QueryRow(ctx, q, code).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("part of speech %q: %w", code, domain.ErrPartOfSpeechNotFound)
+		}
+		return 0, fmt.Errorf("get part_of_speech_id for %s: %w", code, err)
+	}
+	u.pos[code] = id
+	return id, nil
+}
+
+// TopicID returns the ID of a topic code
+func (u *WordUpserter) TopicID(ctx context.Context, tx pgx.Tx, code string) (int64, error) {
+	if id, ok := u.topics[code]; ok {
+		return id, nil
+	}
+	const q = `SELECT id FROM topics WHERE code = $1`
+	var id int64
+	if err := tx.QueryRow(ctx, q, code).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("topic %q: %w", code, domain.ErrTopicNotFound)
+		}
+		return 0, fmt.Errorf("get topic id for %s: %w", code, err)
+	}
+	u.topics[code] = id
+	return id, nil
+}
+
+// LevelID returns the ID of a level code, or nil when code is empty
+func (u *WordUpserter) LevelID(ctx context.Context, tx pgx.Tx, code *string) (*int64, error) {
+	if code == nil || *code == "" {
+		return nil, nil
+	}
+	if id, ok := u.levels[*code]; ok {
+		return &id, nil
+	}
+	const q = `SELECT id FROM levels WHERE code = $1`
+	var id int64
+	if err := tx.QueryRow(ctx, q, *code).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("level %q: %w", *code, domain.ErrLevelNotFound)
+		}
+		return nil, fmt.Errorf("get level id for %s: %w", *code, err)
+	}
+	u.levels[*code] = id
+	return &id, nil
+}
+
+// FillSearchKeys computes lemma_normalized and search_key for entries that omit them,
+// keeping any value already provided. Chinese lemma_normalized is always stored simplified.
+func (u *WordUpserter) FillSearchKeys(languageCode, lemma string, romanization, lemmaNormalized, searchKey *string) (*string, *string) {
+	if languageCode == "zh" && lemmaNormalized != nil && *lemmaNormalized != "" && u.scripts != nil {
+		simplified := u.scripts.ToSimplified(*lemmaNormalized)
+		lemmaNormalized = &simplified
+	}
+	if lemmaNormalized != nil && *lemmaNormalized != "" && searchKey != nil && *searchKey != "" {
+		return lemmaNormalized, searchKey
+	}
+
+	normalized, key := normalize.Keys(languageCode, lemma, romanization, u.scripts)
+	if (lemmaNormalized == nil || *lemmaNormalized == "") && normalized != "" {
+		lemmaNormalized = &normalized
+	}
+	if (searchKey == nil || *searchKey == "") && key != "" {
+		searchKey = &key
+	}
+	return lemmaNormalized, searchKey
+}
+
+// FindWordID returns the ID of the word with the given lemma, or 0 when there is none
+func (u *WordUpserter) FindWordID(ctx context.Context, tx pgx.Tx, languageID int16, lemma string) (int64, error) {
+	const q = `
+SELECT id
+FROM words
+WHERE language_id = $1
+  AND lemma = $2
+ORDER BY id
+LIMIT 1
+`
+	var id int64
+	if err := tx.QueryRow(ctx, q, languageID, lemma).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("select existing word: %w", err)
+	}
+	return id, nil
+}
+
+// UpsertWord writes a word and everything it carries: topics, pronunciations, senses with their
+// translations and examples, relations and characters.
+func (u *WordUpserter) UpsertWord(ctx context.Context, tx pgx.Tx, languageCode string, w domain.WordJSON) (int64, error) {
+	languageID, err := u.LanguageID(ctx, tx, languageCode)
+	if err != nil {
+		return 0, err
+	}
+
+	w.LemmaNormalized, w.SearchKey = u.FillSearchKeys(languageCode, w.Lemma, w.Romanization, w.LemmaNormalized, w.SearchKey)
+
+	wordID, err := u.upsertSingleWord(ctx, tx, languageID, w)
+	if err != nil {
+		return 0, err
+	}
+	u.words[wordCacheKey(languageCode, w.Lemma)] = wordID
+
+	if err := u.upsertWordDetails(ctx, tx, wordID, w); err != nil {
+		return 0, err
+	}
+	return wordID, nil
+}
+
+func (u *WordUpserter) upsertSingleWord(ctx context.Context, tx pgx.Tx, languageID int16, w domain.WordJSON) (int64, error) {
+	const insertQ = `
+INSERT INTO words (
+    language_id,
+    lemma,
+    lemma_normalized,
+    search_key,
+    romanization,
+    script_code,
+    frequency_rank,
+    note
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id
+`
+
+	const updateQ = `
+UPDATE words
+SET
+    lemma_normalized = $2,
+    search_key       = $3,
+    romanization     = $4,
+    script_code      = $5,
+    frequency_rank   = $6,
+    note             = $7,
+    updated_at       = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+	wordID, err := u.FindWordID(ctx, tx, languageID, w.Lemma)
+	if err != nil {
+		return 0, err
+	}
+
+	if wordID == 0 {
+		// Insert new word
+		if err := tx.QueryRow(
+			ctx,
+			insertQ,
+			languageID,
+			w.Lemma,
+			w.LemmaNormalized,
+			w.SearchKey,
+			w.Romanization,
+			w.ScriptCode,
+			w.FrequencyRank,
+			w.Note,
+		).Scan(&wordID); err != nil {
+			return 0, fmt.Errorf("insert word: %w", err)
+		}
+
+		return wordID, nil
+	}
+
+	// Update existing word
+	if _, err := tx.Exec(
+		ctx,
+		updateQ,
+		wordID,
+		w.LemmaNormalized,
+		w.SearchKey,
+		w.Romanization,
+		w.ScriptCode,
+		w.FrequencyRank,
+		w.Note,
+	); err != nil {
+		return 0, fmt.Errorf("update word: %w", err)
+	}
+
+	return wordID, nil
+}
+
+// upsertWordDetails handles all dictionary structures that depend on a word:
+// topics, pronunciations, senses, translations, relations, examples, characters, etc.
+func (u *WordUpserter) upsertWordDetails(ctx context.Context, tx pgx.Tx, wordID int64, w domain.WordJSON) error {
+	// Topics
+	if err := u.AddWordTopics(ctx, tx, wordID, w.Topics); err != nil {
+		return err
+	}
+
+	// Pronunciations
+	for _, p := range w.Pronunciations {
+		if _, err := u.UpsertPronunciation(ctx, tx, wordID, p); err != nil {
+			return err
+		}
+	}
+
+	// Senses, translations, examples
+	for _, s := range w.Senses {
+		if _, err := u.UpsertSense(ctx, tx, wordID, s); err != nil {
+			return err
+		}
+	}
+
+	// Relations; the seed files may list a word as related to itself, which is skipped
+	for _, r := range w.Relations {
+		if _, err := u.UpsertRelation(ctx, tx, wordID, r); err != nil && !errors.Is(err, domain.ErrSelfRelation) {
+			return err
+		}
+	}
+
+	// Characters (mainly for Chinese)
+	return u.upsertWordCharacters(ctx, tx, wordID, w.Characters)
+}
+
+// --------- Word topics ----------
+
+// AddWordTopics links a word to topics by code; existing links are kept
+func (u *WordUpserter) AddWordTopics(ctx context.Context, tx pgx.Tx, wordID int64, topicCodes []string) error {
+	const insertQ = `
+INSERT INTO word_topics (word_id, topic_id)
+VALUES ($1, $2)
+ON CONFLICT (word_id, topic_id) DO NOTHING
+`
+
+	for _, code := range topicCodes {
+		if code == "" {
+			continue
+		}
+		topicID, err := u.TopicID(ctx, tx, code)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, insertQ, wordID, topicID); err != nil {
+			return fmt.Errorf("insert word_topic (%d, %d): %w", wordID, topicID, err)
+		}
+	}
+	return nil
+}
+
+// --------- Pronunciations ----------
+
+// UpsertPronunciation writes the pronunciation of a word for a dialect and returns its ID
+func (u *WordUpserter) UpsertPronunciation(ctx context.Context, tx pgx.Tx, wordID int64, p domain.PronunciationJSON) (int64, error) {
+	const insertQ = `
+INSERT INTO pronunciations (word_id, dialect, ipa, phonetic, audio_url)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (word_id, dialect) DO UPDATE
+SET ipa = EXCLUDED.ipa,
+    phonetic = EXCLUDED.phonetic,
+    audio_url = EXCLUDED.audio_url
+RETURNING id
+`
+
+	var id int64
+	if err := tx.QueryRow(ctx, insertQ, wordID, p.Dialect, p.IPA, p.Phonetic, p.AudioURL).Scan(&id); err != nil {
+		return 0, fmt.Errorf("upsert pronunciation: %w", err)
+	}
+	return id, nil
+}
+
+// --------- Senses, translations, examples ----------
+
+// UpsertSense writes the sense of a word at s.Order with its translations and examples and returns its ID
+func (u *WordUpserter) UpsertSense(ctx context.Context, tx pgx.Tx, wordID int64, s domain.SenseJSON) (int64, error) {
+	const insertSenseQ = `
+INSERT INTO senses (word_id, sense_order, part_of_speech_id, definition, definition_language_id, usage_label, level_id, note)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (word_id, sense_order) DO UPDATE
+SET part_of_speech_id = EXCLUDED.part_of_speech_id,
+    definition = EXCLUDED.definition,
+    usage_label = EXCLUDED.usage_label,
+    level_id = EXCLUDED.level_id,
+    note = EXCLUDED.note
+RETURNING id
+`
+
+	defLangID, err := u.LanguageID(ctx, tx, s.DefinitionLanguage)
+	if err != nil {
+		return 0, err
+	}
+
+	levelID, err := u.LevelID(ctx, tx, s.Level)
+	if err != nil {
+		return 0, err
+	}
+
+	posID, err := u.PartOfSpeechID(ctx, tx, s.PartOfSpeech)
+	if err != nil {
+		return 0, err
+	}
+
+	var senseID int64
+	if err := tx.QueryRow(
+		ctx,
+		insertSenseQ,
+		wordID,
+		s.Order,
+		posID,
+		s.Definition,
+		defLangID,
+		s.UsageLabel,
+		levelID,
+		s.Note,
+	).Scan(&senseID); err != nil {
+		return 0, fmt.Errorf("upsert sense: %w", err)
+	}
+
+	// Translations for this sense
+	for _, t := range s.Translations {
+		if _, err := u.UpsertSenseTranslation(ctx, tx, senseID, t); err != nil {
+			return 0, err
+		}
+	}
+
+	// Examples for this sense
+	for _, ex := range s.Examples {
+		if _, err := u.UpsertExample(ctx, tx, senseID, ex); err != nil {
+			return 0, err
+		}
+	}
+
+	return senseID, nil
+}
+
+// UpsertSenseTranslation links a sense to its translation, creating the target word if needed,
+// and returns the target word ID
+func (u *WordUpserter) UpsertSenseTranslation(ctx context.Context, tx pgx.Tx, senseID int64, t domain.SenseTranslationJSON) (int64, error) {
+	const insertQ = `
+INSERT INTO sense_translations (source_sense_id, target_word_id, priority, note)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (source_sense_id, target_word_id) DO UPDATE
+SET priority = EXCLUDED.priority,
+    note = EXCLUDED.note
+RETURNING id
+`
+
+	targetWordID, err := u.UpsertRelatedWord(ctx, tx, t.TargetWord)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.Exec(
+		ctx,
+		insertQ,
+		senseID,
+		targetWordID,
+		t.Priority,
+		t.Note,
+	); err != nil {
+		return 0, fmt.Errorf("upsert sense_translation: %w", err)
+	}
+
+	return targetWordID, nil
+}
+
+// UpsertExample writes an example sentence of a sense with its translations and returns its ID
+func (u *WordUpserter) UpsertExample(ctx context.Context, tx pgx.Tx, senseID int64, ex domain.ExampleJSON) (int64, error) {
+	const selectExampleQ = `
+SELECT id
+FROM examples
+WHERE source_sense_id = $1 AND language_id = $2 AND content = $3
+`
+
+	const insertExampleQ = `
+INSERT INTO examples (source_sense_id, language_id, content, audio_url, source)
+VALUES ($1, $2, $3, $4, NULL)
+RETURNING id
+`
+
+	const updateExampleQ = `
+UPDATE examples
+SET audio_url = $2
+WHERE id = $1
+`
+
+	langID, err := u.LanguageID(ctx, tx, ex.Language)
+	if err != nil {
+		return 0, err
+	}
+
+	var exampleID int64
+	err = tx.QueryRow(ctx, selectExampleQ, senseID, langID, ex.Content).Scan(&exampleID)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("select example: %w", err)
+		}
+		if err := tx.QueryRow(
+			ctx,
+			insertExampleQ,
+			senseID,
+			langID,
+			ex.Content,
+			ex.AudioURL,
+		).Scan(&exampleID); err != nil {
+			return 0, fmt.Errorf("insert example: %w", err)
+		}
+	} else {
+		if _, err := tx.Exec(ctx, updateExampleQ, exampleID, ex.AudioURL); err != nil {
+			return 0, fmt.Errorf("update example: %w", err)
+		}
+	}
+
+	if err := u.UpsertExampleTranslations(ctx, tx, exampleID, ex.Translations); err != nil {
+		return 0, err
+	}
+
+	return exampleID, nil
+}
+
+// UpsertExampleTranslations writes the translations of an example, one per language
+func (u *WordUpserter) UpsertExampleTranslations(ctx context.Context, tx pgx.Tx, exampleID int64, translations []domain.ExampleTranslationJSON) error {
+	const insertTransQ = `
+INSERT INTO example_translations (example_id, language_id, content)
+VALUES ($1, $2, $3)
+ON CONFLICT (example_id, language_id) DO UPDATE
+SET content = EXCLUDED.content
+`
+
+	for _, tr := range translations {
+		trLangID, err := u.LanguageID(ctx, tx, tr.Language)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(
+			ctx,
+			insertTransQ,
+			exampleID,
+			trLangID,
+			tr.Content,
+		); err != nil {
+			return fmt.Errorf("upsert example_translation: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// --------- Word relations ----------
+
+// UpsertRelation relates a word to a target word, creating the target if needed, and returns the
+// target word ID. It returns domain.ErrSelfRelation without writing when the target is the word itself.
+func (u *WordUpserter) UpsertRelation(ctx context.Context, tx pgx.Tx, fromWordID int64, r domain.WordRelationJSON) (int64, error) {
+	const insertQ = `
+INSERT INTO word_relations (from_word_id, to_word_id, relation_type, note)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (from_word_id, to_word_id, relation_type) DO UPDATE
+SET note = EXCLUDED.note
+`
+
+	targetWordID, err := u.UpsertRelatedWord(ctx, tx, r.TargetWord)
+	if err != nil {
+		return 0, err
+	}
+
+	// from_word_id == to_word_id is rejected by a CHECK constraint
+	if fromWordID == targetWordID {
+		return targetWordID, domain.ErrSelfRelation
+	}
+
+	if _, err := tx.Exec(
+		ctx,
+		insertQ,
+		fromWordID,
+		targetWordID,
+		r.RelationType,
+		r.Note,
+	); err != nil {
+		return 0, fmt.Errorf("upsert word_relation: %w", err)
+	}
+
+	return targetWordID, nil
+}
+
+// UpsertRelatedWord ensures a translation or relation target exists in the words table and returns its ID.
+// Existing words are left as they are.
+func (u *WordUpserter) UpsertRelatedWord(ctx context.Context, tx pgx.Tx, w domain.RelatedWordJSON) (int64, error) {
+	key := wordCacheKey(w.Language, w.Lemma)
+	if id, ok := u.words[key]; ok {
+		return id, nil
+	}
+
+	const insertQ = `
+INSERT INTO words (
+    language_id,
+    lemma,
+    lemma_normalized,
+    search_key,
+    romanization,
+    script_code,
+    frequency_rank,
+    note
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id
+`
+
+	languageID, err := u.LanguageID(ctx, tx, w.Language)
+	if err != nil {
+		return 0, err
+	}
+
+	w.LemmaNormalized, w.SearchKey = u.FillSearchKeys(w.Language, w.Lemma, w.Romanization, w.LemmaNormalized, w.SearchKey)
+
+	id, err := u.FindWordID(ctx, tx, languageID, w.Lemma)
+	if err != nil {
+		return 0, fmt.Errorf("select related word: %w", err)
+	}
+	if id == 0 {
+		if err := tx.QueryRow(
+			ctx,
+			insertQ,
+			languageID,
+			w.Lemma,
+			w.LemmaNormalized,
+			w.SearchKey,
+			w.Romanization,
+			w.ScriptCode,
+			w.FrequencyRank,
+			w.Note,
+		).Scan(&id); err != nil {
+			return 0, fmt.Errorf("insert related word: %w", err)
+		}
+	}
+
+	u.words[key] = id
+	return id, nil
+}
+
+// --------- Characters & readings ----------
+
+func (u *WordUpserter) upsertWordCharacters(ctx context.Context, tx pgx.Tx, wordID int64, chars []domain.CharacterJSON) error {
+	if len(chars) == 0 {
+		return nil
+	}
+
+	const selectCharQ = `
+SELECT id
+FROM characters
+WHERE literal = $1 AND script_code = $2
+`
+
+	const insertCharQ = `
+INSERT INTO characters (literal, simplified, traditional, script_code, strokes, radical, level_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id
+`
+
+	const insertWordCharQ = `
+INSERT INTO word_characters (word_id, character_id, char_order)
+VALUES ($1, $2, $3)
+ON CONFLICT (word_id, char_order) DO UPDATE SET character_id = EXCLUDED.character_id
+`
+
+	const selectReadingQ = `
+SELECT id
+FROM character_readings
+WHERE character_id = $1 AND language_id = $2 AND reading = $3 AND COALESCE(reading_type, '') = COALESCE($4, '')
+`
+
+	const insertReadingQ = `
+INSERT INTO character_readings (character_id, language_id, reading, reading_type, note)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id
+`
+
+	const updateReadingQ = `
+UPDATE character_readings
+SET note = $2
+WHERE id = $1
+`
+
+	for _, c := range chars {
+		if c.Literal == "" {
+			continue
+		}
+
+		charKey := fmt.Sprintf("%s|%s", c.Literal, c.ScriptCode)
+		var charID int64
+		if cachedID, ok := u.characters[charKey]; ok {
+			charID = cachedID
+		} else {
+			err := tx.QueryRow(ctx, selectCharQ, c.Literal, c.ScriptCode).Scan(&charID)
+			if err != nil {
+				if !errors.Is(err, pgx.ErrNoRows) {
+					return fmt.Errorf("select character: %w", err)
+				}
+
+				// Convert level code to level_id
+				levelID, err := u.LevelID(ctx, tx, c.Level)
+				if err != nil {
+					return fmt.Errorf("get level_id for character %s: %w", c.Literal, err)
+				}
+
+				if err := tx.QueryRow(
+					ctx,
+					insertCharQ,
+					c.Literal,
+					c.Simplified,
+					c.Traditional,
+					c.ScriptCode,
+					c.Strokes,
+					c.Radical,
+					levelID,
+				).Scan(&charID); err != nil {
+					return fmt.Errorf("insert character: %w", err)
+				}
+			}
+			u.characters[charKey] = charID
+		}
+
+		// Link word to character with order
+		if _, err := tx.Exec(ctx, insertWordCharQ, wordID, charID, c.CharOrder); err != nil {
+			return fmt.Errorf("insert word_character: %w", err)
+		}
+
+		// Readings for this character
+		for _, r := range c.Readings {
+			langID, err := u.LanguageID(ctx, tx, r.Language)
+			if err != nil {
+				return err
+			}
+
+			var readingID int64
+			err = tx.QueryRow(ctx, selectReadingQ, charID, langID, r.Reading, r.ReadingType).Scan(&readingID)
+			if err != nil {
+				if !errors.Is(err, pgx.ErrNoRows) {
+					return fmt.Errorf("select character_reading: %w", err)
+				}
+				if err := tx.QueryRow(
+					ctx,
+					insertReadingQ,
+					charID,
+					langID,
+					r.Reading,
+					r.ReadingType,
+					r.Note,
+				).Scan(&readingID); err != nil {
+					return fmt.Errorf("insert character_reading: %w", err)
+				}
+			} else {
+				if _, err := tx.Exec(ctx, updateReadingQ, readingID, r.Note); err != nil {
+					return fmt.Errorf("update character_reading: %w", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// wordCacheKey is the cache key for words (for related/translation targets)
+func wordCacheKey(lang, lemma string) string {
+	return fmt.Sprintf("%s|%s", lang, lemma)
+}
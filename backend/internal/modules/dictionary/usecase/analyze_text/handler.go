@@ -0,0 +1,154 @@
+package analyze_text
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/constants"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/english-coach/backend/internal/shared/normalize"
+)
+
+// Handler annotates the vocabulary of a text with dictionary words and their levels
+type Handler struct {
+	analysisRepo domain.TextAnalysisRepository
+	languageRepo domain.LanguageRepository
+	scripts      domain.ScriptConverter // optional, lets traditional text reach simplified lemmas
+	logger       logger.ILogger
+}
+
+// NewHandler creates a new text analysis handler
+func NewHandler(
+	analysisRepo domain.TextAnalysisRepository,
+	languageRepo domain.LanguageRepository,
+	scripts domain.ScriptConverter,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		analysisRepo: analysisRepo,
+		languageRepo: languageRepo,
+		scripts:      scripts,
+		logger:       logger,
+	}
+}
+
+// Execute cuts the text into words of its language and looks them up. Han characters are matched
+// by longest dictionary entry, since Chinese is written without spaces; space-separated words are
+// matched as the longest phrase of up to maxPhraseWords words, which finds Vietnamese words of
+// several syllables. Known words are grouped by their easiest level, unknown tokens are listed apart.
+func (h *Handler) Execute(ctx context.Context, input AnalyzeTextInput) (*AnalyzeTextOutput, error) {
+	if strings.TrimSpace(input.Text) == "" {
+		return nil, sharederrors.ErrInvalidParameter.WithDetails("text is required")
+	}
+	if utf8.RuneCountInString(input.Text) > constants.MaxAnalyzeTextLength {
+		return nil, sharederrors.ErrInvalidParameter.WithDetails(
+			fmt.Sprintf("text must be at most %d characters", constants.MaxAnalyzeTextLength))
+	}
+	if _, err := h.languageRepo.FindLanguageByID(ctx, input.LanguageID); err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if input.TranslationLanguageID != nil {
+		if _, err := h.languageRepo.FindLanguageByID(ctx, *input.TranslationLanguageID); err != nil {
+			return nil, sharederrors.MapDomainErrorToAppError(err)
+		}
+	}
+
+	// Full-width letters are folded for matching; offsets must still point into the text as sent
+	folded := normalize.Width(input.Text)
+	if utf8.RuneCountInString(folded) != utf8.RuneCountInString(input.Text) {
+		folded = input.Text
+	}
+	text := newAnalyzedText(folded, h.scripts)
+	words, err := h.analysisRepo.FindAnalyzerWords(ctx, domain.AnalyzerWordQuery{
+		LanguageID:            input.LanguageID,
+		Forms:                 text.forms(),
+		TranslationLanguageID: input.TranslationLanguageID,
+	})
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	tokens := text.tokens(newWordIndex(words))
+	runes := []rune(input.Text)
+	for _, token := range tokens {
+		token.Text = string(runes[token.Start:token.End])
+	}
+
+	output := &AnalyzeTextOutput{
+		Tokens:  tokens,
+		Levels:  groupByLevel(tokens),
+		Unknown: []*UnknownToken{},
+	}
+	unknownByText := make(map[string]*UnknownToken)
+	for _, token := range tokens {
+		if token.Word != nil {
+			continue
+		}
+		key := normalize.Text(token.Text)
+		if unknown := unknownByText[key]; unknown != nil {
+			unknown.Count++
+			continue
+		}
+		unknown := &UnknownToken{Text: token.Text, Count: 1}
+		unknownByText[key] = unknown
+		output.Unknown = append(output.Unknown, unknown)
+	}
+	return output, nil
+}
+
+// groupByLevel collects the known words of the tokens by level, easiest level first and words
+// without a level last; words keep the order they first appear in
+func groupByLevel(tokens []*Token) []*LevelGroup {
+	groups := []*LevelGroup{}
+	groupByLevelID := make(map[int64]*LevelGroup)
+	occurrenceByWordID := make(map[int64]*WordOccurrence)
+	for _, token := range tokens {
+		if token.Word == nil {
+			continue
+		}
+		if occurrence := occurrenceByWordID[token.Word.WordID]; occurrence != nil {
+			occurrence.Count++
+			continue
+		}
+
+		var levelID int64 // 0 for words without a level
+		if token.Word.Level != nil {
+			levelID = token.Word.Level.ID
+		}
+		group := groupByLevelID[levelID]
+		if group == nil {
+			group = &LevelGroup{Level: token.Word.Level}
+			groupByLevelID[levelID] = group
+			groups = append(groups, group)
+		}
+		occurrence := &WordOccurrence{Word: token.Word, Count: 1}
+		occurrenceByWordID[token.Word.WordID] = occurrence
+		group.Words = append(group.Words, occurrence)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		a, b := groups[i].Level, groups[j].Level
+		switch {
+		case a == nil || b == nil:
+			return b == nil && a != nil
+		case levelOrder(a) != levelOrder(b):
+			return levelOrder(a) < levelOrder(b)
+		default:
+			return a.ID < b.ID
+		}
+	})
+	return groups
+}
+
+// levelOrder returns the difficulty order of a level, putting levels without one last
+func levelOrder(level *domain.Level) int {
+	if level.DifficultyOrder == nil {
+		return 1 << 16
+	}
+	return int(*level.DifficultyOrder)
+}
@@ -0,0 +1,8 @@
+package analyze_text
+
+// AnalyzeTextInput represents the input for annotating the vocabulary of a text.
+type AnalyzeTextInput struct {
+	Text                  string
+	LanguageID            int16  // language the text is written in
+	TranslationLanguageID *int16 // restricts the translation shown for each word to a language
+}
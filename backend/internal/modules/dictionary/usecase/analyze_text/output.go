@@ -0,0 +1,38 @@
+package analyze_text
+
+import "github.com/english-coach/backend/internal/modules/dictionary/domain"
+
+// AnalyzeTextOutput represents a text cut into tokens, with the known words grouped by level.
+type AnalyzeTextOutput struct {
+	Tokens  []*Token
+	Levels  []*LevelGroup
+	Unknown []*UnknownToken
+}
+
+// Token is a word of the text, located by character offsets (Unicode code points, end exclusive).
+// Word is nil for tokens not found in the dictionary.
+type Token struct {
+	Text  string
+	Start int
+	End   int
+	Word  *domain.AnalyzedWord
+}
+
+// LevelGroup holds the known words at one level, in order of first appearance.
+// Level is nil for the group of words whose senses have no level.
+type LevelGroup struct {
+	Level *domain.Level
+	Words []*WordOccurrence
+}
+
+// WordOccurrence is a known word with the number of tokens it was found in
+type WordOccurrence struct {
+	Word  *domain.AnalyzedWord
+	Count int
+}
+
+// UnknownToken is a token not found in the dictionary with the number of times it appears
+type UnknownToken struct {
+	Text  string
+	Count int
+}
@@ -0,0 +1,253 @@
+package analyze_text
+
+import (
+	"unicode"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/normalize"
+)
+
+const (
+	// maxPhraseWords is the longest entry matched across spaces: Vietnamese words of several
+	// syllables ("học sinh", "bệnh viện") and English phrases ("look up", "as well as")
+	maxPhraseWords = 4
+
+	// maxHanWordRunes is the longest Chinese entry tried at each position of a Han run
+	maxHanWordRunes = 8
+)
+
+// textUnit is a piece of the text a dictionary entry is made of: a run of Han characters, which
+// is cut into words by longest match, or a space-delimited word (a syllable for Vietnamese)
+type textUnit struct {
+	start int // rune offset
+	end   int // rune offset, exclusive
+	han   bool
+	// joined reports that only whitespace separates the unit from the previous word, so the two
+	// may belong to one entry
+	joined bool
+}
+
+// splitText cuts text into units. Punctuation ends a phrase, and numbers are left out since they
+// are neither known nor unknown vocabulary.
+func splitText(runes []rune) []textUnit {
+	var units []textUnit
+	joinable := false // the last unit was a word and only whitespace has followed it
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case isHan(r):
+			j := i + 1
+			for j < len(runes) && isHan(runes[j]) {
+				j++
+			}
+			units = append(units, textUnit{start: i, end: j, han: true})
+			joinable = false
+			i = j
+
+		case isWordRune(r):
+			j, number := wordEnd(runes, i)
+			if number {
+				joinable = false
+			} else {
+				units = append(units, textUnit{start: i, end: j, joined: joinable})
+				joinable = true
+			}
+			i = j
+
+		default:
+			joinable = joinable && unicode.IsSpace(r)
+			i++
+		}
+	}
+	return units
+}
+
+// wordEnd returns where the word starting at i ends and whether it is made of digits only
+func wordEnd(runes []rune, i int) (int, bool) {
+	number := true
+	for ; i < len(runes); i++ {
+		switch {
+		case isWordRune(runes[i]):
+			number = number && unicode.IsDigit(runes[i])
+		case isWordJoiner(runes[i]) && i+1 < len(runes) && isWordRune(runes[i+1]):
+			number = false
+		default:
+			return i, number
+		}
+	}
+	return i, number
+}
+
+// isHan reports whether r is a Chinese character
+func isHan(r rune) bool {
+	return unicode.Is(unicode.Han, r)
+}
+
+// isWordRune reports whether r belongs to a space-delimited word
+func isWordRune(r rune) bool {
+	return (unicode.IsLetter(r) && !isHan(r)) || unicode.IsMark(r) || unicode.IsDigit(r)
+}
+
+// isWordJoiner reports whether r may join two parts of one word ("don't", "well-known")
+func isWordJoiner(r rune) bool {
+	return r == '\'' || r == '’' || r == '-'
+}
+
+// phraseLen returns how many units from i on may form one entry
+func phraseLen(units []textUnit, i int) int {
+	n := 1
+	for n < maxPhraseWords && i+n < len(units) && units[i+n].joined {
+		n++
+	}
+	return n
+}
+
+// analyzedText is a text with its units and the simplified form of each Han run
+type analyzedText struct {
+	runes      []rune
+	units      []textUnit
+	simplified map[int][]rune // Han run start → the run in simplified script
+}
+
+// newAnalyzedText splits text into units. scripts may be nil, in which case Han runs are matched as written.
+func newAnalyzedText(text string, scripts domain.ScriptConverter) *analyzedText {
+	t := &analyzedText{runes: []rune(text), simplified: make(map[int][]rune)}
+	t.units = splitText(t.runes)
+	for _, u := range t.units {
+		if !u.han {
+			continue
+		}
+		run := t.runes[u.start:u.end]
+		if scripts != nil {
+			// lemma_normalized is simplified; conversion is per character, so offsets still line up
+			if simplified := []rune(scripts.ToSimplified(string(run))); len(simplified) == len(run) {
+				run = simplified
+			}
+		}
+		t.simplified[u.start] = run
+	}
+	return t
+}
+
+// forms returns every lemma_normalized value an entry of the text may have: each Han substring
+// of up to maxHanWordRunes characters and each folded phrase of up to maxPhraseWords words
+func (t *analyzedText) forms() []string {
+	seen := make(map[string]bool)
+	var forms []string
+	add := func(form string) {
+		if form != "" && !seen[form] {
+			seen[form] = true
+			forms = append(forms, form)
+		}
+	}
+
+	for i, u := range t.units {
+		if u.han {
+			run := t.simplified[u.start]
+			for p := range run {
+				for l := 1; l <= maxHanWordRunes && p+l <= len(run); l++ {
+					add(string(run[p : p+l]))
+				}
+			}
+			continue
+		}
+		for n := 1; n <= phraseLen(t.units, i); n++ {
+			add(normalize.Fold(string(t.runes[u.start:t.units[i+n-1].end])))
+		}
+	}
+	return forms
+}
+
+// wordIndex finds the dictionary words for pieces of the text
+type wordIndex struct {
+	exact  map[string]*domain.AnalyzedWord // normalize.Text of the lemma, diacritics kept
+	folded map[string]*domain.AnalyzedWord // lemma_normalized
+}
+
+// newWordIndex indexes words given most frequent first, so the first word wins a shared key
+func newWordIndex(words []*domain.AnalyzedWord) *wordIndex {
+	idx := &wordIndex{
+		exact:  make(map[string]*domain.AnalyzedWord, len(words)),
+		folded: make(map[string]*domain.AnalyzedWord, len(words)),
+	}
+	for _, w := range words {
+		if key := normalize.Text(w.Lemma); idx.exact[key] == nil {
+			idx.exact[key] = w
+		}
+		if idx.folded[w.LemmaNormalized] == nil {
+			idx.folded[w.LemmaNormalized] = w
+		}
+	}
+	return idx
+}
+
+// lookupPhrase returns the word written as phrase. Written diacritics must match, so "bàn" does
+// not find "bán"; a phrase typed without any may match a word that has them ("hoc sinh").
+func (idx *wordIndex) lookupPhrase(phrase string) *domain.AnalyzedWord {
+	text := normalize.Text(phrase)
+	if w := idx.exact[text]; w != nil {
+		return w
+	}
+	if folded := normalize.Fold(text); folded == text {
+		return idx.folded[folded]
+	}
+	return nil
+}
+
+// tokens cuts the text into dictionary words by longest match: the longest run of Han characters
+// or of space-separated words that is a known entry wins. Unknown Han characters next to each
+// other form one unknown token; an unknown word is a token on its own.
+func (t *analyzedText) tokens(idx *wordIndex) []*Token {
+	var tokens []*Token
+	token := func(start, end int, word *domain.AnalyzedWord) {
+		tokens = append(tokens, &Token{Text: string(t.runes[start:end]), Start: start, End: end, Word: word})
+	}
+
+	for i := 0; i < len(t.units); {
+		u := t.units[i]
+		if u.han {
+			run := t.simplified[u.start]
+			unknown := -1
+			for p := 0; p < len(run); {
+				l := min(maxHanWordRunes, len(run)-p)
+				for ; l > 0; l-- {
+					if w := idx.folded[string(run[p:p+l])]; w != nil {
+						if unknown >= 0 {
+							token(u.start+unknown, u.start+p, nil)
+							unknown = -1
+						}
+						token(u.start+p, u.start+p+l, w)
+						break
+					}
+				}
+				if l == 0 {
+					if unknown < 0 {
+						unknown = p
+					}
+					l = 1
+				}
+				p += l
+			}
+			if unknown >= 0 {
+				token(u.start+unknown, u.end, nil)
+			}
+			i++
+			continue
+		}
+
+		n := phraseLen(t.units, i)
+		for ; n > 0; n-- {
+			end := t.units[i+n-1].end
+			if w := idx.lookupPhrase(string(t.runes[u.start:end])); w != nil {
+				token(u.start, end, w)
+				break
+			}
+		}
+		if n == 0 {
+			token(u.start, u.end, nil)
+			n = 1
+		}
+		i += n
+	}
+	return tokens
+}
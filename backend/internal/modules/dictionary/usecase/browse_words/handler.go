@@ -0,0 +1,72 @@
+package browse_words
+
+import (
+	"context"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler lists words by topic, level, language and part of speech
+type Handler struct {
+	wordRepo  domain.WordRepository
+	topicRepo domain.TopicRepository
+	levelRepo domain.LevelRepository
+	logger    logger.ILogger
+}
+
+// NewHandler creates a new word listing handler
+func NewHandler(
+	wordRepo domain.WordRepository,
+	topicRepo domain.TopicRepository,
+	levelRepo domain.LevelRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		wordRepo:  wordRepo,
+		topicRepo: topicRepo,
+		levelRepo: levelRepo,
+		logger:    logger,
+	}
+}
+
+// Execute returns one page of words matching the filters, each with a gloss from its first sense.
+// Unknown topics and levels are reported as not found rather than as an empty list.
+func (h *Handler) Execute(ctx context.Context, input BrowseWordsInput) (*BrowseWordsOutput, error) {
+	sort := input.Sort
+	switch sort {
+	case "":
+		sort = domain.WordSortFrequency
+	case domain.WordSortFrequency, domain.WordSortAlpha:
+	default:
+		return nil, sharederrors.ErrInvalidParameter.WithDetails("sort must be frequency or alpha")
+	}
+
+	if input.TopicID != nil {
+		if _, err := h.topicRepo.FindTopicByID(ctx, *input.TopicID); err != nil {
+			return nil, sharederrors.MapDomainErrorToAppError(err)
+		}
+	}
+	if input.LevelID != nil {
+		if _, err := h.levelRepo.FindLevelByID(ctx, *input.LevelID); err != nil {
+			return nil, sharederrors.MapDomainErrorToAppError(err)
+		}
+	}
+
+	items, total, err := h.wordRepo.BrowseWords(ctx, domain.WordBrowseQuery{
+		LanguageID:            input.LanguageID,
+		TopicID:               input.TopicID,
+		LevelID:               input.LevelID,
+		PartOfSpeech:          input.PartOfSpeech,
+		TranslationLanguageID: input.TranslationLanguageID,
+		Sort:                  sort,
+		Limit:                 input.Limit,
+		Offset:                input.Offset,
+	})
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	return &BrowseWordsOutput{Items: items, Total: total}, nil
+}
@@ -0,0 +1,13 @@
+package browse_words
+
+// BrowseWordsInput represents the input for the word listing use case. Nil filters are not applied.
+type BrowseWordsInput struct {
+	LanguageID            *int16
+	TopicID               *int64
+	LevelID               *int64
+	PartOfSpeech          *string // part of speech code (noun, verb, ...)
+	TranslationLanguageID *int16  // language of the gloss translation
+	Sort                  string  // frequency (default) or alpha
+	Limit                 int
+	Offset                int
+}
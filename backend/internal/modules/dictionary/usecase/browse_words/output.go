@@ -0,0 +1,9 @@
+package browse_words
+
+import "github.com/english-coach/backend/internal/modules/dictionary/domain"
+
+// BrowseWordsOutput represents one page of a word listing.
+type BrowseWordsOutput struct {
+	Items []*domain.WordBrowseItem
+	Total int
+}
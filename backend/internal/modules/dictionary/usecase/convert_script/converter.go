@@ -0,0 +1,176 @@
+package convert_script
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/english-coach/backend/internal/shared/normalize"
+)
+
+// defaultRefreshInterval is used when the configured refresh interval is not positive
+const defaultRefreshInterval = 5 * time.Minute
+
+// initialLoadTimeout bounds the synchronous load done by Start
+const initialLoadTimeout = 10 * time.Second
+
+// ConverterConfig holds script converter configuration
+type ConverterConfig struct {
+	RefreshInterval time.Duration // How often the conversion table is checked for changes
+}
+
+// Converter converts Chinese text between simplified and traditional script with the pairs
+// stored in script_conversions. The table is loaded at startup and reloaded whenever it changes;
+// until the first load succeeds, text is returned unchanged.
+type Converter struct {
+	repo   domain.ScriptConversionRepository
+	cfg    ConverterConfig
+	logger logger.ILogger
+
+	table     atomic.Pointer[normalize.ScriptTable]
+	version   domain.ScriptConversionsVersion
+	loaded    bool
+	loadMu    sync.Mutex
+	listeners []func()
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewConverter creates a new, empty script converter
+func NewConverter(repo domain.ScriptConversionRepository, cfg ConverterConfig, logger logger.ILogger) *Converter {
+	return &Converter{
+		repo:   repo,
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// OnReload registers fn to be called after the table is reloaded, e.g. to rebuild indexes keyed
+// on simplified text. It must be called before Start.
+func (c *Converter) OnReload(fn func()) {
+	c.listeners = append(c.listeners, fn)
+}
+
+// Start loads the table and keeps it fresh until Stop is called
+func (c *Converter) Start() {
+	if c == nil || c.cancel != nil {
+		return
+	}
+
+	loadCtx, cancelLoad := context.WithTimeout(context.Background(), initialLoadTimeout)
+	if err := c.Reload(loadCtx); err != nil {
+		c.logger.Warn("script conversion table load failed", logger.Error(err))
+	}
+	cancelLoad()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.done = make(chan struct{})
+
+	go func() {
+		defer close(c.done)
+
+		interval := c.cfg.RefreshInterval
+		if interval <= 0 {
+			interval = defaultRefreshInterval
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := c.refresh(ctx); err != nil && ctx.Err() == nil {
+				c.logger.Warn("script conversion table refresh failed", logger.Error(err))
+			}
+		}
+	}()
+}
+
+// Stop stops the background refresher and waits for it to exit
+func (c *Converter) Stop() {
+	if c == nil || c.cancel == nil {
+		return
+	}
+	c.cancel()
+	<-c.done
+	c.cancel = nil
+}
+
+// Reload loads the table unconditionally, e.g. right after pairs were imported
+func (c *Converter) Reload(ctx context.Context) error {
+	c.loadMu.Lock()
+	defer c.loadMu.Unlock()
+
+	version, err := c.repo.GetScriptConversionsVersion(ctx)
+	if err != nil {
+		return err
+	}
+	return c.load(ctx, *version)
+}
+
+// refresh reloads the table if it changed since the last load
+func (c *Converter) refresh(ctx context.Context) error {
+	c.loadMu.Lock()
+	defer c.loadMu.Unlock()
+
+	version, err := c.repo.GetScriptConversionsVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if c.loaded && c.version == *version {
+		return nil
+	}
+	return c.load(ctx, *version)
+}
+
+// load reads every pair and swaps in a new table. Callers hold loadMu.
+func (c *Converter) load(ctx context.Context, version domain.ScriptConversionsVersion) error {
+	conversions, err := c.repo.FindAllScriptConversions(ctx)
+	if err != nil {
+		return err
+	}
+
+	pairs := make([]normalize.ScriptPair, 0, len(conversions))
+	for _, conv := range conversions {
+		pairs = append(pairs, normalize.ScriptPair{Simplified: conv.Simplified, Traditional: conv.Traditional})
+	}
+	table := normalize.NewScriptTable(pairs)
+
+	c.table.Store(table)
+	c.version = version
+	c.loaded = true
+
+	c.logger.Info("script conversion table loaded",
+		logger.Int("pairs", len(conversions)),
+		logger.Int("characters", table.Len()),
+	)
+
+	for _, fn := range c.listeners {
+		fn()
+	}
+	return nil
+}
+
+// ToSimplified replaces traditional characters with their simplified forms
+func (c *Converter) ToSimplified(s string) string {
+	if c == nil {
+		return s
+	}
+	return c.table.Load().ToSimplified(s)
+}
+
+// ToTraditional replaces simplified characters with their traditional forms
+func (c *Converter) ToTraditional(s string) string {
+	if c == nil {
+		return s
+	}
+	return c.table.Load().ToTraditional(s)
+}
@@ -0,0 +1,266 @@
+package correction_proposals
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	dictedit "github.com/english-coach/backend/internal/modules/dictionary/usecase/edit_dictionary"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// detailCache evicts cached word details, which carry the pending proposal count
+type detailCache interface {
+	Invalidate(wordIDs ...int64)
+}
+
+// editor applies approved proposals. It is implemented by the edit_dictionary handler, so approved
+// changes are validated, recorded as revisions and evicted from the caches like any other edit.
+type editor interface {
+	CreateSense(ctx context.Context, editorID int64, input dictedit.CreateSenseInput) (*dictedit.EditOutput, error)
+	UpdateSense(ctx context.Context, editorID int64, input dictedit.UpdateSenseInput) (*dictedit.EditOutput, error)
+	DeleteSense(ctx context.Context, editorID, senseID int64) error
+	UpsertSenseTranslation(ctx context.Context, editorID int64, input dictedit.UpsertSenseTranslationInput) (*dictedit.EditOutput, error)
+	DeleteSenseTranslation(ctx context.Context, editorID int64, input dictedit.DeleteSenseTranslationInput) error
+	CreateExample(ctx context.Context, editorID int64, input dictedit.CreateExampleInput) (*dictedit.EditOutput, error)
+	UpdateExample(ctx context.Context, editorID int64, input dictedit.UpdateExampleInput) (*dictedit.EditOutput, error)
+	DeleteExample(ctx context.Context, editorID, exampleID int64) error
+}
+
+// proposalStatuses are the statuses the moderation queue can be filtered by
+var proposalStatuses = []string{
+	domain.ProposalStatusPending,
+	domain.ProposalStatusApproved,
+	domain.ProposalStatusRejected,
+	domain.ProposalStatusMerged,
+}
+
+// Handler takes correction proposals from users and runs the moderation queue. Approving a
+// proposal applies it through the editor under the moderator's ID.
+type Handler struct {
+	proposalRepo domain.ProposalRepository
+	editor       editor
+	cache        detailCache
+	logger       logger.ILogger
+}
+
+// NewHandler creates a new correction proposal handler
+func NewHandler(
+	proposalRepo domain.ProposalRepository,
+	editor editor,
+	cache detailCache,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		proposalRepo: proposalRepo,
+		editor:       editor,
+		cache:        cache,
+		logger:       logger,
+	}
+}
+
+// Submit stores a user's proposal in the moderation queue and returns it
+func (h *Handler) Submit(ctx context.Context, userID int64, input SubmitProposalInput) (*domain.Proposal, error) {
+	var comment *string
+	if input.Comment != nil {
+		if trimmed := strings.TrimSpace(*input.Comment); trimmed != "" {
+			comment = &trimmed
+		}
+	}
+	proposal := &domain.Proposal{
+		WordID:       input.WordID,
+		Kind:         input.Kind,
+		SenseID:      input.SenseID,
+		ExampleID:    input.ExampleID,
+		TargetWordID: input.TargetWordID,
+		Payload:      input.Payload,
+		Comment:      comment,
+		UserID:       userID,
+	}
+	if err := normalize(proposal); err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	id, err := h.proposalRepo.CreateProposal(ctx, proposal)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	h.invalidate(input.WordID)
+
+	h.logger.Info("correction proposal submitted",
+		logger.Int64("proposal_id", id),
+		logger.Int64("word_id", input.WordID),
+		logger.String("kind", input.Kind),
+		logger.Int64("user_id", userID),
+	)
+
+	created, err := h.proposalRepo.FindProposalByID(ctx, id)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	return created, nil
+}
+
+// ListProposals returns one page of the moderation queue, oldest first
+func (h *Handler) ListProposals(ctx context.Context, input ListProposalsInput) (*ListProposalsOutput, error) {
+	status := input.Status
+	if status == "" {
+		status = domain.ProposalStatusPending
+	}
+	if !slices.Contains(proposalStatuses, status) {
+		return nil, sharederrors.ErrInvalidParameter.WithDetails("status must be one of: " + strings.Join(proposalStatuses, ", "))
+	}
+
+	items, total, err := h.proposalRepo.FindProposals(ctx, domain.ProposalQuery{
+		Status: &status,
+		WordID: input.WordID,
+		Limit:  input.Limit,
+		Offset: input.Offset,
+	})
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	return &ListProposalsOutput{Items: items, Total: total}, nil
+}
+
+// Approve applies a pending proposal as an edit by the moderator. The proposal is claimed first so
+// two moderators cannot apply it twice; when applying fails it goes back to the queue.
+func (h *Handler) Approve(ctx context.Context, moderatorID, proposalID int64) (*domain.Proposal, error) {
+	proposal, err := h.proposalRepo.FindProposalByID(ctx, proposalID)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if err := h.proposalRepo.ReviewProposal(ctx, proposalID, moderatorID, domain.ProposalStatusApproved, nil); err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	if err := h.apply(ctx, moderatorID, proposal); err != nil {
+		if reopenErr := h.proposalRepo.ReopenProposal(ctx, proposalID); reopenErr != nil {
+			h.logger.Error("failed to reopen correction proposal",
+				logger.Int64("proposal_id", proposalID),
+				logger.Error(reopenErr),
+			)
+		}
+		return nil, err
+	}
+	// The editor evicts the word on success; evict again in case nothing changed
+	h.invalidate(proposal.WordID)
+
+	h.logger.Info("correction proposal approved",
+		logger.Int64("proposal_id", proposalID),
+		logger.Int64("word_id", proposal.WordID),
+		logger.Int64("moderator_id", moderatorID),
+	)
+	return h.reload(ctx, proposalID)
+}
+
+// Reject settles a pending proposal as rejected with the moderator's reason
+func (h *Handler) Reject(ctx context.Context, moderatorID int64, input RejectProposalInput) (*domain.Proposal, error) {
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" {
+		return nil, sharederrors.ErrInvalidParameter.WithDetails("reason is required")
+	}
+
+	proposal, err := h.proposalRepo.FindProposalByID(ctx, input.ProposalID)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if err := h.proposalRepo.ReviewProposal(ctx, input.ProposalID, moderatorID, domain.ProposalStatusRejected, &reason); err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	h.invalidate(proposal.WordID)
+
+	h.logger.Info("correction proposal rejected",
+		logger.Int64("proposal_id", input.ProposalID),
+		logger.Int64("moderator_id", moderatorID),
+	)
+	return h.reload(ctx, input.ProposalID)
+}
+
+// Merge marks a pending proposal as a duplicate of another pending proposal for the same word and
+// returns the proposal it was merged into
+func (h *Handler) Merge(ctx context.Context, moderatorID int64, input MergeProposalInput) (*domain.Proposal, error) {
+	if input.IntoID <= 0 {
+		return nil, sharederrors.ErrInvalidParameter.WithDetails("into_id is required")
+	}
+
+	proposal, err := h.proposalRepo.FindProposalByID(ctx, input.ProposalID)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if err := h.proposalRepo.MergeProposal(ctx, input.ProposalID, input.IntoID, moderatorID); err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	h.invalidate(proposal.WordID)
+
+	h.logger.Info("correction proposal merged",
+		logger.Int64("proposal_id", input.ProposalID),
+		logger.Int64("into_id", input.IntoID),
+		logger.Int64("moderator_id", moderatorID),
+	)
+	return h.reload(ctx, input.IntoID)
+}
+
+// apply performs the change a proposal describes. Payloads were validated on submission.
+func (h *Handler) apply(ctx context.Context, moderatorID int64, p *domain.Proposal) error {
+	var err error
+	switch p.Kind {
+	case domain.ProposalKindSenseCreate:
+		var sense domain.SenseJSON
+		if err = json.Unmarshal(p.Payload, &sense); err == nil {
+			_, err = h.editor.CreateSense(ctx, moderatorID, dictedit.CreateSenseInput{WordID: p.WordID, Sense: sense})
+		}
+	case domain.ProposalKindSenseUpdate:
+		var sense domain.SenseJSON
+		if err = json.Unmarshal(p.Payload, &sense); err == nil {
+			_, err = h.editor.UpdateSense(ctx, moderatorID, dictedit.UpdateSenseInput{SenseID: *p.SenseID, Sense: senseEdit(&sense)})
+		}
+	case domain.ProposalKindSenseDelete:
+		err = h.editor.DeleteSense(ctx, moderatorID, *p.SenseID)
+	case domain.ProposalKindTranslationUpsert:
+		var translation domain.SenseTranslationJSON
+		if err = json.Unmarshal(p.Payload, &translation); err == nil {
+			_, err = h.editor.UpsertSenseTranslation(ctx, moderatorID, dictedit.UpsertSenseTranslationInput{SenseID: *p.SenseID, Translation: translation})
+		}
+	case domain.ProposalKindTranslationDelete:
+		err = h.editor.DeleteSenseTranslation(ctx, moderatorID, dictedit.DeleteSenseTranslationInput{SenseID: *p.SenseID, TargetWordID: *p.TargetWordID})
+	case domain.ProposalKindExampleCreate:
+		var example domain.ExampleJSON
+		if err = json.Unmarshal(p.Payload, &example); err == nil {
+			_, err = h.editor.CreateExample(ctx, moderatorID, dictedit.CreateExampleInput{SenseID: *p.SenseID, Example: example})
+		}
+	case domain.ProposalKindExampleUpdate:
+		var example domain.ExampleJSON
+		if err = json.Unmarshal(p.Payload, &example); err == nil {
+			_, err = h.editor.UpdateExample(ctx, moderatorID, dictedit.UpdateExampleInput{ExampleID: *p.ExampleID, Example: example})
+		}
+	case domain.ProposalKindExampleDelete:
+		err = h.editor.DeleteExample(ctx, moderatorID, *p.ExampleID)
+	default:
+		return sharederrors.ErrInvalidParameter.WithDetails("unknown proposal kind " + p.Kind)
+	}
+
+	if err != nil {
+		return sharederrors.MapDomainErrorToAppError(err)
+	}
+	return nil
+}
+
+// reload returns the current state of a proposal
+func (h *Handler) reload(ctx context.Context, proposalID int64) (*domain.Proposal, error) {
+	proposal, err := h.proposalRepo.FindProposalByID(ctx, proposalID)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	return proposal, nil
+}
+
+// invalidate evicts the detail of a word so its pending proposal count is reloaded
+func (h *Handler) invalidate(wordID int64) {
+	if h.cache != nil {
+		h.cache.Invalidate(wordID)
+	}
+}
@@ -0,0 +1,35 @@
+package correction_proposals
+
+import "encoding/json"
+
+// SubmitProposalInput represents a change to a word suggested by a user
+type SubmitProposalInput struct {
+	WordID       int64
+	Kind         string
+	SenseID      *int64
+	ExampleID    *int64
+	TargetWordID *int64
+	Payload      json.RawMessage
+	Comment      *string
+}
+
+// ListProposalsInput represents the input for listing the moderation queue. An empty status lists
+// pending proposals.
+type ListProposalsInput struct {
+	Status string
+	WordID *int64
+	Limit  int
+	Offset int
+}
+
+// RejectProposalInput represents the input for rejecting a proposal
+type RejectProposalInput struct {
+	ProposalID int64
+	Reason     string
+}
+
+// MergeProposalInput represents the input for merging a duplicate proposal into another
+type MergeProposalInput struct {
+	ProposalID int64
+	IntoID     int64
+}
@@ -0,0 +1,9 @@
+package correction_proposals
+
+import "github.com/english-coach/backend/internal/modules/dictionary/domain"
+
+// ListProposalsOutput represents one page of the moderation queue
+type ListProposalsOutput struct {
+	Items []*domain.Proposal
+	Total int
+}
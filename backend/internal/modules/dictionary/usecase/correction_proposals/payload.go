@@ -0,0 +1,146 @@
+package correction_proposals
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	dictedit "github.com/english-coach/backend/internal/modules/dictionary/usecase/edit_dictionary"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// invalid builds an invalid parameter error with a formatted detail
+func invalid(format string, args ...any) error {
+	return sharederrors.ErrInvalidParameter.WithDetails(fmt.Sprintf(format, args...))
+}
+
+// normalize checks that a proposal names the entry its kind targets and carries a valid payload,
+// then clears the IDs the kind does not use and re-encodes the payload so stored proposals are
+// uniform. Payloads are validated with the editor's rules so approving cannot fail on them.
+func normalize(p *domain.Proposal) error {
+	var payload any
+	switch p.Kind {
+	case domain.ProposalKindSenseCreate:
+		p.SenseID, p.ExampleID, p.TargetWordID = nil, nil, nil
+		var sense domain.SenseJSON
+		if err := decodePayload(p.Payload, &sense); err != nil {
+			return err
+		}
+		if err := dictedit.ValidateSense(&sense); err != nil {
+			return err
+		}
+		payload = sense
+	case domain.ProposalKindSenseUpdate:
+		if p.SenseID == nil {
+			return invalid("sense_id is required for %s", p.Kind)
+		}
+		p.ExampleID, p.TargetWordID = nil, nil
+		var sense domain.SenseJSON
+		if err := decodePayload(p.Payload, &sense); err != nil {
+			return err
+		}
+		if len(sense.Translations) > 0 || len(sense.Examples) > 0 {
+			return invalid("sense updates cannot change translations or examples; propose those separately")
+		}
+		edit := senseEdit(&sense)
+		if err := dictedit.ValidateSenseEdit(&edit); err != nil {
+			return err
+		}
+		payload = sense
+	case domain.ProposalKindSenseDelete:
+		if p.SenseID == nil {
+			return invalid("sense_id is required for %s", p.Kind)
+		}
+		p.ExampleID, p.TargetWordID = nil, nil
+	case domain.ProposalKindTranslationUpsert:
+		if p.SenseID == nil {
+			return invalid("sense_id is required for %s", p.Kind)
+		}
+		p.ExampleID, p.TargetWordID = nil, nil
+		var translation domain.SenseTranslationJSON
+		if err := decodePayload(p.Payload, &translation); err != nil {
+			return err
+		}
+		if err := dictedit.ValidateSenseTranslation(&translation); err != nil {
+			return err
+		}
+		payload = translation
+	case domain.ProposalKindTranslationDelete:
+		if p.SenseID == nil || p.TargetWordID == nil {
+			return invalid("sense_id and target_word_id are required for %s", p.Kind)
+		}
+		p.ExampleID = nil
+	case domain.ProposalKindExampleCreate:
+		if p.SenseID == nil {
+			return invalid("sense_id is required for %s", p.Kind)
+		}
+		p.ExampleID, p.TargetWordID = nil, nil
+		var example domain.ExampleJSON
+		if err := decodePayload(p.Payload, &example); err != nil {
+			return err
+		}
+		if err := dictedit.ValidateExample(&example); err != nil {
+			return err
+		}
+		payload = example
+	case domain.ProposalKindExampleUpdate, domain.ProposalKindExampleDelete:
+		if p.ExampleID == nil {
+			return invalid("example_id is required for %s", p.Kind)
+		}
+		p.SenseID, p.TargetWordID = nil, nil
+		if p.Kind == domain.ProposalKindExampleDelete {
+			break
+		}
+		var example domain.ExampleJSON
+		if err := decodePayload(p.Payload, &example); err != nil {
+			return err
+		}
+		if err := dictedit.ValidateExample(&example); err != nil {
+			return err
+		}
+		payload = example
+	default:
+		return invalid("unknown proposal kind %q", p.Kind)
+	}
+
+	if payload == nil {
+		if len(bytes.TrimSpace(p.Payload)) > 0 && !bytes.Equal(bytes.TrimSpace(p.Payload), []byte("null")) {
+			return invalid("%s proposals take no payload", p.Kind)
+		}
+		p.Payload = nil
+		return nil
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("encode proposal payload: %w", err)
+	}
+	p.Payload = data
+	return nil
+}
+
+// decodePayload strictly decodes a proposal payload into v
+func decodePayload(data json.RawMessage, v any) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return invalid("payload is required")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return invalid("invalid payload: %v", err)
+	}
+	return nil
+}
+
+// senseEdit takes the editable fields of a proposed sense
+func senseEdit(sense *domain.SenseJSON) domain.SenseEdit {
+	return domain.SenseEdit{
+		Order:              sense.Order,
+		PartOfSpeech:       sense.PartOfSpeech,
+		DefinitionLanguage: sense.DefinitionLanguage,
+		Definition:         sense.Definition,
+		UsageLabel:         sense.UsageLabel,
+		Level:              sense.Level,
+		Note:               sense.Note,
+	}
+}
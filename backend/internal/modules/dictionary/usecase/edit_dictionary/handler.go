@@ -0,0 +1,321 @@
+package edit_dictionary
+
+import (
+	"context"
+	"strings"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// detailCache evicts cached word details
+type detailCache interface {
+	Invalidate(wordIDs ...int64)
+}
+
+// suggestIndex rebuilds the in-memory suggest index
+type suggestIndex interface {
+	Invalidate()
+}
+
+// Handler creates, updates and deletes dictionary entries on behalf of editors. Writes go through
+// the same upsert logic as the seeder and record a revision of every changed word under editorID;
+// afterwards the affected word details are evicted from the cache and the suggest index is rebuilt
+// when lemmas may have changed.
+type Handler struct {
+	editorRepo domain.EditorRepository
+	cache      detailCache
+	suggest    suggestIndex
+	logger     logger.ILogger
+}
+
+// NewHandler creates a new dictionary editing handler
+func NewHandler(
+	editorRepo domain.EditorRepository,
+	cache detailCache,
+	suggest suggestIndex,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		editorRepo: editorRepo,
+		cache:      cache,
+		suggest:    suggest,
+		logger:     logger,
+	}
+}
+
+// CreateWord creates a word from a WordJSON document. Translation and relation targets are created
+// when missing.
+func (h *Handler) CreateWord(ctx context.Context, editorID int64, word domain.WordJSON) (*EditOutput, error) {
+	word.Language = strings.TrimSpace(word.Language)
+	word.Lemma = strings.TrimSpace(word.Lemma)
+	if err := validateWord(&word); err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	wordID, err := h.editorRepo.CreateWord(ctx, editorID, &word)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(true, wordID)
+	h.logger.Info("dictionary word created",
+		logger.Int64("word_id", wordID),
+		logger.String("language", word.Language),
+		logger.String("lemma", word.Lemma),
+	)
+	return &EditOutput{ID: wordID, WordID: wordID}, nil
+}
+
+// UpdateWord updates the fields of a word
+func (h *Handler) UpdateWord(ctx context.Context, editorID int64, input UpdateWordInput) (*EditOutput, error) {
+	input.Word.Lemma = strings.TrimSpace(input.Word.Lemma)
+	if err := validateWordEdit(&input.Word); err != nil {
+		return nil, err
+	}
+
+	if err := h.editorRepo.UpdateWord(ctx, editorID, input.WordID, &input.Word); err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(true, input.WordID)
+	h.logger.Info("dictionary word updated", logger.Int64("word_id", input.WordID))
+	return &EditOutput{ID: input.WordID, WordID: input.WordID}, nil
+}
+
+// DeleteWord deletes a word with its senses, pronunciations, relations and the translations
+// pointing to it. Words referenced by learning history cannot be deleted.
+func (h *Handler) DeleteWord(ctx context.Context, editorID, wordID int64) error {
+	if err := h.editorRepo.DeleteWord(ctx, editorID, wordID); err != nil {
+		return sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(true, wordID)
+	h.logger.Info("dictionary word deleted", logger.Int64("word_id", wordID))
+	return nil
+}
+
+// CreateSense adds a sense with its translations and examples to a word
+func (h *Handler) CreateSense(ctx context.Context, editorID int64, input CreateSenseInput) (*EditOutput, error) {
+	if err := ValidateSense(&input.Sense); err != nil {
+		return nil, err
+	}
+
+	senseID, err := h.editorRepo.CreateSense(ctx, editorID, input.WordID, &input.Sense)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(len(input.Sense.Translations) > 0, input.WordID)
+	return &EditOutput{ID: senseID, WordID: input.WordID}, nil
+}
+
+// UpdateSense updates the fields of a sense
+func (h *Handler) UpdateSense(ctx context.Context, editorID int64, input UpdateSenseInput) (*EditOutput, error) {
+	if err := ValidateSenseEdit(&input.Sense); err != nil {
+		return nil, err
+	}
+
+	wordID, err := h.editorRepo.UpdateSense(ctx, editorID, input.SenseID, &input.Sense)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(false, wordID)
+	return &EditOutput{ID: input.SenseID, WordID: wordID}, nil
+}
+
+// DeleteSense deletes a sense with its translations and examples
+func (h *Handler) DeleteSense(ctx context.Context, editorID, senseID int64) error {
+	wordID, err := h.editorRepo.DeleteSense(ctx, editorID, senseID)
+	if err != nil {
+		return sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(false, wordID)
+	return nil
+}
+
+// UpsertSenseTranslation links a translation to a sense, creating the target word when missing
+func (h *Handler) UpsertSenseTranslation(ctx context.Context, editorID int64, input UpsertSenseTranslationInput) (*EditOutput, error) {
+	if err := ValidateSenseTranslation(&input.Translation); err != nil {
+		return nil, err
+	}
+
+	wordID, targetWordID, err := h.editorRepo.UpsertSenseTranslation(ctx, editorID, input.SenseID, &input.Translation)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(true, wordID)
+	return &EditOutput{ID: targetWordID, WordID: wordID}, nil
+}
+
+// DeleteSenseTranslation unlinks a translation from a sense. The target word is kept.
+func (h *Handler) DeleteSenseTranslation(ctx context.Context, editorID int64, input DeleteSenseTranslationInput) error {
+	wordID, err := h.editorRepo.DeleteSenseTranslation(ctx, editorID, input.SenseID, input.TargetWordID)
+	if err != nil {
+		return sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(false, wordID)
+	return nil
+}
+
+// CreateExample adds an example with its translations to a sense
+func (h *Handler) CreateExample(ctx context.Context, editorID int64, input CreateExampleInput) (*EditOutput, error) {
+	if err := ValidateExample(&input.Example); err != nil {
+		return nil, err
+	}
+
+	wordID, exampleID, err := h.editorRepo.CreateExample(ctx, editorID, input.SenseID, &input.Example)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(false, wordID)
+	return &EditOutput{ID: exampleID, WordID: wordID}, nil
+}
+
+// UpdateExample replaces the content and translations of an example
+func (h *Handler) UpdateExample(ctx context.Context, editorID int64, input UpdateExampleInput) (*EditOutput, error) {
+	if err := ValidateExample(&input.Example); err != nil {
+		return nil, err
+	}
+
+	wordID, err := h.editorRepo.UpdateExample(ctx, editorID, input.ExampleID, &input.Example)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(false, wordID)
+	return &EditOutput{ID: input.ExampleID, WordID: wordID}, nil
+}
+
+// DeleteExample deletes an example with its translations
+func (h *Handler) DeleteExample(ctx context.Context, editorID, exampleID int64) error {
+	wordID, err := h.editorRepo.DeleteExample(ctx, editorID, exampleID)
+	if err != nil {
+		return sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(false, wordID)
+	return nil
+}
+
+// UpsertPronunciation writes the pronunciation of a word for a dialect
+func (h *Handler) UpsertPronunciation(ctx context.Context, editorID int64, input UpsertPronunciationInput) (*EditOutput, error) {
+	if err := validatePronunciation(&input.Pronunciation); err != nil {
+		return nil, err
+	}
+
+	pronunciationID, err := h.editorRepo.UpsertPronunciation(ctx, editorID, input.WordID, &input.Pronunciation)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(false, input.WordID)
+	return &EditOutput{ID: pronunciationID, WordID: input.WordID}, nil
+}
+
+// DeletePronunciation deletes a pronunciation
+func (h *Handler) DeletePronunciation(ctx context.Context, editorID, pronunciationID int64) error {
+	wordID, err := h.editorRepo.DeletePronunciation(ctx, editorID, pronunciationID)
+	if err != nil {
+		return sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(false, wordID)
+	return nil
+}
+
+// UpsertRelation relates a word to another word, creating the target when missing
+func (h *Handler) UpsertRelation(ctx context.Context, editorID int64, input UpsertRelationInput) (*EditOutput, error) {
+	if err := validateRelation(&input.Relation); err != nil {
+		return nil, err
+	}
+
+	targetWordID, err := h.editorRepo.UpsertRelation(ctx, editorID, input.WordID, &input.Relation)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(true, input.WordID)
+	return &EditOutput{ID: targetWordID, WordID: input.WordID}, nil
+}
+
+// DeleteRelation deletes a relation of a word. The target word is kept.
+func (h *Handler) DeleteRelation(ctx context.Context, editorID int64, input DeleteRelationInput) error {
+	if err := h.editorRepo.DeleteRelation(ctx, editorID, input.WordID, input.TargetWordID, input.RelationType); err != nil {
+		return sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(false, input.WordID)
+	return nil
+}
+
+// CreateTopic creates a topic
+func (h *Handler) CreateTopic(ctx context.Context, input TopicInput) (*domain.Topic, error) {
+	if err := validateTopic(&input); err != nil {
+		return nil, err
+	}
+
+	topic := &domain.Topic{Code: strings.TrimSpace(input.Code), Name: strings.TrimSpace(input.Name)}
+	if err := h.editorRepo.CreateTopic(ctx, topic); err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	return topic, nil
+}
+
+// UpdateTopic changes the code and name of a topic
+func (h *Handler) UpdateTopic(ctx context.Context, editorID int64, input TopicInput) (*domain.Topic, error) {
+	if err := validateTopic(&input); err != nil {
+		return nil, err
+	}
+
+	topic := &domain.Topic{ID: input.ID, Code: strings.TrimSpace(input.Code), Name: strings.TrimSpace(input.Name)}
+	wordIDs, err := h.editorRepo.UpdateTopic(ctx, editorID, topic)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(false, wordIDs...)
+	return topic, nil
+}
+
+// DeleteTopic deletes a topic and untags its words. Topics used by game sessions cannot be deleted.
+func (h *Handler) DeleteTopic(ctx context.Context, editorID, topicID int64) error {
+	wordIDs, err := h.editorRepo.DeleteTopic(ctx, editorID, topicID)
+	if err != nil {
+		return sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(false, wordIDs...)
+	return nil
+}
+
+// RestoreRevision brings a word back to one of its revisions, recreating it when it was deleted
+func (h *Handler) RestoreRevision(ctx context.Context, editorID int64, input RestoreRevisionInput) (*RestoreRevisionOutput, error) {
+	wordID, revision, err := h.editorRepo.RestoreRevision(ctx, editorID, input.WordID, input.Revision)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.wordsChanged(true, input.WordID, wordID)
+	h.logger.Info("dictionary word restored",
+		logger.Int64("word_id", wordID),
+		logger.Int("from_revision", input.Revision),
+		logger.Int("revision", revision),
+	)
+	return &RestoreRevisionOutput{WordID: wordID, Revision: revision}, nil
+}
+
+// wordsChanged evicts the cached details of the words and of every detail embedding them.
+// lemmasChanged also rebuilds the suggest index, for edits that add, rename or remove words.
+func (h *Handler) wordsChanged(lemmasChanged bool, wordIDs ...int64) {
+	h.cache.Invalidate(wordIDs...)
+	if lemmasChanged {
+		h.suggest.Invalidate()
+	}
+}
@@ -0,0 +1,79 @@
+package edit_dictionary
+
+import (
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+)
+
+// UpdateWordInput represents the input for updating a word
+type UpdateWordInput struct {
+	WordID int64
+	Word   domain.WordEdit
+}
+
+// CreateSenseInput represents the input for adding a sense to a word
+type CreateSenseInput struct {
+	WordID int64
+	Sense  domain.SenseJSON
+}
+
+// UpdateSenseInput represents the input for updating a sense
+type UpdateSenseInput struct {
+	SenseID int64
+	Sense   domain.SenseEdit
+}
+
+// UpsertSenseTranslationInput represents the input for linking a translation to a sense
+type UpsertSenseTranslationInput struct {
+	SenseID     int64
+	Translation domain.SenseTranslationJSON
+}
+
+// DeleteSenseTranslationInput represents the input for unlinking a translation from a sense
+type DeleteSenseTranslationInput struct {
+	SenseID      int64
+	TargetWordID int64
+}
+
+// CreateExampleInput represents the input for adding an example to a sense
+type CreateExampleInput struct {
+	SenseID int64
+	Example domain.ExampleJSON
+}
+
+// UpdateExampleInput represents the input for replacing an example
+type UpdateExampleInput struct {
+	ExampleID int64
+	Example   domain.ExampleJSON
+}
+
+// UpsertPronunciationInput represents the input for writing a pronunciation of a word
+type UpsertPronunciationInput struct {
+	WordID        int64
+	Pronunciation domain.PronunciationJSON
+}
+
+// UpsertRelationInput represents the input for relating a word to another word
+type UpsertRelationInput struct {
+	WordID   int64
+	Relation domain.WordRelationJSON
+}
+
+// DeleteRelationInput represents the input for deleting a relation of a word
+type DeleteRelationInput struct {
+	WordID       int64
+	TargetWordID int64
+	RelationType string
+}
+
+// TopicInput represents the input for creating or updating a topic. ID is ignored on create.
+type TopicInput struct {
+	ID   int64
+	Code string
+	Name string
+}
+
+// RestoreRevisionInput represents the input for restoring a word to one of its revisions
+type RestoreRevisionInput struct {
+	WordID   int64
+	Revision int
+}
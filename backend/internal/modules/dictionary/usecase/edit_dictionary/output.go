@@ -0,0 +1,14 @@
+package edit_dictionary
+
+// EditOutput identifies the entry written by an editor operation and the word it belongs to
+type EditOutput struct {
+	ID     int64 // ID of the created or updated entry; the target word ID for translations and relations
+	WordID int64
+}
+
+// RestoreRevisionOutput identifies the restored word and the revision recording the restore. WordID
+// differs from the requested word when a deleted word was recreated.
+type RestoreRevisionOutput struct {
+	WordID   int64 `json:"word_id"`
+	Revision int   `json:"revision"`
+}
@@ -0,0 +1,178 @@
+package edit_dictionary
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// invalid builds an invalid parameter error with a formatted detail
+func invalid(format string, args ...any) error {
+	return sharederrors.ErrInvalidParameter.WithDetails(fmt.Sprintf(format, args...))
+}
+
+// blank reports whether s is empty after trimming spaces
+func blank(s string) bool {
+	return strings.TrimSpace(s) == ""
+}
+
+// validateWord checks a word document before it is written. Sense orders must be unique within the
+// document and relations may not point back to the word itself.
+func validateWord(w *domain.WordJSON) error {
+	if blank(w.Language) {
+		return invalid("language is required")
+	}
+	if blank(w.Lemma) {
+		return invalid("lemma is required")
+	}
+	if err := validateTopics(w.Topics); err != nil {
+		return err
+	}
+	for i := range w.Pronunciations {
+		if err := validatePronunciation(&w.Pronunciations[i]); err != nil {
+			return err
+		}
+	}
+
+	orders := make(map[int]bool, len(w.Senses))
+	for i := range w.Senses {
+		s := &w.Senses[i]
+		if err := ValidateSense(s); err != nil {
+			return err
+		}
+		if orders[s.Order] {
+			return invalid("sense order %d is used more than once", s.Order)
+		}
+		orders[s.Order] = true
+	}
+
+	for i := range w.Relations {
+		r := &w.Relations[i]
+		if err := validateRelation(r); err != nil {
+			return err
+		}
+		if r.TargetWord.Language == w.Language && r.TargetWord.Lemma == w.Lemma {
+			return domain.ErrSelfRelation
+		}
+	}
+	return nil
+}
+
+// validateWordEdit checks the editable fields of a word
+func validateWordEdit(w *domain.WordEdit) error {
+	if blank(w.Lemma) {
+		return invalid("lemma is required")
+	}
+	if w.FrequencyRank != nil && *w.FrequencyRank < 1 {
+		return invalid("frequency_rank must be positive")
+	}
+	return validateTopics(w.Topics)
+}
+
+func validateTopics(topics []string) error {
+	for _, code := range topics {
+		if blank(code) {
+			return invalid("topic codes must not be empty")
+		}
+	}
+	return nil
+}
+
+func validatePronunciation(p *domain.PronunciationJSON) error {
+	if blank(p.Dialect) {
+		return invalid("dialect is required")
+	}
+	return nil
+}
+
+// ValidateSense checks a sense with its translations and examples
+func ValidateSense(s *domain.SenseJSON) error {
+	if err := validateSenseFields(s.Order, s.PartOfSpeech, s.DefinitionLanguage, s.Definition); err != nil {
+		return err
+	}
+	for i := range s.Translations {
+		if err := ValidateSenseTranslation(&s.Translations[i]); err != nil {
+			return err
+		}
+	}
+	for i := range s.Examples {
+		if err := ValidateExample(&s.Examples[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateSenseEdit checks the editable fields of a sense
+func ValidateSenseEdit(s *domain.SenseEdit) error {
+	return validateSenseFields(s.Order, s.PartOfSpeech, s.DefinitionLanguage, s.Definition)
+}
+
+func validateSenseFields(order int, partOfSpeech, definitionLanguage, definition string) error {
+	if order < 1 || order > 32767 {
+		return invalid("sense order must be between 1 and 32767")
+	}
+	if blank(partOfSpeech) {
+		return invalid("part_of_speech is required")
+	}
+	if blank(definitionLanguage) {
+		return invalid("definition_language is required")
+	}
+	if blank(definition) {
+		return invalid("definition is required")
+	}
+	return nil
+}
+
+// ValidateSenseTranslation checks the target word of a sense translation
+func ValidateSenseTranslation(t *domain.SenseTranslationJSON) error {
+	return validateRelatedWord(&t.TargetWord)
+}
+
+// ValidateExample checks an example and its translations, allowing one translation per language
+func ValidateExample(ex *domain.ExampleJSON) error {
+	if blank(ex.Language) {
+		return invalid("example language is required")
+	}
+	if blank(ex.Content) {
+		return invalid("example content is required")
+	}
+	seen := make(map[string]bool, len(ex.Translations))
+	for _, tr := range ex.Translations {
+		if blank(tr.Language) || blank(tr.Content) {
+			return invalid("example translations need a language and content")
+		}
+		if seen[tr.Language] {
+			return invalid("example has more than one %s translation", tr.Language)
+		}
+		seen[tr.Language] = true
+	}
+	return nil
+}
+
+func validateRelation(r *domain.WordRelationJSON) error {
+	if !slices.Contains(domain.RelationTypes, r.RelationType) {
+		return invalid("relation_type must be one of %s", strings.Join(domain.RelationTypes, ", "))
+	}
+	return validateRelatedWord(&r.TargetWord)
+}
+
+func validateRelatedWord(w *domain.RelatedWordJSON) error {
+	if blank(w.Language) || blank(w.Lemma) {
+		return invalid("target_word needs a language and lemma")
+	}
+	return nil
+}
+
+func validateTopic(t *TopicInput) error {
+	if blank(t.Code) {
+		return invalid("code is required")
+	}
+	if blank(t.Name) {
+		return invalid("name is required")
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package export_words
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/constants"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Flusher is implemented by writers that can push buffered data to the client.
+// Execute flushes after every page so the export streams instead of buffering.
+type Flusher interface {
+	Flush() error
+}
+
+// deckName is the Anki deck exports go to; selection filters add subdecks
+const deckName = "English Coach"
+
+// Handler handles exporting dictionary entries
+type Handler struct {
+	exportRepo   domain.WordExportRepository
+	languageRepo domain.LanguageRepository
+	levelRepo    domain.LevelRepository
+	topicRepo    domain.TopicRepository
+	logger       logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	exportRepo domain.WordExportRepository,
+	languageRepo domain.LanguageRepository,
+	levelRepo domain.LevelRepository,
+	topicRepo domain.TopicRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		exportRepo:   exportRepo,
+		languageRepo: languageRepo,
+		levelRepo:    levelRepo,
+		topicRepo:    topicRepo,
+		logger:       logger,
+	}
+}
+
+// filter validates the input and resolves its codes before anything is written to the client.
+// Unknown codes are reported as not found rather than as an empty export.
+func (h *Handler) filter(ctx context.Context, input ExportWordsInput) (domain.WordExportFilter, error) {
+	var filter domain.WordExportFilter
+	switch input.Format {
+	case FormatJSONL, FormatCSV, FormatAPKG:
+	default:
+		return filter, sharederrors.ErrInvalidExportFormat.WithDetails("format must be jsonl, csv or apkg")
+	}
+
+	if input.Language != "" {
+		language, err := h.languageRepo.FindLanguageByCode(ctx, input.Language)
+		if err != nil {
+			return filter, sharederrors.MapDomainErrorToAppError(err)
+		}
+		filter.LanguageID = &language.ID
+	}
+	if input.Level != "" {
+		level, err := h.levelRepo.FindLevelByCode(ctx, input.Level)
+		if err != nil {
+			return filter, sharederrors.MapDomainErrorToAppError(err)
+		}
+		filter.LevelID = &level.ID
+	}
+	if input.Topic != "" {
+		topic, err := h.topicRepo.FindTopicByCode(ctx, input.Topic)
+		if err != nil {
+			return filter, sharederrors.MapDomainErrorToAppError(err)
+		}
+		filter.TopicID = &topic.ID
+	}
+	filter.WordIDs = input.WordIDs
+	return filter, nil
+}
+
+// Execute writes every entry matching the input to w, ordered by word ID. Entries are read page by
+// page with a keyset cursor; JSONL and CSV are streamed, while an Anki package is assembled in
+// memory and written at the end because the collection is a single database file.
+func (h *Handler) Execute(ctx context.Context, input ExportWordsInput, w io.Writer) (*ExportWordsOutput, error) {
+	filter, err := h.filter(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	records := newRecordWriter(input.Format, w, exportDeckName(input))
+	output := &ExportWordsOutput{}
+	var afterID int64
+
+	for {
+		words, err := h.exportRepo.FindExportWords(ctx, filter, afterID, constants.WordExportBatchSize)
+		if err != nil {
+			h.logger.Error("failed to read word export page",
+				logger.Error(err),
+				logger.Int64("after_word_id", afterID),
+			)
+			return nil, sharederrors.MapDomainErrorToAppError(err)
+		}
+
+		for _, word := range words {
+			if err := records.writeWord(word); err != nil {
+				return nil, err
+			}
+			output.WordCount++
+		}
+
+		// Deleted words are skipped, so a short page does not always mean the end
+		if len(words) == 0 {
+			break
+		}
+		afterID = words[len(words)-1].ID
+
+		if err := h.flush(records, w); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := records.close(); err != nil {
+		return nil, err
+	}
+	if err := h.flush(records, w); err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("dictionary export completed",
+		logger.String("format", input.Format),
+		logger.String("language", input.Language),
+		logger.String("level", input.Level),
+		logger.String("topic", input.Topic),
+		logger.Int("selected_word_count", len(input.WordIDs)),
+		logger.Int("word_count", output.WordCount),
+	)
+
+	return output, nil
+}
+
+// flush pushes the encoder buffer and then the underlying writer, if it supports flushing
+func (h *Handler) flush(records recordWriter, w io.Writer) error {
+	if err := records.flush(); err != nil {
+		return err
+	}
+	if f, ok := w.(Flusher); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+// exportDeckName names the Anki deck after the selection, e.g. "English Coach::zh::HSK1"
+func exportDeckName(input ExportWordsInput) string {
+	parts := []string{deckName}
+	for _, code := range []string{input.Language, input.Level, input.Topic} {
+		if code != "" {
+			parts = append(parts, code)
+		}
+	}
+	return strings.Join(parts, "::")
+}
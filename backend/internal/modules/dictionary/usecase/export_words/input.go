@@ -0,0 +1,18 @@
+package export_words
+
+// Supported export formats
+const (
+	FormatJSONL = "jsonl" // one seed file line per word, importable by the seeder
+	FormatCSV   = "csv"   // one record per sense
+	FormatAPKG  = "apkg"  // an Anki deck with one front/back card per word
+)
+
+// ExportWordsInput selects the words to export by language, level and topic codes. WordIDs
+// restricts the export to an explicit selection such as a user's word list; filters combine.
+type ExportWordsInput struct {
+	Format   string
+	Language string
+	Level    string
+	Topic    string
+	WordIDs  []int64
+}
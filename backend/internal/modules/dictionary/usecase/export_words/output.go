@@ -0,0 +1,6 @@
+package export_words
+
+// ExportWordsOutput summarizes a finished export
+type ExportWordsOutput struct {
+	WordCount int
+}
@@ -0,0 +1,223 @@
+package export_words
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/anki"
+)
+
+// csvHeader lists the CSV columns; each CSV record is one sense of a word, and words without
+// senses get a single record with empty sense columns
+var csvHeader = []string{
+	"word_id", "language", "lemma", "romanization", "topics",
+	"sense_order", "part_of_speech", "level", "definition_language", "definition", "usage_label",
+	"translations", "examples",
+}
+
+// recordWriter encodes exported words in a specific format
+type recordWriter interface {
+	// writeWord writes one entry
+	writeWord(word *domain.ExportedWord) error
+	// flush pushes buffered output to the underlying writer
+	flush() error
+	// close finishes the export
+	close() error
+}
+
+// newRecordWriter returns the writer for the format, or nil if the format is unsupported
+func newRecordWriter(format string, w io.Writer, deckName string) recordWriter {
+	switch format {
+	case FormatJSONL:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		return &jsonlRecordWriter{enc: enc}
+	case FormatCSV:
+		return &csvRecordWriter{w: csv.NewWriter(w)}
+	case FormatAPKG:
+		return &apkgRecordWriter{w: w, pkg: anki.NewPackage(deckName)}
+	default:
+		return nil
+	}
+}
+
+// jsonlRecordWriter writes one seed file line per word
+type jsonlRecordWriter struct {
+	enc *json.Encoder
+}
+
+func (jw *jsonlRecordWriter) writeWord(word *domain.ExportedWord) error {
+	// Encode appends the newline that terminates the JSONL line
+	return jw.enc.Encode(word.Word)
+}
+
+func (jw *jsonlRecordWriter) flush() error {
+	return nil
+}
+
+func (jw *jsonlRecordWriter) close() error {
+	return nil
+}
+
+// csvRecordWriter writes one CSV record per sense
+type csvRecordWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (cw *csvRecordWriter) writeHeader() error {
+	if cw.headerWritten {
+		return nil
+	}
+	cw.headerWritten = true
+	return cw.w.Write(csvHeader)
+}
+
+func (cw *csvRecordWriter) writeWord(word *domain.ExportedWord) error {
+	if err := cw.writeHeader(); err != nil {
+		return err
+	}
+
+	entry := word.Word
+	prefix := []string{
+		strconv.FormatInt(word.ID, 10),
+		entry.Language,
+		entry.Lemma,
+		formatStringPtr(entry.Romanization),
+		strings.Join(entry.Topics, ";"),
+	}
+	if len(entry.Senses) == 0 {
+		return cw.w.Write(append(prefix, make([]string, len(csvHeader)-len(prefix))...))
+	}
+
+	for _, sense := range entry.Senses {
+		translations := make([]string, 0, len(sense.Translations))
+		for _, translation := range sense.Translations {
+			translations = append(translations, translation.TargetWord.Language+":"+translation.TargetWord.Lemma)
+		}
+		examples := make([]string, 0, len(sense.Examples))
+		for _, example := range sense.Examples {
+			examples = append(examples, formatExample(example))
+		}
+
+		record := append(append([]string{}, prefix...),
+			strconv.Itoa(sense.Order),
+			sense.PartOfSpeech,
+			formatStringPtr(sense.Level),
+			sense.DefinitionLanguage,
+			sense.Definition,
+			formatStringPtr(sense.UsageLabel),
+			strings.Join(translations, ";"),
+			strings.Join(examples, " | "),
+		)
+		if err := cw.w.Write(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cw *csvRecordWriter) flush() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+func (cw *csvRecordWriter) close() error {
+	// An empty export still gets the header row
+	if err := cw.writeHeader(); err != nil {
+		return err
+	}
+	return cw.flush()
+}
+
+// apkgRecordWriter collects one Anki note per word and writes the package on close
+type apkgRecordWriter struct {
+	w   io.Writer
+	pkg *anki.Package
+}
+
+func (aw *apkgRecordWriter) writeWord(word *domain.ExportedWord) error {
+	entry := word.Word
+	tags := []string{entry.Language}
+	levels := make(map[string]bool)
+	for _, sense := range entry.Senses {
+		if sense.Level != nil && !levels[*sense.Level] {
+			levels[*sense.Level] = true
+			tags = append(tags, *sense.Level)
+		}
+	}
+	tags = append(tags, entry.Topics...)
+
+	aw.pkg.AddNote(anki.Note{
+		// Keyed by lemma rather than word ID so packages from different databases match up
+		Key:   entry.Language + ":" + entry.Lemma,
+		Front: html.EscapeString(entry.Lemma),
+		Back:  cardBack(entry),
+		Tags:  tags,
+	})
+	return nil
+}
+
+func (aw *apkgRecordWriter) flush() error {
+	return nil
+}
+
+func (aw *apkgRecordWriter) close() error {
+	_, err := aw.pkg.WriteTo(aw.w)
+	return err
+}
+
+// cardBack renders the answer side of a word's card: its romanization and a numbered list of
+// senses with their translations and first example
+func cardBack(entry *domain.WordJSON) string {
+	var b strings.Builder
+	if entry.Romanization != nil {
+		fmt.Fprintf(&b, "<div>%s</div>", html.EscapeString(*entry.Romanization))
+	}
+	if len(entry.Senses) == 0 {
+		return b.String()
+	}
+
+	b.WriteString("<ol>")
+	for _, sense := range entry.Senses {
+		fmt.Fprintf(&b, "<li><i>%s</i> %s", html.EscapeString(sense.PartOfSpeech), html.EscapeString(sense.Definition))
+		if len(sense.Translations) > 0 {
+			lemmas := make([]string, 0, len(sense.Translations))
+			for _, translation := range sense.Translations {
+				lemmas = append(lemmas, html.EscapeString(translation.TargetWord.Lemma))
+			}
+			fmt.Fprintf(&b, "<br>%s", strings.Join(lemmas, ", "))
+		}
+		if len(sense.Examples) > 0 {
+			fmt.Fprintf(&b, "<br><small>%s</small>", html.EscapeString(formatExample(sense.Examples[0])))
+		}
+		b.WriteString("</li>")
+	}
+	b.WriteString("</ol>")
+	return b.String()
+}
+
+// formatExample joins an example with its translations, e.g. "我很好 (vi: Tôi khỏe)"
+func formatExample(example domain.ExampleJSON) string {
+	if len(example.Translations) == 0 {
+		return example.Content
+	}
+	translations := make([]string, 0, len(example.Translations))
+	for _, translation := range example.Translations {
+		translations = append(translations, translation.Language+": "+translation.Content)
+	}
+	return example.Content + " (" + strings.Join(translations, "; ") + ")"
+}
+
+func formatStringPtr(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
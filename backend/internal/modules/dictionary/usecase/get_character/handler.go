@@ -0,0 +1,106 @@
+package get_character
+
+import (
+	"context"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/constants"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler provides the character dictionary detail
+type Handler struct {
+	characterRepo domain.CharacterRepository
+	languageRepo  domain.LanguageRepository
+	levelRepo     domain.LevelRepository
+	logger        logger.ILogger
+}
+
+// NewHandler creates a new character detail handler
+func NewHandler(
+	characterRepo domain.CharacterRepository,
+	languageRepo domain.LanguageRepository,
+	levelRepo domain.LevelRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		characterRepo: characterRepo,
+		languageRepo:  languageRepo,
+		levelRepo:     levelRepo,
+		logger:        logger,
+	}
+}
+
+// Execute returns the character with its level, readings grouped by language and the words containing it
+func (h *Handler) Execute(ctx context.Context, input GetCharacterInput) (*GetCharacterOutput, error) {
+	limit := input.WordsLimit
+	if limit <= 0 {
+		limit = constants.DefaultCharacterWordsLimit
+	}
+	if limit > constants.MaxCharacterWordsLimit {
+		limit = constants.MaxCharacterWordsLimit
+	}
+
+	character, err := h.characterRepo.FindCharacterByLiteral(ctx, input.Literal)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	output := &GetCharacterOutput{
+		Character: character,
+		Readings:  []ReadingGroup{},
+	}
+
+	if character.LevelID != nil {
+		level, err := h.levelRepo.FindLevelByID(ctx, *character.LevelID)
+		if err != nil {
+			// A dangling level reference should not hide the character
+			h.logger.Warn("character level lookup failed",
+				logger.Int64("character_id", character.ID),
+				logger.Int64("level_id", *character.LevelID),
+				logger.Error(err),
+			)
+		} else {
+			output.Level = level
+		}
+	}
+
+	readings, err := h.characterRepo.FindReadingsByCharacterIDs(ctx, []int64{character.ID})
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if list := readings[character.ID]; len(list) > 0 {
+		languages, err := h.languageRepo.FindAllLanguages(ctx)
+		if err != nil {
+			return nil, sharederrors.MapDomainErrorToAppError(err)
+		}
+		languageByID := make(map[int16]*domain.Language, len(languages))
+		for _, lang := range languages {
+			languageByID[lang.ID] = lang
+		}
+
+		// Readings arrive ordered by language, so each language forms one contiguous run
+		for _, reading := range list {
+			last := len(output.Readings) - 1
+			if last < 0 || output.Readings[last].Language.ID != reading.LanguageID {
+				language := languageByID[reading.LanguageID]
+				if language == nil {
+					language = &domain.Language{ID: reading.LanguageID}
+				}
+				output.Readings = append(output.Readings, ReadingGroup{Language: language})
+				last++
+			}
+			output.Readings[last].Readings = append(output.Readings[last].Readings, reading)
+		}
+	}
+
+	words, total, err := h.characterRepo.FindWordsByCharacterID(ctx, character.ID, limit)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	output.Words = words
+	output.WordsTotal = total
+
+	return output, nil
+}
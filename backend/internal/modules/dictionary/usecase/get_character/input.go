@@ -0,0 +1,7 @@
+package get_character
+
+// GetCharacterInput represents the input for the character detail use case.
+type GetCharacterInput struct {
+	Literal    string // the character, or its simplified/traditional form
+	WordsLimit int    // maximum number of words containing the character to list
+}
@@ -0,0 +1,18 @@
+package get_character
+
+import "github.com/english-coach/backend/internal/modules/dictionary/domain"
+
+// GetCharacterOutput represents a character with its readings and the words that contain it.
+type GetCharacterOutput struct {
+	Character  *domain.Character
+	Level      *domain.Level
+	Readings   []ReadingGroup
+	Words      []*domain.CharacterWord
+	WordsTotal int
+}
+
+// ReadingGroup holds the readings of a character in one language.
+type ReadingGroup struct {
+	Language *domain.Language
+	Readings []*domain.CharacterReading
+}
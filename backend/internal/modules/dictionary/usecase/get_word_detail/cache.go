@@ -0,0 +1,230 @@
+package get_word_detail
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/cache"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Cache defaults, used when the configured values are not positive
+const (
+	defaultCacheCapacity        = 5000
+	defaultCacheRefreshInterval = 30 * time.Second
+)
+
+// CacheConfig holds word detail cache configuration
+type CacheConfig struct {
+	Enabled         bool
+	Capacity        int           // Maximum number of cached words
+	TTL             time.Duration // Upper bound on how long a detail is served; 0 disables expiry
+	RefreshInterval time.Duration // How often words.updated_at is checked for changes
+}
+
+// Cache stores assembled word details by word ID. Cached details are shared between
+// requests and must be treated as read-only.
+type Cache interface {
+	Get(wordID int64) (*GetWordDetailOutput, bool)
+	Set(wordID int64, detail *GetWordDetailOutput)
+	// Invalidate evicts the given words and every cached detail that embeds them
+	Invalidate(wordIDs ...int64)
+	Purge()
+}
+
+// updatesSource reports which words changed
+type updatesSource interface {
+	GetWordsVersion(ctx context.Context) (*domain.WordsVersion, error)
+	FindWordsUpdatedSince(ctx context.Context, since time.Time) ([]*domain.WordUpdate, error)
+}
+
+// cachedDetail is a detail together with the other words it embeds
+type cachedDetail struct {
+	detail  *GetWordDetailOutput
+	related []int64
+}
+
+// LRUCache is an in-memory Cache. A background poller watches words.updated_at and evicts
+// changed words along with the details that show them as translations or relations.
+// A disabled or nil LRUCache never stores anything.
+type LRUCache struct {
+	cfg      CacheConfig
+	capacity int
+	lru      *cache.LRU[int64, cachedDetail]
+	logger   logger.ILogger
+
+	mu      sync.Mutex
+	version *domain.WordsVersion // last version seen by the poller
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewLRUCache creates a new, empty word detail cache
+func NewLRUCache(cfg CacheConfig, logger logger.ILogger) *LRUCache {
+	capacity := cfg.Capacity
+	if capacity <= 0 {
+		capacity = defaultCacheCapacity
+	}
+	return &LRUCache{
+		cfg:      cfg,
+		capacity: capacity,
+		lru:      cache.NewLRU[int64, cachedDetail](capacity, cfg.TTL),
+		logger:   logger,
+	}
+}
+
+// Get returns the cached detail for a word
+func (c *LRUCache) Get(wordID int64) (*GetWordDetailOutput, bool) {
+	if c == nil || !c.cfg.Enabled {
+		return nil, false
+	}
+	entry, ok := c.lru.Get(wordID)
+	return entry.detail, ok
+}
+
+// Set caches the detail for a word
+func (c *LRUCache) Set(wordID int64, detail *GetWordDetailOutput) {
+	if c == nil || !c.cfg.Enabled || detail == nil {
+		return
+	}
+	c.lru.Set(wordID, cachedDetail{detail: detail, related: relatedWordIDs(detail)})
+}
+
+// Invalidate evicts the given words and every cached detail that embeds them
+func (c *LRUCache) Invalidate(wordIDs ...int64) {
+	if c == nil || !c.cfg.Enabled || len(wordIDs) == 0 {
+		return
+	}
+	changed := make(map[int64]bool, len(wordIDs))
+	for _, id := range wordIDs {
+		changed[id] = true
+	}
+	c.lru.DeleteFunc(func(wordID int64, entry cachedDetail) bool {
+		if changed[wordID] {
+			return true
+		}
+		for _, id := range entry.related {
+			if changed[id] {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Purge evicts every cached detail
+func (c *LRUCache) Purge() {
+	if c == nil || !c.cfg.Enabled {
+		return
+	}
+	c.lru.Purge()
+}
+
+// Start polls source for word changes until Stop is called
+func (c *LRUCache) Start(source updatesSource) {
+	if c == nil || !c.cfg.Enabled || c.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.done = make(chan struct{})
+
+	interval := c.cfg.RefreshInterval
+	if interval <= 0 {
+		interval = defaultCacheRefreshInterval
+	}
+
+	go func() {
+		defer close(c.done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		c.refresh(ctx, source)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			c.refresh(ctx, source)
+		}
+	}()
+
+	c.logger.Info("word detail cache started",
+		logger.Int("capacity", c.capacity),
+		logger.Duration("ttl", c.cfg.TTL),
+		logger.Duration("refresh_interval", interval),
+	)
+}
+
+// Stop stops the background poller and waits for it to exit
+func (c *LRUCache) Stop() {
+	if c == nil || c.cancel == nil {
+		return
+	}
+	c.cancel()
+	<-c.done
+	c.cancel = nil
+}
+
+// refresh evicts details of words updated since the last check. Deleted words leave no
+// updated_at behind, so a drop in the word count purges the whole cache.
+func (c *LRUCache) refresh(ctx context.Context, source updatesSource) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	version, err := source.GetWordsVersion(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			c.logger.Warn("word detail cache version check failed", logger.Error(err))
+		}
+		return
+	}
+
+	previous := c.version
+	switch {
+	case previous == nil:
+		// First check only records the baseline
+	case *version == *previous:
+		return
+	case version.WordCount < previous.WordCount:
+		c.lru.Purge()
+		c.logger.Info("word detail cache purged after word deletion")
+	default:
+		updates, err := source.FindWordsUpdatedSince(ctx, previous.LastUpdatedAt)
+		if err != nil {
+			if ctx.Err() == nil {
+				c.logger.Warn("word detail cache update check failed", logger.Error(err))
+			}
+			return
+		}
+		wordIDs := make([]int64, len(updates))
+		for i, update := range updates {
+			wordIDs[i] = update.ID
+		}
+		c.Invalidate(wordIDs...)
+		c.logger.Debug("word detail cache invalidated updated words", logger.Int("words", len(wordIDs)))
+	}
+	c.version = version
+}
+
+// relatedWordIDs returns the words embedded in a detail as translations or relation targets
+func relatedWordIDs(detail *GetWordDetailOutput) []int64 {
+	var ids []int64
+	for _, sense := range detail.Senses {
+		for _, translation := range sense.Translations {
+			ids = append(ids, translation.ID)
+		}
+	}
+	for _, relation := range detail.Relations {
+		if relation.TargetWord != nil {
+			ids = append(ids, relation.TargetWord.ID)
+		}
+	}
+	return ids
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/english-coach/backend/internal/modules/dictionary/domain"
 	sharederrors "github.com/english-coach/backend/internal/shared/errors"
 	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -16,6 +17,8 @@ type Handler struct {
 	languageRepo     domain.LanguageRepository
 	levelRepo        domain.LevelRepository
 	partOfSpeechRepo domain.PartOfSpeechRepository
+	scripts          domain.ScriptConverter
+	cache            Cache
 	pool             *pgxpool.Pool
 	logger           logger.ILogger
 }
@@ -27,6 +30,8 @@ func NewHandler(
 	languageRepo domain.LanguageRepository,
 	levelRepo domain.LevelRepository,
 	partOfSpeechRepo domain.PartOfSpeechRepository,
+	scripts domain.ScriptConverter,
+	cache Cache,
 	pool *pgxpool.Pool,
 	logger logger.ILogger,
 ) *Handler {
@@ -36,85 +41,80 @@ func NewHandler(
 		languageRepo:     languageRepo,
 		levelRepo:        levelRepo,
 		partOfSpeechRepo: partOfSpeechRepo,
+		scripts:          scripts,
+		cache:            cache,
 		pool:             pool,
 		logger:           logger,
 	}
 }
 
-// Execute retrieves detailed information about a word including senses, translations, examples, and pronunciations
+// Execute retrieves detailed information about a word including senses, translations, examples, and pronunciations.
+// Details are served from the cache when present; the returned output must not be modified.
 func (h *Handler) Execute(ctx context.Context, input GetWordDetailInput) (*GetWordDetailOutput, error) {
+	if h.cache != nil {
+		if detail, ok := h.cache.Get(input.WordID); ok {
+			return detail, nil
+		}
+	}
+
+	detail, complete, err := h.loadWordDetail(ctx, input.WordID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A detail missing parts that failed to load is returned but not cached, so the next
+	// request tries again instead of serving the gaps until the entry expires
+	if h.cache != nil && complete {
+		h.cache.Set(input.WordID, detail)
+	}
+	return detail, nil
+}
+
+// loadWordDetail assembles a word detail from the database. Parts other than the word and its
+// senses are left empty when they fail to load, in which case complete is false.
+func (h *Handler) loadWordDetail(ctx context.Context, wordID int64) (*GetWordDetailOutput, bool, error) {
 	// Get word
-	word, err := h.wordRepo.FindWordByID(ctx, input.WordID)
+	word, err := h.wordRepo.FindWordByID(ctx, wordID)
 	if err != nil {
 		// Check if error is "not found" (pgx.ErrNoRows)
 		if sharederrors.IsNotFound(err) {
-			return nil, domain.ErrWordNotFound
+			return nil, false, domain.ErrWordNotFound
 		}
-		return nil, err
+		return nil, false, err
 	}
 
 	// Check if word is nil (should not happen if repository is correct, but check for safety)
 	if word == nil {
-		return nil, domain.ErrWordNotFound
+		return nil, false, domain.ErrWordNotFound
 	}
 
 	// Get senses
-	senses, err := h.senseRepo.FindSensesByWordID(ctx, input.WordID)
+	senses, err := h.senseRepo.FindSensesByWordID(ctx, wordID)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	// Get translations for each sense
 	senseIDs := make([]int64, len(senses))
 	for i, sense := range senses {
 		senseIDs[i] = sense.ID
 	}
 
-	// Get sense translations
-	translations, err := h.getSenseTranslations(ctx, senseIDs)
-	if err != nil {
-		h.logger.Warn("failed to fetch sense translations", logger.Error(err))
-		translations = make(map[int64][]*domain.Word)
-	}
-	if translations == nil {
-		translations = make(map[int64][]*domain.Word)
-	}
+	// Translations, examples, pronunciations, topics, relations and the pending proposal count in
+	// one round trip
+	rows := h.fetchDetailRows(ctx, wordID, senseIDs)
+	complete := !rows.incomplete
 
-	// Get examples for senses
-	examples, err := h.getExamples(ctx, senseIDs)
-	if err != nil {
-		h.logger.Warn("failed to fetch examples", logger.Error(err))
-		examples = make(map[int64][]*domain.Example)
-	}
-	if examples == nil {
-		examples = make(map[int64][]*domain.Example)
-	}
-
-	// Get pronunciations
-	pronunciations, err := h.getPronunciations(ctx, input.WordID)
-	if err != nil {
-		h.logger.Warn("failed to fetch pronunciations", logger.Error(err))
-		pronunciations = []*domain.Pronunciation{}
-	}
-	if pronunciations == nil {
-		pronunciations = []*domain.Pronunciation{}
-	}
-
-	// Get part of speech IDs and language IDs for lookup
+	// Get part of speech, level and language IDs for lookup
 	posIDs := make([]int16, 0)
 	levelIDs := make([]int64, 0)
-	langIDs := make([]int16, 0)
-	langIDSet := make(map[int16]bool)
+	langIDs := []int16{word.LanguageID}
 
 	for _, sense := range senses {
 		posIDs = append(posIDs, sense.PartOfSpeechID)
 		if sense.LevelID != nil {
 			levelIDs = append(levelIDs, *sense.LevelID)
 		}
-		if !langIDSet[sense.DefinitionLanguageID] {
-			langIDs = append(langIDs, sense.DefinitionLanguageID)
-			langIDSet[sense.DefinitionLanguageID] = true
-		}
+		langIDs = append(langIDs, sense.DefinitionLanguageID)
 	}
 
 	// Fetch part of speech names
@@ -123,6 +123,7 @@ func (h *Handler) Execute(ctx context.Context, input GetWordDetailInput) (*GetWo
 		posData, err := h.partOfSpeechRepo.FindPartsOfSpeechByIDs(ctx, posIDs)
 		if err != nil {
 			h.logger.Warn("failed to fetch part of speech names", logger.Error(err))
+			complete = false
 		} else {
 			for id, pos := range posData {
 				name := pos.Name
@@ -133,25 +134,36 @@ func (h *Handler) Execute(ctx context.Context, input GetWordDetailInput) (*GetWo
 
 	// Fetch level names
 	levelMap := make(map[int64]*string)
-	for _, levelID := range levelIDs {
-		level, err := h.levelRepo.FindLevelByID(ctx, levelID)
+	if len(levelIDs) > 0 {
+		levels, err := h.levelRepo.FindLevelsByIDs(ctx, levelIDs)
 		if err != nil {
-			h.logger.Warn("failed to fetch level name", logger.Int64("level_id", levelID), logger.Error(err))
+			h.logger.Warn("failed to fetch level names", logger.Error(err))
+			complete = false
 		} else {
-			levelMap[levelID] = &level.Name
+			for id, level := range levels {
+				name := level.Name
+				levelMap[id] = &name
+			}
 		}
 	}
 
 	// Fetch language names
+	languages, err := h.languageRepo.FindLanguagesByIDs(ctx, langIDs)
+	if err != nil {
+		h.logger.Warn("failed to fetch language names", logger.Error(err))
+		complete = false
+		languages = make(map[int16]*domain.Language)
+	}
 	langMap := make(map[int16]*string)
-	for _, langID := range langIDs {
-		lang, err := h.languageRepo.FindLanguageByID(ctx, langID)
-		if err != nil {
-			h.logger.Warn("failed to fetch language name", logger.Int("language_id", int(langID)), logger.Error(err))
-		} else {
-			name := lang.Name
-			langMap[langID] = &name
-		}
+	for id, lang := range languages {
+		name := lang.Name
+		langMap[id] = &name
+	}
+
+	// Chinese words carry both script forms so clients can show the reader's preferred one
+	var scriptForms *domain.ScriptForms
+	if language, ok := languages[word.LanguageID]; ok && language.Code == "zh" {
+		scriptForms = domain.NewScriptForms(h.scripts, word.Lemma)
 	}
 
 	// Build sense details
@@ -165,63 +177,71 @@ func (h *Handler) Execute(ctx context.Context, input GetWordDetailInput) (*GetWo
 		}
 
 		// Ensure translations and examples are not nil
-		senseTranslations := translations[sense.ID]
+		senseTranslations := rows.translations[sense.ID]
 		if senseTranslations == nil {
 			senseTranslations = []*domain.Word{}
 		}
-		senseExamples := examples[sense.ID]
+		senseExamples := rows.examples[sense.ID]
 		if senseExamples == nil {
 			senseExamples = []*domain.Example{}
 		}
 
 		senseDetails[i] = SenseDetail{
-			ID:                   sense.ID,
-			SenseOrder:           sense.SenseOrder,
-			PartOfSpeechID:       sense.PartOfSpeechID,
-			PartOfSpeechName:     posMap[sense.PartOfSpeechID],
-			Definition:           sense.Definition,
-			DefinitionLanguageID: sense.DefinitionLanguageID,
-			LevelID:              sense.LevelID,
-			LevelName:            levelName,
-			Note:                 sense.Note,
-			Translations:         senseTranslations,
-			Examples:             senseExamples,
+			ID:                     sense.ID,
+			SenseOrder:             sense.SenseOrder,
+			PartOfSpeechID:         sense.PartOfSpeechID,
+			PartOfSpeechName:       posMap[sense.PartOfSpeechID],
+			Definition:             sense.Definition,
+			DefinitionLanguageID:   sense.DefinitionLanguageID,
+			DefinitionLanguageName: langMap[sense.DefinitionLanguageID],
+			LevelID:                sense.LevelID,
+			LevelName:              levelName,
+			Note:                   sense.Note,
+			Translations:           senseTranslations,
+			Examples:               senseExamples,
 		}
 	}
 
-	// Get topics for word
-	topics, err := h.getWordTopics(ctx, input.WordID)
-	if err != nil {
-		h.logger.Warn("failed to fetch word topics", logger.Error(err))
-	} else {
+	// Attach topics to the word and its related words
+	if topics, ok := rows.topics[word.ID]; ok {
 		word.Topics = topics
-	}
-
-	// Get relations for word
-	relations, err := h.getWordRelations(ctx, input.WordID)
-	if err != nil {
-		h.logger.Warn("failed to fetch word relations", logger.Error(err))
-		relations = []*domain.WordRelation{}
-	}
-	if relations == nil {
-		relations = []*domain.WordRelation{}
+	} else if rows.topicsLoaded {
+		word.Topics = []*domain.Topic{}
+	}
+	for _, relation := range rows.relations {
+		if topics, ok := rows.topics[relation.TargetWord.ID]; ok {
+			relation.TargetWord.Topics = topics
+		} else if rows.topicsLoaded {
+			relation.TargetWord.Topics = []*domain.Topic{}
+		}
 	}
 
 	return &GetWordDetailOutput{
-		Word:           word,
-		Senses:         senseDetails,
-		Pronunciations: pronunciations,
-		Relations:      relations,
-	}, nil
+		Word:             word,
+		Senses:           senseDetails,
+		Pronunciations:   rows.pronunciations,
+		Relations:        rows.relations,
+		ScriptForms:      scriptForms,
+		PendingProposals: rows.pendingProposals,
+	}, complete, nil
 }
 
-// getSenseTranslations retrieves translations for given sense IDs
-func (h *Handler) getSenseTranslations(ctx context.Context, senseIDs []int64) (map[int64][]*domain.Word, error) {
-	if len(senseIDs) == 0 {
-		return make(map[int64][]*domain.Word), nil
-	}
+// detailRows holds the rows fetched alongside a word. A part that fails to load is left empty
+// and marks the rows incomplete.
+type detailRows struct {
+	translations     map[int64][]*domain.Word    // by sense ID
+	examples         map[int64][]*domain.Example // by sense ID
+	pronunciations   []*domain.Pronunciation
+	topics           map[int64][]*domain.Topic // by word ID, for the word and its related words
+	topicsLoaded     bool
+	relations        []*domain.WordRelation
+	pendingProposals int
+	incomplete       bool
+}
 
-	query := `
+// Detail queries, sent together in one batch
+const (
+	senseTranslationsQuery = `
 		SELECT st.source_sense_id, tw.id, tw.language_id, tw.lemma, tw.lemma_normalized, tw.search_key,
 		       tw.romanization, tw.script_code, tw.frequency_rank,
 		       tw.note, tw.created_at, tw.updated_at
@@ -230,7 +250,126 @@ func (h *Handler) getSenseTranslations(ctx context.Context, senseIDs []int64) (m
 		WHERE st.source_sense_id = ANY($1)
 		ORDER BY st.source_sense_id, st.priority, tw.frequency_rank NULLS LAST
 	`
-	rows, err := h.pool.Query(ctx, query, senseIDs)
+	examplesQuery = `
+		SELECT e.id, e.source_sense_id, e.language_id, e.content, e.audio_url, e.source
+		FROM examples e
+		WHERE e.source_sense_id = ANY($1)
+		ORDER BY e.source_sense_id, e.id
+	`
+	exampleTranslationsQuery = `
+		SELECT et.example_id, l.code, et.content
+		FROM example_translations et
+		INNER JOIN examples e ON et.example_id = e.id
+		INNER JOIN languages l ON et.language_id = l.id
+		WHERE e.source_sense_id = ANY($1)
+		ORDER BY et.example_id, et.id
+	`
+	pronunciationsQuery = `
+		SELECT id, word_id, dialect, ipa, phonetic, audio_url
+		FROM pronunciations
+		WHERE word_id = $1
+		ORDER BY id
+	`
+	wordTopicsQuery = `
+		SELECT wt.word_id, t.id, t.code, t.name
+		FROM word_topics wt
+		INNER JOIN topics t ON wt.topic_id = t.id
+		WHERE wt.word_id = $1
+		   OR wt.word_id IN (SELECT to_word_id FROM word_relations WHERE from_word_id = $1)
+		ORDER BY wt.word_id, t.code
+	`
+	wordRelationsQuery = `
+		SELECT wr.relation_type, wr.note,
+		       tw.id, tw.language_id, tw.lemma, tw.lemma_normalized, tw.search_key,
+		       tw.romanization, tw.script_code, tw.frequency_rank, tw.note,
+		       tw.created_at, tw.updated_at
+		FROM word_relations wr
+		INNER JOIN words tw ON wr.to_word_id = tw.id
+		WHERE wr.from_word_id = $1
+		ORDER BY wr.relation_type, tw.lemma
+	`
+	pendingProposalsQuery = `
+		SELECT COUNT(*)
+		FROM word_proposals
+		WHERE word_id = $1 AND status = 'pending'
+	`
+)
+
+// fetchDetailRows loads everything attached to a word and its senses in a single batch
+func (h *Handler) fetchDetailRows(ctx context.Context, wordID int64, senseIDs []int64) *detailRows {
+	result := &detailRows{
+		translations:   make(map[int64][]*domain.Word),
+		examples:       make(map[int64][]*domain.Example),
+		pronunciations: []*domain.Pronunciation{},
+		topics:         make(map[int64][]*domain.Topic),
+		relations:      []*domain.WordRelation{},
+	}
+
+	batch := &pgx.Batch{}
+	batch.Queue(senseTranslationsQuery, senseIDs)
+	batch.Queue(examplesQuery, senseIDs)
+	batch.Queue(exampleTranslationsQuery, senseIDs)
+	batch.Queue(pronunciationsQuery, wordID)
+	batch.Queue(wordTopicsQuery, wordID)
+	batch.Queue(wordRelationsQuery, wordID)
+	batch.Queue(pendingProposalsQuery, wordID)
+
+	br := h.pool.SendBatch(ctx, batch)
+	defer br.Close()
+
+	if translations, err := scanSenseTranslations(br); err != nil {
+		h.logger.Warn("failed to fetch sense translations", logger.Error(err))
+		result.incomplete = true
+	} else {
+		result.translations = translations
+	}
+
+	examples, exampleMap, err := scanExamples(br)
+	if err != nil {
+		h.logger.Warn("failed to fetch examples", logger.Error(err))
+		result.incomplete = true
+	} else {
+		result.examples = examples
+	}
+
+	if err := scanExampleTranslations(br, exampleMap); err != nil {
+		h.logger.Warn("failed to fetch example translations", logger.Error(err))
+		result.incomplete = true
+	}
+
+	if pronunciations, err := scanPronunciations(br); err != nil {
+		h.logger.Warn("failed to fetch pronunciations", logger.Error(err))
+		result.incomplete = true
+	} else {
+		result.pronunciations = pronunciations
+	}
+
+	if topics, err := scanWordTopics(br); err != nil {
+		h.logger.Warn("failed to fetch word topics", logger.Error(err))
+		result.incomplete = true
+	} else {
+		result.topics = topics
+		result.topicsLoaded = true
+	}
+
+	if relations, err := scanWordRelations(br); err != nil {
+		h.logger.Warn("failed to fetch word relations", logger.Error(err))
+		result.incomplete = true
+	} else {
+		result.relations = relations
+	}
+
+	if err := br.QueryRow().Scan(&result.pendingProposals); err != nil {
+		h.logger.Warn("failed to count pending proposals", logger.Error(err))
+		result.incomplete = true
+	}
+
+	return result
+}
+
+// scanSenseTranslations reads translations keyed by source sense ID
+func scanSenseTranslations(br pgx.BatchResults) (map[int64][]*domain.Word, error) {
+	rows, err := br.Query()
 	if err != nil {
 		return nil, err
 	}
@@ -274,21 +413,11 @@ func (h *Handler) getSenseTranslations(ctx context.Context, senseIDs []int64) (m
 	return result, nil
 }
 
-// getExamples retrieves examples for given sense IDs
-func (h *Handler) getExamples(ctx context.Context, senseIDs []int64) (map[int64][]*domain.Example, error) {
-	if len(senseIDs) == 0 {
-		return make(map[int64][]*domain.Example), nil
-	}
-
-	query := `
-		SELECT e.id, e.source_sense_id, e.language_id, e.content, e.audio_url, e.source
-		FROM examples e
-		WHERE e.source_sense_id = ANY($1)
-		ORDER BY e.source_sense_id, e.id
-	`
-	rows, err := h.pool.Query(ctx, query, senseIDs)
+// scanExamples reads examples keyed by sense ID, plus an index by example ID for attaching translations
+func scanExamples(br pgx.BatchResults) (map[int64][]*domain.Example, map[int64]*domain.Example, error) {
+	rows, err := br.Query()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer rows.Close()
 
@@ -305,7 +434,7 @@ func (h *Handler) getExamples(ctx context.Context, senseIDs []int64) (map[int64]
 			&audioURL,
 			&source,
 		); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		example.AudioURL = audioURL
 		example.Source = source
@@ -315,63 +444,46 @@ func (h *Handler) getExamples(ctx context.Context, senseIDs []int64) (map[int64]
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	// Fetch example translations
-	if len(exampleMap) > 0 {
-		exampleIDs := make([]int64, 0, len(exampleMap))
-		for id := range exampleMap {
-			exampleIDs = append(exampleIDs, id)
-		}
+	return result, exampleMap, nil
+}
 
-		transQuery := `
-			SELECT et.example_id, l.code, et.content
-			FROM example_translations et
-			INNER JOIN languages l ON et.language_id = l.id
-			WHERE et.example_id = ANY($1)
-			ORDER BY et.example_id
-		`
-		transRows, err := h.pool.Query(ctx, transQuery, exampleIDs)
-		if err != nil {
-			h.logger.Warn("failed to fetch example translations", logger.Error(err))
-		} else {
-			defer transRows.Close()
-			for transRows.Next() {
-				var exampleID int64
-				var langCode, content string
-				if err := transRows.Scan(&exampleID, &langCode, &content); err != nil {
-					h.logger.Warn("failed to scan example translation", logger.Error(err))
-					continue
-				}
-				if example, ok := exampleMap[exampleID]; ok {
-					example.Translations = append(example.Translations, domain.ExampleTranslationSimple{
-						Language: langCode,
-						Content:  content,
-					})
-				}
-			}
+// scanExampleTranslations appends translations to the examples they belong to
+func scanExampleTranslations(br pgx.BatchResults, exampleMap map[int64]*domain.Example) error {
+	rows, err := br.Query()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var exampleID int64
+		var langCode, content string
+		if err := rows.Scan(&exampleID, &langCode, &content); err != nil {
+			return err
+		}
+		if example, ok := exampleMap[exampleID]; ok {
+			example.Translations = append(example.Translations, domain.ExampleTranslationSimple{
+				Language: langCode,
+				Content:  content,
+			})
 		}
 	}
 
-	return result, nil
+	return rows.Err()
 }
 
-// getPronunciations retrieves pronunciations for a word
-func (h *Handler) getPronunciations(ctx context.Context, wordID int64) ([]*domain.Pronunciation, error) {
-	query := `
-		SELECT id, word_id, dialect, ipa, phonetic, audio_url
-		FROM pronunciations
-		WHERE word_id = $1
-		ORDER BY id
-	`
-	rows, err := h.pool.Query(ctx, query, wordID)
+// scanPronunciations reads the pronunciations of a word
+func scanPronunciations(br pgx.BatchResults) ([]*domain.Pronunciation, error) {
+	rows, err := br.Query()
 	if err != nil {
-		return []*domain.Pronunciation{}, err
+		return nil, err
 	}
 	defer rows.Close()
 
-	var pronunciations []*domain.Pronunciation
+	pronunciations := []*domain.Pronunciation{}
 	for rows.Next() {
 		var pron domain.Pronunciation
 		var dialect, ipa, phonetic, audioURL *string
@@ -383,7 +495,7 @@ func (h *Handler) getPronunciations(ctx context.Context, wordID int64) ([]*domai
 			&phonetic,
 			&audioURL,
 		); err != nil {
-			return []*domain.Pronunciation{}, err
+			return nil, err
 		}
 		pron.Dialect = dialect
 		pron.IPA = ipa
@@ -393,70 +505,46 @@ func (h *Handler) getPronunciations(ctx context.Context, wordID int64) ([]*domai
 	}
 
 	if err := rows.Err(); err != nil {
-		return []*domain.Pronunciation{}, err
-	}
-
-	if pronunciations == nil {
-		return []*domain.Pronunciation{}, nil
+		return nil, err
 	}
 
 	return pronunciations, nil
 }
 
-// getWordTopics retrieves topic objects (with code and name) for a word
-func (h *Handler) getWordTopics(ctx context.Context, wordID int64) ([]*domain.Topic, error) {
-	query := `
-		SELECT t.id, t.code, t.name
-		FROM word_topics wt
-		INNER JOIN topics t ON wt.topic_id = t.id
-		WHERE wt.word_id = $1
-		ORDER BY t.code
-	`
-	rows, err := h.pool.Query(ctx, query, wordID)
+// scanWordTopics reads topic objects (with code and name) keyed by word ID
+func scanWordTopics(br pgx.BatchResults) (map[int64][]*domain.Topic, error) {
+	rows, err := br.Query()
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var topics []*domain.Topic
+	result := make(map[int64][]*domain.Topic)
 	for rows.Next() {
+		var wordID int64
 		var topic domain.Topic
-		if err := rows.Scan(&topic.ID, &topic.Code, &topic.Name); err != nil {
+		if err := rows.Scan(&wordID, &topic.ID, &topic.Code, &topic.Name); err != nil {
 			return nil, err
 		}
-		topics = append(topics, &topic)
+		result[wordID] = append(result[wordID], &topic)
 	}
 
 	if err := rows.Err(); err != nil {
-		return []*domain.Topic{}, err
-	}
-
-	if topics == nil {
-		return []*domain.Topic{}, nil
+		return nil, err
 	}
 
-	return topics, nil
+	return result, nil
 }
 
-// getWordRelations retrieves relations for a word
-func (h *Handler) getWordRelations(ctx context.Context, wordID int64) ([]*domain.WordRelation, error) {
-	query := `
-		SELECT wr.relation_type, wr.note, 
-		       tw.id, tw.language_id, tw.lemma, tw.lemma_normalized, tw.search_key,
-		       tw.romanization, tw.script_code, tw.frequency_rank, tw.note,
-		       tw.created_at, tw.updated_at
-		FROM word_relations wr
-		INNER JOIN words tw ON wr.to_word_id = tw.id
-		WHERE wr.from_word_id = $1
-		ORDER BY wr.relation_type, tw.lemma
-	`
-	rows, err := h.pool.Query(ctx, query, wordID)
+// scanWordRelations reads the relations of a word with their target words
+func scanWordRelations(br pgx.BatchResults) ([]*domain.WordRelation, error) {
+	rows, err := br.Query()
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var relations []*domain.WordRelation
+	relations := []*domain.WordRelation{}
 	for rows.Next() {
 		var relation domain.WordRelation
 		var targetWord domain.Word
@@ -486,25 +574,12 @@ func (h *Handler) getWordRelations(ctx context.Context, wordID int64) ([]*domain
 		targetWord.ScriptCode = scriptCode
 		targetWord.FrequencyRank = frequencyRank
 		targetWord.Note = targetNote
-
-		// Get topics for target word
-		targetTopics, err := h.getWordTopics(ctx, targetWord.ID)
-		if err != nil {
-			h.logger.Warn("failed to fetch topics for related word", logger.Int64("word_id", targetWord.ID), logger.Error(err))
-		} else {
-			targetWord.Topics = targetTopics
-		}
-
 		relation.TargetWord = &targetWord
 		relations = append(relations, &relation)
 	}
 
 	if err := rows.Err(); err != nil {
-		return []*domain.WordRelation{}, err
-	}
-
-	if relations == nil {
-		return []*domain.WordRelation{}, nil
+		return nil, err
 	}
 
 	return relations, nil
@@ -6,24 +6,27 @@ import (
 
 // GetWordDetailOutput represents detailed information about a word for the use case.
 type GetWordDetailOutput struct {
-	Word           *domain.Word
-	Senses         []SenseDetail
-	Pronunciations []*domain.Pronunciation
-	Relations      []*domain.WordRelation
+	Word             *domain.Word
+	Senses           []SenseDetail
+	Pronunciations   []*domain.Pronunciation
+	Relations        []*domain.WordRelation
+	ScriptForms      *domain.ScriptForms // set for Chinese words whose simplified and traditional forms differ
+	PendingProposals int                 // correction proposals waiting for moderation
 }
 
 // SenseDetail represents detailed information about a sense.
 type SenseDetail struct {
-	ID                   int64
-	SenseOrder           int16
-	PartOfSpeechID       int16
-	PartOfSpeechName     *string
-	Definition           string
-	DefinitionLanguageID int16
-	LevelID              *int64
-	LevelName            *string
-	Note                 *string
-	Translations         []*domain.Word
-	Examples             []*domain.Example
+	ID                     int64
+	SenseOrder             int16
+	PartOfSpeechID         int16
+	PartOfSpeechName       *string
+	Definition             string
+	DefinitionLanguageID   int16
+	DefinitionLanguageName *string
+	LevelID                *int64
+	LevelName              *string
+	Note                   *string
+	Translations           []*domain.Word
+	Examples               []*domain.Example
 }
 
@@ -0,0 +1,201 @@
+package get_word_graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/constants"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler builds the word graph around a word
+type Handler struct {
+	wordRepo  domain.WordRepository
+	graphRepo domain.WordGraphRepository
+	logger    logger.ILogger
+}
+
+// NewHandler creates a new word graph handler
+func NewHandler(
+	wordRepo domain.WordRepository,
+	graphRepo domain.WordGraphRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		wordRepo:  wordRepo,
+		graphRepo: graphRepo,
+		logger:    logger,
+	}
+}
+
+// edgeFilter selects which edge sources the traversal follows
+type edgeFilter struct {
+	relationTypes    []string
+	translations     bool
+	sharedCharacters bool
+}
+
+// Execute expands the graph breadth-first from the root word, one batched lookup per edge source and hop.
+// The traversal stops at the requested depth or once MaxWordGraphNodes words have been reached.
+func (h *Handler) Execute(ctx context.Context, input GetWordGraphInput) (*GetWordGraphOutput, error) {
+	depth := input.Depth
+	if depth <= 0 {
+		depth = constants.DefaultWordGraphDepth
+	}
+	if depth > constants.MaxWordGraphDepth {
+		depth = constants.MaxWordGraphDepth
+	}
+
+	filter, err := parseEdgeTypes(input.Types)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := h.wordRepo.FindWordByID(ctx, input.WordID)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	depthByID := map[int64]int{root.ID: 0}
+	order := []int64{root.ID}
+	edges := []*domain.WordGraphEdge{}
+	seenEdges := make(map[string]bool)
+	truncated := false
+
+	frontier := []int64{root.ID}
+	for hop := 1; hop <= depth && len(frontier) > 0; hop++ {
+		hopEdges, err := h.findEdges(ctx, frontier, filter)
+		if err != nil {
+			return nil, sharederrors.MapDomainErrorToAppError(err)
+		}
+
+		var next []int64
+		for _, edge := range hopEdges {
+			for _, id := range [2]int64{edge.FromWordID, edge.ToWordID} {
+				if _, ok := depthByID[id]; ok {
+					continue
+				}
+				if len(order) >= constants.MaxWordGraphNodes {
+					truncated = true
+					continue
+				}
+				depthByID[id] = hop
+				order = append(order, id)
+				next = append(next, id)
+			}
+
+			// Edges to words dropped by the node cap would dangle
+			_, fromOK := depthByID[edge.FromWordID]
+			_, toOK := depthByID[edge.ToWordID]
+			key := edgeKey(edge)
+			if !fromOK || !toOK || seenEdges[key] {
+				continue
+			}
+			seenEdges[key] = true
+			edges = append(edges, edge)
+		}
+		frontier = next
+	}
+
+	words, err := h.wordRepo.FindWordsByIDs(ctx, order)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	wordByID := make(map[int64]*domain.Word, len(words))
+	for _, word := range words {
+		wordByID[word.ID] = word
+	}
+
+	nodes := make([]*domain.WordGraphNode, 0, len(order))
+	for _, id := range order {
+		word := wordByID[id]
+		if word == nil {
+			continue
+		}
+		nodes = append(nodes, &domain.WordGraphNode{Word: word, Depth: depthByID[id]})
+	}
+
+	if truncated {
+		h.logger.Debug("word graph truncated",
+			logger.Int64("word_id", root.ID),
+			logger.Int("depth", depth),
+			logger.Int("nodes", len(nodes)),
+		)
+	}
+
+	return &GetWordGraphOutput{
+		RootWordID: root.ID,
+		Depth:      depth,
+		Nodes:      nodes,
+		Edges:      edges,
+		Truncated:  truncated,
+	}, nil
+}
+
+// findEdges returns every edge of the selected types touching the frontier words
+func (h *Handler) findEdges(ctx context.Context, frontier []int64, filter edgeFilter) ([]*domain.WordGraphEdge, error) {
+	var edges []*domain.WordGraphEdge
+
+	if len(filter.relationTypes) > 0 {
+		relations, err := h.graphRepo.FindRelationEdges(ctx, frontier, filter.relationTypes)
+		if err != nil {
+			return nil, err
+		}
+		edges = append(edges, relations...)
+	}
+
+	if filter.translations {
+		translations, err := h.graphRepo.FindTranslationEdges(ctx, frontier)
+		if err != nil {
+			return nil, err
+		}
+		edges = append(edges, translations...)
+	}
+
+	if filter.sharedCharacters {
+		shared, err := h.graphRepo.FindSharedCharacterEdges(ctx, frontier, constants.WordGraphCharacterNeighbors)
+		if err != nil {
+			return nil, err
+		}
+		edges = append(edges, shared...)
+	}
+
+	return edges, nil
+}
+
+// parseEdgeTypes validates the requested edge types; an empty list follows every type
+func parseEdgeTypes(types []string) (edgeFilter, error) {
+	if len(types) == 0 {
+		return edgeFilter{
+			relationTypes:    domain.RelationTypes,
+			translations:     true,
+			sharedCharacters: true,
+		}, nil
+	}
+
+	var filter edgeFilter
+	for _, t := range types {
+		switch t {
+		case domain.RelationTypeSynonym, domain.RelationTypeAntonym, domain.RelationTypeRelated:
+			filter.relationTypes = append(filter.relationTypes, t)
+		case domain.GraphEdgeTranslation:
+			filter.translations = true
+		case domain.GraphEdgeSharedCharacter:
+			filter.sharedCharacters = true
+		default:
+			return edgeFilter{}, sharederrors.ErrInvalidParameter.WithDetails("invalid edge type: " + t)
+		}
+	}
+	return filter, nil
+}
+
+// edgeKey identifies an edge; the same pair may be linked by several shared characters
+func edgeKey(edge *domain.WordGraphEdge) string {
+	key := fmt.Sprintf("%s:%d:%d", edge.Type, edge.FromWordID, edge.ToWordID)
+	if edge.Character != nil {
+		key += ":" + *edge.Character
+	}
+	return key
+}
@@ -0,0 +1,8 @@
+package get_word_graph
+
+// GetWordGraphInput represents the input for the word graph use case.
+type GetWordGraphInput struct {
+	WordID int64    // root word
+	Depth  int      // hops to expand from the root, 0 for the default
+	Types  []string // edge types to follow, empty for all
+}
@@ -0,0 +1,12 @@
+package get_word_graph
+
+import "github.com/english-coach/backend/internal/modules/dictionary/domain"
+
+// GetWordGraphOutput represents the words reachable from a root word and the edges between them.
+type GetWordGraphOutput struct {
+	RootWordID int64
+	Depth      int
+	Nodes      []*domain.WordGraphNode // breadth-first order, root first
+	Edges      []*domain.WordGraphEdge
+	Truncated  bool // the node cap was hit before the traversal finished
+}
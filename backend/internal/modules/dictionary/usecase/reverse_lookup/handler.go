@@ -0,0 +1,87 @@
+package reverse_lookup
+
+import (
+	"context"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/constants"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler provides reverse translation lookup: from a translated word back to its source words
+type Handler struct {
+	wordRepo     domain.WordRepository
+	languageRepo domain.LanguageRepository
+	logger       logger.ILogger
+}
+
+// NewHandler creates a new reverse lookup handler
+func NewHandler(
+	wordRepo domain.WordRepository,
+	languageRepo domain.LanguageRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		wordRepo:     wordRepo,
+		languageRepo: languageRepo,
+		logger:       logger,
+	}
+}
+
+// Execute finds the source senses whose translations include the queried word and groups them
+// by source language. Within a group results keep the repository order (priority, then frequency).
+func (h *Handler) Execute(ctx context.Context, input ReverseLookupInput) (*ReverseLookupOutput, error) {
+	limit := input.Limit
+	if limit <= 0 {
+		limit = constants.DefaultReverseLookupLimit
+	}
+	if limit > constants.MaxReverseLookupLimit {
+		limit = constants.MaxReverseLookupLimit
+	}
+
+	if _, err := h.languageRepo.FindLanguageByID(ctx, input.LanguageID); err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if input.SourceLanguageID != 0 {
+		if _, err := h.languageRepo.FindLanguageByID(ctx, input.SourceLanguageID); err != nil {
+			return nil, sharederrors.MapDomainErrorToAppError(err)
+		}
+	}
+
+	results, err := h.wordRepo.FindReverseTranslations(ctx, input.Query, input.LanguageID, input.SourceLanguageID, limit)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	output := &ReverseLookupOutput{Groups: []LanguageGroup{}}
+	if len(results) == 0 {
+		return output, nil
+	}
+
+	languages, err := h.languageRepo.FindAllLanguages(ctx)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	languageByID := make(map[int16]*domain.Language, len(languages))
+	for _, lang := range languages {
+		languageByID[lang.ID] = lang
+	}
+
+	// Results arrive ordered by source language, so each language forms one contiguous run
+	for _, result := range results {
+		languageID := result.SourceWord.LanguageID
+		last := len(output.Groups) - 1
+		if last < 0 || output.Groups[last].Language.ID != languageID {
+			language := languageByID[languageID]
+			if language == nil {
+				language = &domain.Language{ID: languageID}
+			}
+			output.Groups = append(output.Groups, LanguageGroup{Language: language})
+			last++
+		}
+		output.Groups[last].Results = append(output.Groups[last].Results, result)
+	}
+
+	return output, nil
+}
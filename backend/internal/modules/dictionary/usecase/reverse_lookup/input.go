@@ -0,0 +1,9 @@
+package reverse_lookup
+
+// ReverseLookupInput represents the input for the reverse translation lookup use case.
+type ReverseLookupInput struct {
+	Query            string
+	LanguageID       int16 // language of the queried (translated) word
+	SourceLanguageID int16 // 0 to search every source language
+	Limit            int
+}
@@ -0,0 +1,14 @@
+package reverse_lookup
+
+import "github.com/english-coach/backend/internal/modules/dictionary/domain"
+
+// ReverseLookupOutput represents source words grouped by their language.
+type ReverseLookupOutput struct {
+	Groups []LanguageGroup
+}
+
+// LanguageGroup holds the reverse translations from one source language, ordered by priority.
+type LanguageGroup struct {
+	Language *domain.Language
+	Results  []*domain.ReverseTranslation
+}
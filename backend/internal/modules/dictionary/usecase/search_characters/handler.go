@@ -0,0 +1,76 @@
+package search_characters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler provides character browsing by radical and stroke count
+type Handler struct {
+	characterRepo domain.CharacterRepository
+	logger        logger.ILogger
+}
+
+// NewHandler creates a new character search handler
+func NewHandler(
+	characterRepo domain.CharacterRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		characterRepo: characterRepo,
+		logger:        logger,
+	}
+}
+
+// Execute returns one page of characters with the given radical and/or stroke count,
+// ordered by stroke count, level and literal
+func (h *Handler) Execute(ctx context.Context, input SearchCharactersInput) (*SearchCharactersOutput, error) {
+	query := domain.CharacterSearchQuery{
+		Strokes: input.Strokes,
+		Limit:   input.Limit,
+		Offset:  input.Offset,
+	}
+	if input.Radical != nil {
+		if radical := strings.TrimSpace(*input.Radical); radical != "" {
+			query.Radical = &radical
+		}
+	}
+	if query.Radical == nil && query.Strokes == nil {
+		return nil, sharederrors.ErrInvalidParameter.WithDetails("radical or strokes is required")
+	}
+	if query.Strokes != nil && *query.Strokes <= 0 {
+		return nil, sharederrors.ErrInvalidParameter.WithDetails("strokes must be positive")
+	}
+
+	characters, total, err := h.characterRepo.SearchCharacters(ctx, query)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	ids := make([]int64, 0, len(characters))
+	for _, character := range characters {
+		ids = append(ids, character.ID)
+	}
+	readings, err := h.characterRepo.FindReadingsByCharacterIDs(ctx, ids)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	output := &SearchCharactersOutput{
+		Results: make([]CharacterResult, 0, len(characters)),
+		Total:   total,
+	}
+	for _, character := range characters {
+		list := readings[character.ID]
+		if list == nil {
+			list = []*domain.CharacterReading{}
+		}
+		output.Results = append(output.Results, CharacterResult{Character: character, Readings: list})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,10 @@
+package search_characters
+
+// SearchCharactersInput represents the input for browsing characters by radical and stroke count.
+// At least one of Radical and Strokes is required.
+type SearchCharactersInput struct {
+	Radical *string
+	Strokes *int16
+	Limit   int
+	Offset  int
+}
@@ -0,0 +1,15 @@
+package search_characters
+
+import "github.com/english-coach/backend/internal/modules/dictionary/domain"
+
+// SearchCharactersOutput represents one page of matching characters.
+type SearchCharactersOutput struct {
+	Results []CharacterResult
+	Total   int
+}
+
+// CharacterResult is a matching character with its readings in every language.
+type CharacterResult struct {
+	Character *domain.Character
+	Readings  []*domain.CharacterReading
+}
@@ -0,0 +1,69 @@
+package search_text
+
+import (
+	"context"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler provides full-text search over definitions and example sentences
+type Handler struct {
+	textSearchRepo domain.TextSearchRepository
+	languageRepo   domain.LanguageRepository
+	logger         logger.ILogger
+}
+
+// NewHandler creates a new text search handler
+func NewHandler(
+	textSearchRepo domain.TextSearchRepository,
+	languageRepo domain.LanguageRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		textSearchRepo: textSearchRepo,
+		languageRepo:   languageRepo,
+		logger:         logger,
+	}
+}
+
+// Execute searches the requested sources in the given language. The language decides tokenization:
+// English is stemmed, other space-delimited languages are matched word by word, and zh/ja/ko by substring.
+func (h *Handler) Execute(ctx context.Context, input SearchTextInput) (*SearchTextOutput, error) {
+	query := domain.TextSearchQuery{
+		Query:      input.Query,
+		LanguageID: input.LanguageID,
+		Limit:      input.Limit,
+		Offset:     input.Offset,
+	}
+
+	if len(input.Sources) == 0 {
+		query.Definitions, query.Examples, query.Translations = true, true, true
+	}
+	for _, source := range input.Sources {
+		switch source {
+		case domain.TextSourceDefinition:
+			query.Definitions = true
+		case domain.TextSourceExample:
+			query.Examples = true
+		case domain.TextSourceExampleTranslation:
+			query.Translations = true
+		default:
+			return nil, sharederrors.ErrInvalidParameter.WithDetails("invalid source: " + source)
+		}
+	}
+
+	language, err := h.languageRepo.FindLanguageByID(ctx, input.LanguageID)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	query.LanguageCode = language.Code
+
+	hits, total, err := h.textSearchRepo.SearchText(ctx, query)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	return &SearchTextOutput{Hits: hits, Total: total}, nil
+}
@@ -0,0 +1,10 @@
+package search_text
+
+// SearchTextInput represents the input for searching definitions and example sentences.
+type SearchTextInput struct {
+	Query      string
+	LanguageID int16    // language the searched text is written in
+	Sources    []string // definition, example, example_translation; empty means all
+	Limit      int
+	Offset     int
+}
@@ -0,0 +1,9 @@
+package search_text
+
+import "github.com/english-coach/backend/internal/modules/dictionary/domain"
+
+// SearchTextOutput represents one page of text search hits.
+type SearchTextOutput struct {
+	Hits  []*domain.TextSearchHit
+	Total int
+}
@@ -0,0 +1,67 @@
+package suggest_words
+
+import (
+	"context"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/constants"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler provides dictionary autocomplete suggestions
+type Handler struct {
+	wordRepo domain.WordRepository
+	index    *PrefixIndex
+	logger   logger.ILogger
+}
+
+// NewHandler creates a new suggest words handler
+func NewHandler(
+	wordRepo domain.WordRepository,
+	index *PrefixIndex,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		wordRepo: wordRepo,
+		index:    index,
+		logger:   logger,
+	}
+}
+
+// StartIndex builds the prefix index in the background and keeps it in sync with the words table
+func (h *Handler) StartIndex() {
+	h.index.start(h.wordRepo)
+}
+
+// Execute returns the top prefix matches for the query, from the in-memory index when it is ready
+// and from the database otherwise
+func (h *Handler) Execute(ctx context.Context, input SuggestWordsInput) (*SuggestWordsOutput, error) {
+	limit := input.Limit
+	if limit <= 0 {
+		limit = constants.DefaultSuggestLimit
+	}
+	if limit > constants.MaxSuggestLimit {
+		limit = constants.MaxSuggestLimit
+	}
+
+	if suggestions, ok := h.index.lookup(input.Query, input.LanguageID, limit); ok {
+		return &SuggestWordsOutput{Suggestions: suggestions, Source: SourceIndex}, nil
+	}
+
+	start := time.Now()
+	suggestions, err := h.wordRepo.SuggestWords(ctx, input.Query, input.LanguageID, limit)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	h.logger.Debug("suggest served from database",
+		logger.String("query", input.Query),
+		logger.Int("language_id", int(input.LanguageID)),
+		logger.Int("count", len(suggestions)),
+		logger.Duration("duration", time.Since(start)),
+	)
+
+	return &SuggestWordsOutput{Suggestions: suggestions, Source: SourceDatabase}, nil
+}
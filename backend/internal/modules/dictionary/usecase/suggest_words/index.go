@@ -0,0 +1,370 @@
+package suggest_words
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/shared/logger"
+	"github.com/english-coach/backend/internal/shared/normalize"
+)
+
+// defaultRefreshInterval is used when the configured refresh interval is not positive
+const defaultRefreshInterval = time.Minute
+
+// IndexConfig holds prefix index configuration
+type IndexConfig struct {
+	Enabled         bool
+	RefreshInterval time.Duration // How often the words table is checked for changes
+}
+
+// Match field priorities, lower is more relevant
+const (
+	fieldLemma uint8 = iota
+	fieldSearchKey
+	fieldRomanization
+)
+
+var fieldNames = [...]string{
+	fieldLemma:        domain.SuggestMatchLemma,
+	fieldSearchKey:    domain.SuggestMatchSearchKey,
+	fieldRomanization: domain.SuggestMatchRomanization,
+}
+
+// indexKey is one normalized form of a word field, pointing back at its entry
+type indexKey struct {
+	key   string
+	entry int32
+	field uint8
+}
+
+// languageIndex holds the sorted keys and entries of one language
+type languageIndex struct {
+	keys    []indexKey
+	entries []*domain.WordSuggestEntry
+}
+
+// indexSnapshot is an immutable index built from one read of the words table
+type indexSnapshot struct {
+	version   domain.WordsVersion
+	languages map[int16]*languageIndex
+}
+
+// wordsSource loads what the index is built from
+type wordsSource interface {
+	FindWordSuggestEntries(ctx context.Context) ([]*domain.WordSuggestEntry, error)
+	GetWordsVersion(ctx context.Context) (*domain.WordsVersion, error)
+}
+
+// PrefixIndex serves word suggestions from memory. It is built in the background at startup
+// and rebuilt whenever the words table changes; until the first build completes, lookups miss.
+type PrefixIndex struct {
+	cfg     IndexConfig
+	scripts domain.ScriptConverter // optional, lets traditional queries reach simplified keys
+	logger  logger.ILogger
+
+	snapshot atomic.Pointer[indexSnapshot]
+	stale    atomic.Bool
+	buildMu  sync.Mutex
+
+	wake   chan struct{}
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewPrefixIndex creates a new, empty prefix index. scripts may be nil.
+func NewPrefixIndex(cfg IndexConfig, scripts domain.ScriptConverter, logger logger.ILogger) *PrefixIndex {
+	return &PrefixIndex{
+		cfg:     cfg,
+		scripts: scripts,
+		logger:  logger,
+		wake:    make(chan struct{}, 1),
+	}
+}
+
+// simplifier returns the script converter for normalization, or nil when none is set
+func (p *PrefixIndex) simplifier() normalize.Simplifier {
+	if p.scripts == nil {
+		return nil
+	}
+	return p.scripts
+}
+
+// start builds the index and keeps it fresh until Stop is called
+func (p *PrefixIndex) start(source wordsSource) {
+	if p == nil || !p.cfg.Enabled || p.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	p.done = make(chan struct{})
+
+	go func() {
+		defer close(p.done)
+
+		interval := p.cfg.RefreshInterval
+		if interval <= 0 {
+			interval = defaultRefreshInterval
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		p.refresh(ctx, source)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-p.wake:
+			}
+			p.refresh(ctx, source)
+		}
+	}()
+
+	p.logger.Info("suggest index started",
+		logger.Duration("refresh_interval", p.cfg.RefreshInterval),
+	)
+}
+
+// Stop stops the background refresher and waits for it to exit
+func (p *PrefixIndex) Stop() {
+	if p == nil || p.cancel == nil {
+		return
+	}
+	p.cancel()
+	<-p.done
+	p.cancel = nil
+}
+
+// Invalidate forces a rebuild on the next refresh and wakes the refresher.
+// Callers that change words should call it so suggestions catch up without waiting for the ticker.
+func (p *PrefixIndex) Invalidate() {
+	if p == nil || !p.cfg.Enabled {
+		return
+	}
+	p.stale.Store(true)
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Ready reports whether lookups are served from memory
+func (p *PrefixIndex) Ready() bool {
+	return p != nil && p.snapshot.Load() != nil
+}
+
+// refresh rebuilds the index if it was invalidated or the words table changed since the last build
+func (p *PrefixIndex) refresh(ctx context.Context, source wordsSource) {
+	p.buildMu.Lock()
+	defer p.buildMu.Unlock()
+
+	version, err := source.GetWordsVersion(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			p.logger.Warn("suggest index version check failed", logger.Error(err))
+		}
+		return
+	}
+
+	current := p.snapshot.Load()
+	if current != nil && !p.stale.Load() && current.version == *version {
+		return
+	}
+	p.stale.Store(false)
+
+	start := time.Now()
+	entries, err := source.FindWordSuggestEntries(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			p.logger.Warn("suggest index build failed", logger.Error(err))
+		}
+		return
+	}
+
+	snapshot := buildSnapshot(entries, *version, p.simplifier())
+	p.snapshot.Store(snapshot)
+
+	keyCount := 0
+	for _, lang := range snapshot.languages {
+		keyCount += len(lang.keys)
+	}
+	p.logger.Info("suggest index built",
+		logger.Int("words", len(entries)),
+		logger.Int("keys", keyCount),
+		logger.Duration("duration", time.Since(start)),
+	)
+}
+
+// buildSnapshot indexes every normalized form of each entry's lemma, search key and romanization.
+// Lemmas are also indexed in simplified script so traditional queries, simplified by lookup, find them.
+func buildSnapshot(entries []*domain.WordSuggestEntry, version domain.WordsVersion, scripts normalize.Simplifier) *indexSnapshot {
+	snapshot := &indexSnapshot{
+		version:   version,
+		languages: make(map[int16]*languageIndex),
+	}
+
+	for _, entry := range entries {
+		lang := snapshot.languages[entry.LanguageID]
+		if lang == nil {
+			lang = &languageIndex{}
+			snapshot.languages[entry.LanguageID] = lang
+		}
+		idx := int32(len(lang.entries))
+		lang.entries = append(lang.entries, entry)
+
+		seen := make(map[string]bool)
+		add := func(key string, field uint8) {
+			if key == "" || seen[key] {
+				return
+			}
+			seen[key] = true
+			lang.keys = append(lang.keys, indexKey{key: key, entry: idx, field: field})
+		}
+
+		add(normalize.Text(entry.Lemma), fieldLemma)
+		add(normalize.Fold(entry.Lemma), fieldLemma)
+		add(normalize.Toneless(entry.Lemma), fieldLemma)
+		if scripts != nil {
+			add(scripts.ToSimplified(normalize.Fold(entry.Lemma)), fieldLemma)
+		}
+		for _, key := range []*string{entry.LemmaNormalized, entry.SearchKey} {
+			if key != nil {
+				add(normalize.Text(*key), fieldSearchKey)
+				add(normalize.Toneless(*key), fieldSearchKey)
+			}
+		}
+		if entry.Romanization != nil {
+			add(normalize.Text(*entry.Romanization), fieldRomanization)
+			add(normalize.Fold(*entry.Romanization), fieldRomanization)
+			add(normalize.Toneless(*entry.Romanization), fieldRomanization)
+			add(normalize.Compact(normalize.PinyinToNumbered(*entry.Romanization)), fieldRomanization)
+		}
+	}
+
+	for _, lang := range snapshot.languages {
+		sort.Slice(lang.keys, func(i, j int) bool {
+			return lang.keys[i].key < lang.keys[j].key
+		})
+	}
+
+	return snapshot
+}
+
+// candidate is an entry matched by a lookup with its best match
+type candidate struct {
+	entry *domain.WordSuggestEntry
+	field uint8
+	exact bool
+}
+
+// lookup returns up to limit entries whose keys start with any form of the query.
+// ok is false when the index has not been built yet.
+func (p *PrefixIndex) lookup(query string, languageID int16, limit int) ([]*domain.WordSuggestion, bool) {
+	if p == nil {
+		return nil, false
+	}
+	snapshot := p.snapshot.Load()
+	if snapshot == nil {
+		return nil, false
+	}
+
+	lang := snapshot.languages[languageID]
+	forms := normalize.Query(query, p.simplifier())
+	if lang == nil || forms.Text == "" || forms.Folded == "" {
+		return []*domain.WordSuggestion{}, true
+	}
+
+	best := make(map[int32]*candidate)
+	for _, prefix := range uniqueForms(forms) {
+		from := sort.Search(len(lang.keys), func(i int) bool {
+			return lang.keys[i].key >= prefix
+		})
+		for i := from; i < len(lang.keys) && strings.HasPrefix(lang.keys[i].key, prefix); i++ {
+			k := lang.keys[i]
+			exact := k.key == prefix
+			c, ok := best[k.entry]
+			if !ok {
+				best[k.entry] = &candidate{entry: lang.entries[k.entry], field: k.field, exact: exact}
+				continue
+			}
+			if (exact && !c.exact) || (exact == c.exact && k.field < c.field) {
+				c.field = k.field
+				c.exact = exact
+			}
+		}
+	}
+
+	candidates := make([]*candidate, 0, len(best))
+	for _, c := range best {
+		candidates = append(candidates, c)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.exact != b.exact {
+			return a.exact
+		}
+		if a.field != b.field {
+			return a.field < b.field
+		}
+		if ra, rb := frequencyOrder(a.entry), frequencyOrder(b.entry); ra != rb {
+			return ra < rb
+		}
+		if la, lb := len(a.entry.Lemma), len(b.entry.Lemma); la != lb {
+			return la < lb
+		}
+		return a.entry.ID < b.entry.ID
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	suggestions := make([]*domain.WordSuggestion, 0, len(candidates))
+	for _, c := range candidates {
+		suggestions = append(suggestions, &domain.WordSuggestion{
+			WordID:        c.entry.ID,
+			LanguageID:    c.entry.LanguageID,
+			Lemma:         c.entry.Lemma,
+			Romanization:  c.entry.Romanization,
+			FrequencyRank: c.entry.FrequencyRank,
+			MatchedOn:     fieldNames[c.field],
+		})
+	}
+
+	return suggestions, true
+}
+
+// uniqueForms returns the distinct non-empty query forms to look up
+func uniqueForms(forms normalize.QueryForms) []string {
+	out := make([]string, 0, 4)
+	for _, f := range []string{forms.Text, forms.Folded, forms.Numbered, forms.Toneless} {
+		if f == "" {
+			continue
+		}
+		dup := false
+		for _, o := range out {
+			if o == f {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// frequencyOrder sorts words without a frequency rank last
+func frequencyOrder(e *domain.WordSuggestEntry) int {
+	if e.FrequencyRank == nil {
+		return math.MaxInt
+	}
+	return *e.FrequencyRank
+}
@@ -0,0 +1,8 @@
+package suggest_words
+
+// SuggestWordsInput represents the input for the word suggestion use case.
+type SuggestWordsInput struct {
+	Query      string
+	LanguageID int16
+	Limit      int
+}
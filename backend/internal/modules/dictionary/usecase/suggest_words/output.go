@@ -0,0 +1,15 @@
+package suggest_words
+
+import "github.com/english-coach/backend/internal/modules/dictionary/domain"
+
+// Suggestion sources
+const (
+	SourceIndex    = "index"    // served from the in-memory prefix index
+	SourceDatabase = "database" // index not ready, served by a database query
+)
+
+// SuggestWordsOutput represents the prefix matches for a query.
+type SuggestWordsOutput struct {
+	Suggestions []*domain.WordSuggestion
+	Source      string
+}
@@ -0,0 +1,171 @@
+package word_revisions
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+)
+
+// diffSnapshots compares two snapshots field by field. Both are compared in their JSON form, so
+// paths use the JSON field names of WordJSON.
+func diffSnapshots(from, to *domain.WordJSON) ([]domain.RevisionChange, error) {
+	oldValue, err := toJSONValue(from)
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := toJSONValue(to)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []domain.RevisionChange{}
+	diffValues("", oldValue, newValue, &changes)
+	return changes, nil
+}
+
+// toJSONValue converts a snapshot to generic maps, slices and scalars
+func toJSONValue(snapshot *domain.WordJSON) (any, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("encode snapshot: %w", err)
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("decode snapshot: %w", err)
+	}
+	return value, nil
+}
+
+// diffValues appends the changes turning oldValue into newValue at path
+func diffValues(path string, oldValue, newValue any, changes *[]domain.RevisionChange) {
+	switch {
+	case oldValue == nil && newValue == nil:
+		return
+	case oldValue == nil:
+		*changes = append(*changes, domain.RevisionChange{Path: path, Kind: domain.RevisionChangeAdded, New: newValue})
+		return
+	case newValue == nil:
+		*changes = append(*changes, domain.RevisionChange{Path: path, Kind: domain.RevisionChangeRemoved, Old: oldValue})
+		return
+	}
+
+	oldMap, oldIsMap := oldValue.(map[string]any)
+	newMap, newIsMap := newValue.(map[string]any)
+	if oldIsMap && newIsMap {
+		diffObjects(path, oldMap, newMap, changes)
+		return
+	}
+	oldList, oldIsList := oldValue.([]any)
+	newList, newIsList := newValue.([]any)
+	if oldIsList && newIsList {
+		diffLists(path, oldList, newList, changes)
+		return
+	}
+
+	if !reflect.DeepEqual(oldValue, newValue) {
+		*changes = append(*changes, domain.RevisionChange{Path: path, Kind: domain.RevisionChangeChanged, Old: oldValue, New: newValue})
+	}
+}
+
+// diffObjects compares the fields of two objects in name order
+func diffObjects(path string, oldMap, newMap map[string]any, changes *[]domain.RevisionChange) {
+	names := make([]string, 0, len(oldMap)+len(newMap))
+	for name := range oldMap {
+		names = append(names, name)
+	}
+	for name := range newMap {
+		if _, ok := oldMap[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fieldPath := name
+		if path != "" {
+			fieldPath = path + "." + name
+		}
+		diffValues(fieldPath, oldMap[name], newMap[name], changes)
+	}
+}
+
+// diffLists pairs list items by their natural key, falling back to their position when the items
+// have none or it is not unique. Lists of scalars, like topics, are paired by value.
+func diffLists(path string, oldList, newList []any, changes *[]domain.RevisionChange) {
+	oldKeys, oldKeyed := itemKeys(oldList)
+	newKeys, newKeyed := itemKeys(newList)
+	if !oldKeyed || !newKeyed {
+		oldKeys, newKeys = indexKeys(len(oldList)), indexKeys(len(newList))
+	}
+
+	newByKey := make(map[string]any, len(newList))
+	for i, key := range newKeys {
+		newByKey[key] = newList[i]
+	}
+	oldByKey := make(map[string]bool, len(oldList))
+	for i, key := range oldKeys {
+		oldByKey[key] = true
+		diffValues(fmt.Sprintf("%s[%s]", path, key), oldList[i], newByKey[key], changes)
+	}
+	for i, key := range newKeys {
+		if !oldByKey[key] {
+			diffValues(fmt.Sprintf("%s[%s]", path, key), nil, newList[i], changes)
+		}
+	}
+}
+
+// itemKeys returns the natural key of every item, or false when an item has none or keys repeat
+func itemKeys(list []any) ([]string, bool) {
+	keys := make([]string, len(list))
+	seen := make(map[string]bool, len(list))
+	for i, item := range list {
+		key := itemKey(item)
+		if key == "" || seen[key] {
+			return nil, false
+		}
+		seen[key] = true
+		keys[i] = key
+	}
+	return keys, true
+}
+
+// itemKey identifies a list item: senses by order, pronunciations by dialect, translations and
+// relations by their target word, example translations by language and scalars by value
+func itemKey(item any) string {
+	object, ok := item.(map[string]any)
+	if !ok {
+		return fmt.Sprint(item)
+	}
+	if order, ok := object["order"]; ok {
+		return fmt.Sprintf("order=%v", order)
+	}
+	if dialect, ok := object["dialect"]; ok {
+		return fmt.Sprintf("dialect=%v", dialect)
+	}
+	if target, ok := object["target_word"].(map[string]any); ok {
+		key := fmt.Sprintf("%v:%v", target["language"], target["lemma"])
+		if relationType, ok := object["relation_type"]; ok {
+			key = fmt.Sprintf("%v %s", relationType, key)
+		}
+		return key
+	}
+	if language, ok := object["language"]; ok {
+		return fmt.Sprintf("language=%v", language)
+	}
+	return ""
+}
+
+// indexKeys keys list items by position
+func indexKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = fmt.Sprint(i)
+	}
+	return keys
+}
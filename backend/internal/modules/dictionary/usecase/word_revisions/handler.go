@@ -0,0 +1,81 @@
+package word_revisions
+
+import (
+	"context"
+
+	"github.com/english-coach/backend/internal/modules/dictionary/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler reads the revision history of words. Restoring a revision is an edit and lives in the
+// edit_dictionary use case.
+type Handler struct {
+	revisionRepo domain.WordRevisionRepository
+	logger       logger.ILogger
+}
+
+// NewHandler creates a new word revision handler
+func NewHandler(revisionRepo domain.WordRevisionRepository, logger logger.ILogger) *Handler {
+	return &Handler{
+		revisionRepo: revisionRepo,
+		logger:       logger,
+	}
+}
+
+// ListRevisions returns one page of a word's revisions, newest first. Revisions of deleted words
+// stay listed so they can be restored.
+func (h *Handler) ListRevisions(ctx context.Context, input ListRevisionsInput) (*ListRevisionsOutput, error) {
+	items, total, err := h.revisionRepo.FindWordRevisions(ctx, input.WordID, input.Limit, input.Offset)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	return &ListRevisionsOutput{Items: items, Total: total}, nil
+}
+
+// GetRevision returns one revision of a word with the snapshot of the whole entry
+func (h *Handler) GetRevision(ctx context.Context, input GetRevisionInput) (*domain.WordRevision, error) {
+	if input.Revision <= 0 {
+		return nil, sharederrors.ErrInvalidParameter.WithDetails("revision must be positive")
+	}
+
+	revision, err := h.revisionRepo.FindWordRevision(ctx, input.WordID, input.Revision)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	return revision, nil
+}
+
+// DiffRevisions lists the changes between the snapshots of two revisions of a word
+func (h *Handler) DiffRevisions(ctx context.Context, input DiffRevisionsInput) (*DiffRevisionsOutput, error) {
+	if input.From <= 0 || input.To <= 0 {
+		return nil, sharederrors.ErrInvalidParameter.WithDetails("from and to must be positive revisions")
+	}
+
+	from, err := h.revisionRepo.FindWordRevision(ctx, input.WordID, input.From)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	to, err := h.revisionRepo.FindWordRevision(ctx, input.WordID, input.To)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	changes, err := diffSnapshots(from.Snapshot, to.Snapshot)
+	if err != nil {
+		h.logger.Error("failed to diff word revisions",
+			logger.Int64("word_id", input.WordID),
+			logger.Int("from", input.From),
+			logger.Int("to", input.To),
+			logger.Error(err),
+		)
+		return nil, sharederrors.ErrInternalError
+	}
+
+	return &DiffRevisionsOutput{
+		WordID:  input.WordID,
+		From:    input.From,
+		To:      input.To,
+		Changes: changes,
+	}, nil
+}
@@ -0,0 +1,21 @@
+package word_revisions
+
+// ListRevisionsInput represents the input for listing the revisions of a word
+type ListRevisionsInput struct {
+	WordID int64
+	Limit  int
+	Offset int
+}
+
+// GetRevisionInput represents the input for loading one revision of a word
+type GetRevisionInput struct {
+	WordID   int64
+	Revision int
+}
+
+// DiffRevisionsInput represents the input for comparing two revisions of a word
+type DiffRevisionsInput struct {
+	WordID int64
+	From   int
+	To     int
+}
@@ -0,0 +1,17 @@
+package word_revisions
+
+import "github.com/english-coach/backend/internal/modules/dictionary/domain"
+
+// ListRevisionsOutput represents one page of a word's revision history
+type ListRevisionsOutput struct {
+	Items []*domain.WordRevision
+	Total int
+}
+
+// DiffRevisionsOutput lists the changes made between two revisions of a word
+type DiffRevisionsOutput struct {
+	WordID  int64                   `json:"word_id"`
+	From    int                     `json:"from"`
+	To      int                     `json:"to"`
+	Changes []domain.RevisionChange `json:"changes"`
+}
@@ -28,32 +28,36 @@ type LoginResponse struct {
 	UserID   int64   `json:"user_id"`
 	Email    *string `json:"email,omitempty"`
 	Username *string `json:"username,omitempty"`
+	Role     string  `json:"role"`
 }
 
 // UpdateProfileRequest represents the request body for updating user profile
 type UpdateProfileRequest struct {
-	DisplayName *string `json:"display_name,omitempty" binding:"omitempty,max=100"`
-	AvatarURL   *string `json:"avatar_url,omitempty" binding:"omitempty,url,max=500"`
-	BirthDay    *string `json:"birth_day,omitempty" binding:"omitempty,datetime=2006-01-02"`
-	Bio         *string `json:"bio,omitempty"`
+	DisplayName     *string `json:"display_name,omitempty" binding:"omitempty,max=100"`
+	AvatarURL       *string `json:"avatar_url,omitempty" binding:"omitempty,url,max=500"`
+	BirthDay        *string `json:"birth_day,omitempty" binding:"omitempty,datetime=2006-01-02"`
+	Bio             *string `json:"bio,omitempty"`
+	PreferredScript *string `json:"preferred_script,omitempty" binding:"omitempty,oneof=simplified traditional"`
 }
 
 // UserProfileResponse represents the user profile response body
 type UserProfileResponse struct {
-	UserID      int64   `json:"user_id"`
-	DisplayName *string `json:"display_name,omitempty"`
-	AvatarURL   *string `json:"avatar_url,omitempty"`
-	BirthDay    *string `json:"birth_day,omitempty"`
-	Bio         *string `json:"bio,omitempty"`
+	UserID          int64   `json:"user_id"`
+	DisplayName     *string `json:"display_name,omitempty"`
+	AvatarURL       *string `json:"avatar_url,omitempty"`
+	BirthDay        *string `json:"birth_day,omitempty"`
+	Bio             *string `json:"bio,omitempty"`
+	PreferredScript *string `json:"preferred_script,omitempty"`
 }
 
 // UpdateProfileResponse represents the response body for updating user profile
 type UpdateProfileResponse struct {
-	UserID      int64   `json:"user_id"`
-	DisplayName *string `json:"display_name,omitempty"`
-	AvatarURL   *string `json:"avatar_url,omitempty"`
-	BirthDay    *string `json:"birth_day,omitempty"`
-	Bio         *string `json:"bio,omitempty"`
+	UserID          int64   `json:"user_id"`
+	DisplayName     *string `json:"display_name,omitempty"`
+	AvatarURL       *string `json:"avatar_url,omitempty"`
+	BirthDay        *string `json:"birth_day,omitempty"`
+	Bio             *string `json:"bio,omitempty"`
+	PreferredScript *string `json:"preferred_script,omitempty"`
 }
 
 // CheckEmailAvailabilityResponse represents the response for email availability check
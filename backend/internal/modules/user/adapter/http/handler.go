@@ -115,6 +115,7 @@ func (h *Handler) Login(c *gin.Context) {
 		UserID:   result.UserID,
 		Email:    result.Email,
 		Username: result.Username,
+		Role:     result.Role,
 	}
 
 	response.Success(c, http.StatusOK, resp)
@@ -150,11 +151,12 @@ func (h *Handler) GetProfile(c *gin.Context) {
 	}
 
 	resp := UserProfileResponse{
-		UserID:      profile.UserID,
-		DisplayName: profile.DisplayName,
-		AvatarURL:   profile.AvatarURL,
-		BirthDay:    profile.BirthDay,
-		Bio:         profile.Bio,
+		UserID:          profile.UserID,
+		DisplayName:     profile.DisplayName,
+		AvatarURL:       profile.AvatarURL,
+		BirthDay:        profile.BirthDay,
+		Bio:             profile.Bio,
+		PreferredScript: profile.PreferredScript,
 	}
 
 	response.Success(c, http.StatusOK, resp)
@@ -194,10 +196,11 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 	}
 
 	result, err := h.updateProfileUC.Execute(ctx, userIDInt64, userupdateprofile.UpdateProfileInput{
-		DisplayName: req.DisplayName,
-		AvatarURL:   req.AvatarURL,
-		BirthDay:    req.BirthDay,
-		Bio:         req.Bio,
+		DisplayName:     req.DisplayName,
+		AvatarURL:       req.AvatarURL,
+		BirthDay:        req.BirthDay,
+		Bio:             req.Bio,
+		PreferredScript: req.PreferredScript,
 	})
 
 	if err != nil {
@@ -206,11 +209,12 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 	}
 
 	resp := UpdateProfileResponse{
-		UserID:      result.UserID,
-		DisplayName: result.DisplayName,
-		AvatarURL:   result.AvatarURL,
-		BirthDay:    result.BirthDay,
-		Bio:         result.Bio,
+		UserID:          result.UserID,
+		DisplayName:     result.DisplayName,
+		AvatarURL:       result.AvatarURL,
+		BirthDay:        result.BirthDay,
+		Bio:             result.Bio,
+		PreferredScript: result.PreferredScript,
 	}
 
 	response.Success(c, http.StatusOK, resp)
@@ -11,16 +11,31 @@ type User struct {
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 	IsActive     bool      `json:"is_active"`
+	Role         string    `json:"role"` // RoleLearner, RoleEditor or RoleAdmin
 }
 
+// User roles
+const (
+	RoleLearner = "learner"
+	RoleEditor  = "editor" // may edit dictionary content
+	RoleAdmin   = "admin"
+)
+
 // UserProfile represents extended user profile information
 type UserProfile struct {
-	UserID      int64      `json:"user_id"`
-	DisplayName *string    `json:"display_name,omitempty"`
-	AvatarURL   *string    `json:"avatar_url,omitempty"`
-	BirthDay    *time.Time `json:"birth_day,omitempty"`
-	Bio         *string    `json:"bio,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	UserID          int64      `json:"user_id"`
+	DisplayName     *string    `json:"display_name,omitempty"`
+	AvatarURL       *string    `json:"avatar_url,omitempty"`
+	BirthDay        *time.Time `json:"birth_day,omitempty"`
+	Bio             *string    `json:"bio,omitempty"`
+	PreferredScript *string    `json:"preferred_script,omitempty"` // ScriptSimplified or ScriptTraditional
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
 }
 
+// Preferred scripts for displaying Chinese text
+const (
+	ScriptSimplified  = "simplified"
+	ScriptTraditional = "traditional"
+)
+
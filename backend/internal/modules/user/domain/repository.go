@@ -30,6 +30,6 @@ type UserProfileRepository interface {
 	Create(ctx context.Context, userID int64, displayName *string, avatarURL *string, birthDay *string, bio *string) (*UserProfile, error)
 	// FindUserProfileByUserID returns a user profile by user ID
 	FindUserProfileByUserID(ctx context.Context, userID int64) (*UserProfile, error)
-	// Update updates a user profile
-	Update(ctx context.Context, userID int64, displayName *string, avatarURL *string, birthDay *string, bio *string) (*UserProfile, error)
+	// Update updates a user profile; nil fields are left unchanged
+	Update(ctx context.Context, userID int64, displayName *string, avatarURL *string, birthDay *string, bio *string, preferredScript *string) (*UserProfile, error)
 }
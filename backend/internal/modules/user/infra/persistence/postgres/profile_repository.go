@@ -66,7 +66,7 @@ func (r *userProfileRepository) FindUserProfileByUserID(ctx context.Context, use
 }
 
 // Update updates a user profile
-func (r *userProfileRepository) Update(ctx context.Context, userID int64, displayName *string, avatarURL *string, birthDay *string, bio *string) (*domain.UserProfile, error) {
+func (r *userProfileRepository) Update(ctx context.Context, userID int64, displayName *string, avatarURL *string, birthDay *string, bio *string, preferredScript *string) (*domain.UserProfile, error) {
 	var displayNamePg pgtype.Text
 	if displayName != nil && *displayName != "" {
 		displayNamePg = pgtype.Text{String: *displayName, Valid: true}
@@ -90,12 +90,18 @@ func (r *userProfileRepository) Update(ctx context.Context, userID int64, displa
 		bioPg = pgtype.Text{String: *bio, Valid: true}
 	}
 
+	var preferredScriptPg pgtype.Text
+	if preferredScript != nil && *preferredScript != "" {
+		preferredScriptPg = pgtype.Text{String: *preferredScript, Valid: true}
+	}
+
 	row, err := r.queries.UpdateUserProfile(ctx, db.UpdateUserProfileParams{
-		UserID:      userID,
-		DisplayName: displayNamePg,
-		AvatarUrl:   avatarURLPg,
-		BirthDay:    birthDayPg,
-		Bio:         bioPg,
+		UserID:          userID,
+		DisplayName:     displayNamePg,
+		AvatarUrl:       avatarURLPg,
+		BirthDay:        birthDayPg,
+		Bio:             bioPg,
+		PreferredScript: preferredScriptPg,
 	})
 	if err != nil {
 		return nil, sharederrors.MapUserRepositoryError(err, "Update")
@@ -110,6 +116,7 @@ func mapDBProfileToModel(row *db.UserProfile) *domain.UserProfile {
 	var avatarURL *string
 	var birthDay *time.Time
 	var bio *string
+	var preferredScript *string
 
 	if row.DisplayName.Valid {
 		displayName = &row.DisplayName.String
@@ -123,14 +130,18 @@ func mapDBProfileToModel(row *db.UserProfile) *domain.UserProfile {
 	if row.Bio.Valid {
 		bio = &row.Bio.String
 	}
+	if row.PreferredScript.Valid {
+		preferredScript = &row.PreferredScript.String
+	}
 
 	return &domain.UserProfile{
-		UserID:      row.UserID,
-		DisplayName: displayName,
-		AvatarURL:   avatarURL,
-		BirthDay:    birthDay,
-		Bio:         bio,
-		CreatedAt:   row.CreatedAt.Time,
-		UpdatedAt:   row.UpdatedAt.Time,
+		UserID:          row.UserID,
+		DisplayName:     displayName,
+		AvatarURL:       avatarURL,
+		BirthDay:        birthDay,
+		Bio:             bio,
+		PreferredScript: preferredScript,
+		CreatedAt:       row.CreatedAt.Time,
+		UpdatedAt:       row.UpdatedAt.Time,
 	}
 }
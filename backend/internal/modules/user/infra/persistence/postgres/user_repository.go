@@ -155,5 +155,6 @@ func mapDBUserToModel(row *db.User) *domain.User {
 		CreatedAt:    row.CreatedAt.Time,
 		UpdatedAt:    row.UpdatedAt.Time,
 		IsActive:     row.IsActive.Bool,
+		Role:         row.Role,
 	}
 }
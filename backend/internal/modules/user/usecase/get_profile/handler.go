@@ -40,10 +40,11 @@ func (h *Handler) Execute(ctx context.Context, input GetProfileInput) (*GetProfi
 	}
 
 	return &GetProfileOutput{
-		UserID:      profile.UserID,
-		DisplayName: profile.DisplayName,
-		AvatarURL:   profile.AvatarURL,
-		BirthDay:    birthDayStr,
-		Bio:         profile.Bio,
+		UserID:          profile.UserID,
+		DisplayName:     profile.DisplayName,
+		AvatarURL:       profile.AvatarURL,
+		BirthDay:        birthDayStr,
+		Bio:             profile.Bio,
+		PreferredScript: profile.PreferredScript,
 	}, nil
 }
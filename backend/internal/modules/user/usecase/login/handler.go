@@ -71,7 +71,7 @@ func (h *Handler) Execute(ctx context.Context, input LoginInput) (*LoginOutput,
 		username = *user.Email
 	}
 
-	token, err := h.jwtManager.GenerateToken(user.ID, username)
+	token, err := h.jwtManager.GenerateToken(user.ID, username, user.Role)
 	if err != nil {
 		return nil, sharederrors.MapDomainErrorToAppError(err)
 	}
@@ -81,5 +81,6 @@ func (h *Handler) Execute(ctx context.Context, input LoginInput) (*LoginOutput,
 		UserID:   user.ID,
 		Email:    user.Email,
 		Username: user.Username,
+		Role:     user.Role,
 	}, nil
 }
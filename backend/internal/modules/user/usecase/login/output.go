@@ -6,5 +6,6 @@ type LoginOutput struct {
 	UserID   int64
 	Email    *string
 	Username *string
+	Role     string
 }
 
@@ -23,7 +23,7 @@ func NewHandler(
 
 // Execute updates user profile
 func (h *Handler) Execute(ctx context.Context, userID int64, input UpdateProfileInput) (*UpdateProfileOutput, error) {
-	profile, err := h.profileRepo.Update(ctx, userID, input.DisplayName, input.AvatarURL, input.BirthDay, input.Bio)
+	profile, err := h.profileRepo.Update(ctx, userID, input.DisplayName, input.AvatarURL, input.BirthDay, input.Bio, input.PreferredScript)
 	if err != nil {
 		// Map domain error to AppError
 		return nil, sharederrors.MapDomainErrorToAppError(err)
@@ -36,10 +36,11 @@ func (h *Handler) Execute(ctx context.Context, userID int64, input UpdateProfile
 	}
 
 	return &UpdateProfileOutput{
-		UserID:      profile.UserID,
-		DisplayName: profile.DisplayName,
-		AvatarURL:   profile.AvatarURL,
-		BirthDay:    birthDayStr,
-		Bio:         profile.Bio,
+		UserID:          profile.UserID,
+		DisplayName:     profile.DisplayName,
+		AvatarURL:       profile.AvatarURL,
+		BirthDay:        birthDayStr,
+		Bio:             profile.Bio,
+		PreferredScript: profile.PreferredScript,
 	}, nil
 }
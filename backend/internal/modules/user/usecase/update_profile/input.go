@@ -2,9 +2,10 @@ package update_profile
 
 // UpdateProfileInput represents the input for updating user profile use case.
 type UpdateProfileInput struct {
-	DisplayName *string
-	AvatarURL   *string
-	BirthDay    *string // Format: YYYY-MM-DD
-	Bio         *string
+	DisplayName     *string
+	AvatarURL       *string
+	BirthDay        *string // Format: YYYY-MM-DD
+	Bio             *string
+	PreferredScript *string // "simplified" or "traditional"
 }
 
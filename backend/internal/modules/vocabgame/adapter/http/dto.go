@@ -20,7 +20,7 @@ type CreateSessionResponse struct {
 	Mode             string    `json:"mode"`
 	SourceLanguageID int16     `json:"source_language_id"`
 	TargetLanguageID int16     `json:"target_language_id"`
-	TopicID          *int64    `json:"topic_id,omitempty"`
+	TopicIDs         []int64   `json:"topic_ids"`
 	LevelID          *int64    `json:"level_id,omitempty"`
 	TotalQuestions   int16     `json:"total_questions"`
 	CorrectQuestions int16     `json:"correct_questions"`
@@ -47,6 +47,13 @@ type SubmitAnswerResponse struct {
 	AnsweredAt       time.Time `json:"answered_at"`
 }
 
+// ExportSessionsRequest represents the query parameters for exporting session history
+type ExportSessionsRequest struct {
+	Format string `form:"format"` // csv (default) or ndjson
+	From   string `form:"from"`   // inclusive, YYYY-MM-DD or RFC 3339
+	To     string `form:"to"`     // inclusive date or exclusive timestamp
+}
+
 // GetSessionRequest represents the path parameter for getting a session
 type GetSessionRequest struct {
 	SessionID int64 `uri:"sessionId" binding:"required"`
@@ -59,12 +66,13 @@ type GameSessionResponse struct {
 	Mode             string     `json:"mode"`
 	SourceLanguageID int16      `json:"source_language_id"`
 	TargetLanguageID int16      `json:"target_language_id"`
-	TopicID          *int64     `json:"topic_id,omitempty"`
+	TopicIDs         []int64    `json:"topic_ids"`
 	LevelID          *int64     `json:"level_id,omitempty"`
 	TotalQuestions   int16      `json:"total_questions"`
 	CorrectQuestions int16      `json:"correct_questions"`
 	StartedAt        time.Time  `json:"started_at"`
 	EndedAt          *time.Time `json:"ended_at,omitempty"`
+	FlaggedAt        *time.Time `json:"flagged_at,omitempty"` // set when the session failed integrity checks
 }
 
 // GameQuestionResponse represents a vocabgame question for HTTP response
@@ -103,7 +111,74 @@ type GetSessionResponse struct {
 	Questions []QuestionWithOptions `json:"questions"`
 }
 
+// ReviewOptionResponse represents an option in a session review
+type ReviewOptionResponse struct {
+	ID           int64  `json:"id"`
+	OptionLabel  string `json:"option_label"`
+	TargetWordID int64  `json:"target_word_id"`
+	WordText     string `json:"word_text"`
+}
+
+// ReviewSenseResponse represents the main sense of a reviewed question's source word
+type ReviewSenseResponse struct {
+	ID         int64  `json:"id"`
+	SenseOrder int16  `json:"sense_order"`
+	Definition string `json:"definition"`
+}
+
+// ReviewExampleTranslationResponse represents a translation of a review example
+type ReviewExampleTranslationResponse struct {
+	Language string `json:"language"`
+	Content  string `json:"content"`
+}
+
+// ReviewExampleResponse represents an example sentence of the main sense
+type ReviewExampleResponse struct {
+	ID           int64                              `json:"id"`
+	Content      string                             `json:"content"`
+	Translations []ReviewExampleTranslationResponse `json:"translations"`
+}
+
+// QuestionReviewResponse represents one reviewed question
+type QuestionReviewResponse struct {
+	QuestionID     int64                  `json:"question_id"`
+	QuestionOrder  int16                  `json:"question_order"`
+	QuestionType   string                 `json:"question_type"`
+	SourceWordID   int64                  `json:"source_word_id"`
+	SourceWordText string                 `json:"source_word_text"`
+	CorrectOption  *ReviewOptionResponse  `json:"correct_option"`
+	ChosenOption   *ReviewOptionResponse  `json:"chosen_option"` // null when the question was not answered
+	IsCorrect      bool                   `json:"is_correct"`
+	ResponseTimeMs *int                   `json:"response_time_ms,omitempty"`
+	AnsweredAt     *time.Time             `json:"answered_at,omitempty"`
+	Sense          *ReviewSenseResponse   `json:"sense,omitempty"`
+	Example        *ReviewExampleResponse `json:"example,omitempty"`
+}
+
+// GetSessionReviewResponse represents the review of an ended session
+type GetSessionReviewResponse struct {
+	Session   GameSessionResponse      `json:"session"`
+	Questions []QuestionReviewResponse `json:"questions"`
+}
+
 // ListSessionsResponse represents the response for listing sessions
 type ListSessionsResponse struct {
 	Sessions []GameSessionResponse `json:"sessions"`
-}
\ No newline at end of file
+}
+// QuestionPoolKeyResponse represents the ready question sets for one pool key
+type QuestionPoolKeyResponse struct {
+	SourceLanguageID int16 `json:"source_language_id"`
+	TargetLanguageID int16 `json:"target_language_id"`
+	LevelID          int64 `json:"level_id"`
+	TopicID          int64 `json:"topic_id"` // 0 means all topics
+	Available        int   `json:"available"`
+}
+
+// QuestionPoolStatsResponse represents question pool hit/miss metrics
+type QuestionPoolStatsResponse struct {
+	Enabled bool                      `json:"enabled"`
+	Hits    int64                     `json:"hits"`
+	Misses  int64                     `json:"misses"`
+	HitRate float64                   `json:"hit_rate"`
+	Pools   []QuestionPoolKeyResponse `json:"pools"`
+}
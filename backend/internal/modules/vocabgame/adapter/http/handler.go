@@ -1,12 +1,16 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	dictdomain "github.com/english-coach/backend/internal/modules/dictionary/domain"
 	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
 	gamecreatesession "github.com/english-coach/backend/internal/modules/vocabgame/usecase/create_session"
+	gameexportsessions "github.com/english-coach/backend/internal/modules/vocabgame/usecase/export_sessions"
+	gamegetsessionreview "github.com/english-coach/backend/internal/modules/vocabgame/usecase/get_session_review"
 	gamesubmitanswer "github.com/english-coach/backend/internal/modules/vocabgame/usecase/submit_answer"
 	sharederrors "github.com/english-coach/backend/internal/shared/errors"
 	"github.com/english-coach/backend/internal/shared/logger"
@@ -18,30 +22,36 @@ import (
 
 // Handler handles vocabgame-related HTTP requests
 type Handler struct {
-	createSessionUC *gamecreatesession.Handler
-	submitAnswerUC  *gamesubmitanswer.Handler
-	questionRepo    domain.GameQuestionRepository
-	sessionRepo     domain.GameSessionRepository
-	wordRepo        dictdomain.WordRepository
-	logger          logger.ILogger
+	createSessionUC    *gamecreatesession.Handler
+	submitAnswerUC     *gamesubmitanswer.Handler
+	getSessionReviewUC *gamegetsessionreview.Handler
+	exportSessionsUC   *gameexportsessions.Handler
+	questionRepo       domain.GameQuestionRepository
+	sessionRepo        domain.GameSessionRepository
+	wordRepo           dictdomain.WordRepository
+	logger             logger.ILogger
 }
 
 // NewHandler creates a new vocabgame handler
 func NewHandler(
 	createSessionUC *gamecreatesession.Handler,
 	submitAnswerUC *gamesubmitanswer.Handler,
+	getSessionReviewUC *gamegetsessionreview.Handler,
+	exportSessionsUC *gameexportsessions.Handler,
 	questionRepo domain.GameQuestionRepository,
 	sessionRepo domain.GameSessionRepository,
 	wordRepo dictdomain.WordRepository,
 	logger logger.ILogger,
 ) *Handler {
 	return &Handler{
-		createSessionUC: createSessionUC,
-		submitAnswerUC:  submitAnswerUC,
-		questionRepo:    questionRepo,
-		sessionRepo:     sessionRepo,
-		wordRepo:        wordRepo,
-		logger:          logger,
+		createSessionUC:    createSessionUC,
+		submitAnswerUC:     submitAnswerUC,
+		getSessionReviewUC: getSessionReviewUC,
+		exportSessionsUC:   exportSessionsUC,
+		questionRepo:       questionRepo,
+		sessionRepo:        sessionRepo,
+		wordRepo:           wordRepo,
+		logger:             logger,
 	}
 }
 
@@ -136,7 +146,7 @@ func (h *Handler) CreateSession(c *gin.Context) {
 		Mode:             session.Mode,
 		SourceLanguageID: session.SourceLanguageID,
 		TargetLanguageID: session.TargetLanguageID,
-		TopicID:          session.TopicID,
+		TopicIDs:         session.TopicIDs,
 		LevelID:          session.LevelID,
 		TotalQuestions:   session.TotalQuestions,
 		CorrectQuestions: session.CorrectQuestions,
@@ -228,12 +238,13 @@ func (h *Handler) ListSessions(c *gin.Context) {
 			Mode:             session.Mode,
 			SourceLanguageID: session.SourceLanguageID,
 			TargetLanguageID: session.TargetLanguageID,
-			TopicID:          session.TopicID,
+			TopicIDs:         session.TopicIDs,
 			LevelID:          session.LevelID,
 			TotalQuestions:   session.TotalQuestions,
 			CorrectQuestions: session.CorrectQuestions,
 			StartedAt:        session.StartedAt,
 			EndedAt:          session.EndedAt,
+			FlaggedAt:        session.FlaggedAt,
 		})
 	}
 
@@ -344,11 +355,12 @@ func (h *Handler) GetSession(c *gin.Context) {
 		Mode:             session.Mode,
 		SourceLanguageID: session.SourceLanguageID,
 		TargetLanguageID: session.TargetLanguageID,
-		TopicID:          session.TopicID,
+		TopicIDs:         session.TopicIDs,
 		LevelID:          session.LevelID,
 		TotalQuestions:   session.TotalQuestions,
 		CorrectQuestions: session.CorrectQuestions,
 		StartedAt:        session.StartedAt,
+		FlaggedAt:        session.FlaggedAt,
 	}
 	if session.EndedAt != nil {
 		sessionResp.EndedAt = session.EndedAt
@@ -479,3 +491,267 @@ func (h *Handler) SubmitAnswer(c *gin.Context) {
 
 	response.Success(c, http.StatusCreated, resp)
 }
+
+// GetSessionReview handles GET /api/v1/vocabgames/sessions/{sessionId}/review
+func (h *Handler) GetSessionReview(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req GetSessionRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.ErrorResponse(c, http.StatusBadRequest,
+			"INVALID_PARAMETER",
+			"ID phiên chơi không hợp lệ",
+			nil,
+		)
+		return
+	}
+
+	// Get user ID
+	userID, exists := c.Get("user_id")
+	if !exists {
+		userID = int64(1)
+	}
+
+	var userIDInt64 int64
+	switch v := userID.(type) {
+	case int64:
+		userIDInt64 = v
+	case int:
+		userIDInt64 = int64(v)
+	case string:
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			userIDInt64 = 1
+		} else {
+			userIDInt64 = parsed
+		}
+	default:
+		userIDInt64 = 1
+	}
+
+	output, err := h.getSessionReviewUC.Execute(ctx, gamegetsessionreview.GetSessionReviewInput{
+		SessionID: req.SessionID,
+		UserID:    userIDInt64,
+	})
+	if err != nil {
+		middleware.SetError(c, err)
+		return
+	}
+
+	session := output.Session
+	sessionResp := GameSessionResponse{
+		ID:               session.ID,
+		UserID:           session.UserID,
+		Mode:             session.Mode,
+		SourceLanguageID: session.SourceLanguageID,
+		TargetLanguageID: session.TargetLanguageID,
+		TopicIDs:         session.TopicIDs,
+		LevelID:          session.LevelID,
+		TotalQuestions:   session.TotalQuestions,
+		CorrectQuestions: session.CorrectQuestions,
+		StartedAt:        session.StartedAt,
+		EndedAt:          session.EndedAt,
+		FlaggedAt:        session.FlaggedAt,
+	}
+
+	questions := make([]QuestionReviewResponse, 0, len(output.Questions))
+	for _, review := range output.Questions {
+		q := review.Question
+		item := QuestionReviewResponse{
+			QuestionID:     q.ID,
+			QuestionOrder:  q.QuestionOrder,
+			QuestionType:   q.QuestionType,
+			SourceWordID:   q.SourceWordID,
+			CorrectOption:  toReviewOptionResponse(review.CorrectOption),
+			ChosenOption:   toReviewOptionResponse(review.ChosenOption),
+			IsCorrect:      review.IsCorrect,
+			ResponseTimeMs: review.ResponseTimeMs,
+			AnsweredAt:     review.AnsweredAt,
+		}
+		if review.SourceWord != nil {
+			item.SourceWordText = review.SourceWord.Lemma
+		}
+		if review.MainSense != nil {
+			item.Sense = &ReviewSenseResponse{
+				ID:         review.MainSense.ID,
+				SenseOrder: review.MainSense.SenseOrder,
+				Definition: review.MainSense.Definition,
+			}
+		}
+		if review.Example != nil {
+			translations := make([]ReviewExampleTranslationResponse, 0, len(review.Example.Translations))
+			for _, t := range review.Example.Translations {
+				translations = append(translations, ReviewExampleTranslationResponse{
+					Language: t.Language,
+					Content:  t.Content,
+				})
+			}
+			item.Example = &ReviewExampleResponse{
+				ID:           review.Example.ID,
+				Content:      review.Example.Content,
+				Translations: translations,
+			}
+		}
+		questions = append(questions, item)
+	}
+
+	response.Success(c, http.StatusOK, GetSessionReviewResponse{
+		Session:   sessionResp,
+		Questions: questions,
+	})
+}
+
+// toReviewOptionResponse maps a reviewed option to its response DTO
+func toReviewOptionResponse(option *gamegetsessionreview.OptionReview) *ReviewOptionResponse {
+	if option == nil {
+		return nil
+	}
+	resp := &ReviewOptionResponse{
+		ID:           option.Option.ID,
+		OptionLabel:  option.Option.OptionLabel,
+		TargetWordID: option.Option.TargetWordID,
+	}
+	if option.Word != nil {
+		resp.WordText = option.Word.Lemma
+	}
+	return resp
+}
+
+// ExportSessions handles GET /api/v1/vocabgames/sessions/export
+func (h *Handler) ExportSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// Get user ID
+	userID, exists := c.Get("user_id")
+	if !exists {
+		userID = int64(1)
+	}
+
+	var userIDInt64 int64
+	switch v := userID.(type) {
+	case int64:
+		userIDInt64 = v
+	case int:
+		userIDInt64 = int64(v)
+	case string:
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			userIDInt64 = 1
+		} else {
+			userIDInt64 = parsed
+		}
+	default:
+		userIDInt64 = 1
+	}
+
+	var req ExportSessionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidRequest.WithDetails(err.Error()))
+		return
+	}
+	if req.Format == "" {
+		req.Format = gameexportsessions.FormatCSV
+	}
+
+	from, err := parseExportDate(req.From, false)
+	if err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("from: "+err.Error()))
+		return
+	}
+	to, err := parseExportDate(req.To, true)
+	if err != nil {
+		middleware.SetError(c, sharederrors.ErrInvalidParameter.WithDetails("to: "+err.Error()))
+		return
+	}
+
+	// Get request logger from context (includes request ID)
+	requestLogger, _ := c.Get("logger")
+	var appLogger logger.ILogger
+	if reqLogger, ok := requestLogger.(logger.ILogger); ok {
+		appLogger = reqLogger
+	} else {
+		appLogger = h.logger
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if req.Format == gameexportsessions.FormatNDJSON {
+		contentType = "application/x-ndjson"
+	}
+	filename := fmt.Sprintf("vocabgame-sessions-%d.%s", userIDInt64, req.Format)
+	stream := response.NewExportStream(c, contentType, filename)
+
+	// Large exports may outlive the server write timeout
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	_, err = h.exportSessionsUC.Execute(ctx, gameexportsessions.ExportSessionsInput{
+		UserID: userIDInt64,
+		Format: req.Format,
+		From:   from,
+		To:     to,
+	}, stream)
+	if err != nil {
+		if !stream.Started() {
+			middleware.SetError(c, err)
+			return
+		}
+		// Headers are already sent; the truncated body is all the client can get
+		appLogger.Error("vocabgame session export aborted",
+			logger.Error(err),
+			logger.Int64("user_id", userIDInt64),
+		)
+		c.Abort()
+		return
+	}
+
+	if err := stream.Close(); err != nil {
+		appLogger.Error("failed to finish vocabgame session export",
+			logger.Error(err),
+			logger.Int64("user_id", userIDInt64),
+		)
+	}
+}
+
+// parseExportDate parses a YYYY-MM-DD date or an RFC 3339 timestamp.
+// A date-only upper bound covers the whole day, so it is moved to the next midnight.
+func parseExportDate(value string, upper bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		if upper {
+			t = t.AddDate(0, 0, 1)
+		}
+		return &t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("expected YYYY-MM-DD or RFC 3339 timestamp")
+	}
+	// Session times are stored as local wall-clock timestamps
+	t = t.In(time.Local)
+	return &t, nil
+}
+
+// GetPoolStats handles GET /api/v1/vocabgames/pool/stats
+func (h *Handler) GetPoolStats(c *gin.Context) {
+	stats := h.createSessionUC.PoolStats()
+
+	pools := make([]QuestionPoolKeyResponse, 0, len(stats.Pools))
+	for _, pool := range stats.Pools {
+		pools = append(pools, QuestionPoolKeyResponse{
+			SourceLanguageID: pool.SourceLanguageID,
+			TargetLanguageID: pool.TargetLanguageID,
+			LevelID:          pool.LevelID,
+			TopicID:          pool.TopicID,
+			Available:        pool.Available,
+		})
+	}
+
+	response.Success(c, http.StatusOK, QuestionPoolStatsResponse{
+		Enabled: stats.Enabled,
+		Hits:    stats.Hits,
+		Misses:  stats.Misses,
+		HitRate: stats.HitRate,
+		Pools:   pools,
+	})
+}
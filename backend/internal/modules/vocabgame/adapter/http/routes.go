@@ -5,7 +5,7 @@ import (
 )
 
 // RegisterRoutes registers vocabgame-related HTTP routes
-func RegisterRoutes(router *gin.RouterGroup, handler *Handler, authMiddleware gin.HandlerFunc) {
+func RegisterRoutes(router *gin.RouterGroup, handler *Handler, authMiddleware, adminMiddleware gin.HandlerFunc) {
 	// VocabGame routes: /api/v1/vocabgames/... (protected - requires login)
 	vocabGameGroup := router.Group("/vocabgames")
 	vocabGameGroup.Use(authMiddleware)
@@ -14,8 +14,13 @@ func RegisterRoutes(router *gin.RouterGroup, handler *Handler, authMiddleware gi
 		{
 			sessionsGroup.POST("", handler.CreateSession)
 			sessionsGroup.GET("", handler.ListSessions) // Must be before /:sessionId to avoid route conflict
+			sessionsGroup.GET("/export", handler.ExportSessions)
 			sessionsGroup.GET("/:sessionId", handler.GetSession)
 			sessionsGroup.POST("/:sessionId/answers", handler.SubmitAnswer)
+			sessionsGroup.GET("/:sessionId/review", handler.GetSessionReview)
 		}
+
+		// Operational metrics (requires the admin role)
+		vocabGameGroup.GET("/pool/stats", adminMiddleware, handler.GetPoolStats)
 	}
 }
@@ -7,6 +7,7 @@ var (
 	ErrInsufficientWords      = errors.New("Insufficient words available")
 	ErrSessionNotFound        = errors.New("Session not found")
 	ErrSessionEnded           = errors.New("Session has ended")
+	ErrSessionNotEnded        = errors.New("Session has not ended yet")
 	ErrQuestionNotFound       = errors.New("Question not found")
 	ErrQuestionNotInSession   = errors.New("Question does not belong to this session")
 	ErrOptionNotFound         = errors.New("Option not found")
@@ -14,4 +15,6 @@ var (
 	ErrInvalidMode            = errors.New("Invalid mode")
 	ErrSessionNotOwned        = errors.New("Session is not owned by this user")
 	ErrTranslationNotFound    = errors.New("Translation not found")
+	ErrInvalidExportFormat    = errors.New("Invalid export format")
+	ErrInvalidExportRange     = errors.New("Invalid export date range")
 )
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 // GameSessionRepository defines operations for vocabgame session data access
@@ -28,6 +29,8 @@ type GameQuestionRepository interface {
 	FindGameQuestionsBySessionID(ctx context.Context, sessionID int64) ([]*GameQuestion, error)
 	// FindGameQuestionByID returns a question by ID with its options
 	FindGameQuestionByID(ctx context.Context, questionID int64) (*GameQuestion, error)
+	// MarkGameQuestionsServed sets served_at on all not yet served questions of a session
+	MarkGameQuestionsServed(ctx context.Context, sessionID int64, servedAt time.Time) error
 }
 
 // GameAnswerRepository defines operations for vocabgame answer data access
@@ -39,3 +42,17 @@ type GameAnswerRepository interface {
 	// FindGameAnswersBySessionID returns all answers for a session
 	FindGameAnswersBySessionID(ctx context.Context, sessionID, userID int64) ([]*GameAnswer, error)
 }
+
+// GameSessionFlagRepository defines operations for vocabgame integrity flag data access
+type GameSessionFlagRepository interface {
+	// CreateFlags stores the flags and marks the session as flagged in a transaction
+	CreateFlags(ctx context.Context, sessionID int64, flags []*GameSessionFlag) error
+	// FindFlagsBySessionID returns all flags recorded for a session
+	FindFlagsBySessionID(ctx context.Context, sessionID int64) ([]*GameSessionFlag, error)
+}
+
+// GameSessionExportRepository defines cursor-based reads for exporting session history
+type GameSessionExportRepository interface {
+	// FindExportRows returns up to limit rows after the cursor, ordered by session ID and question order
+	FindExportRows(ctx context.Context, filter GameSessionExportFilter, after GameSessionExportCursor, limit int) ([]*GameSessionExportRow, error)
+}
@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+// GameSessionExportFilter selects the sessions included in a history export.
+// StartedFrom is inclusive and StartedTo is exclusive; nil bounds are open.
+type GameSessionExportFilter struct {
+	UserID      int64
+	StartedFrom *time.Time
+	StartedTo   *time.Time
+}
+
+// GameSessionExportCursor is the position of the last exported row.
+// The zero value starts from the beginning.
+type GameSessionExportCursor struct {
+	SessionID     int64
+	QuestionOrder int16
+}
+
+// GameSessionExportRow is one question of a session joined with its answer, if any
+type GameSessionExportRow struct {
+	SessionID           int64
+	Mode                string
+	SourceLanguageID    int16
+	TargetLanguageID    int16
+	LevelID             *int64
+	TopicIDs            []int64
+	TotalQuestions      int16
+	CorrectQuestions    int16
+	StartedAt           time.Time
+	EndedAt             *time.Time
+	FlaggedAt           *time.Time
+	QuestionID          int64
+	QuestionOrder       int16
+	QuestionType        string
+	SourceWordID        int64
+	SourceWord          string
+	CorrectTargetWordID int64
+	CorrectWord         string
+	Answered            bool
+	SelectedOptionLabel *string
+	SelectedWordID      *int64
+	SelectedWord        *string
+	IsCorrect           bool
+	ResponseTimeMs      *int
+	AnsweredAt          *time.Time
+}
+
+// Cursor returns the cursor that resumes the export after this row
+func (r *GameSessionExportRow) Cursor() GameSessionExportCursor {
+	return GameSessionExportCursor{SessionID: r.SessionID, QuestionOrder: r.QuestionOrder}
+}
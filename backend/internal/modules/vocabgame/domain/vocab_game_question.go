@@ -14,6 +14,7 @@ type GameQuestion struct {
 	SourceLanguageID    int16                 `json:"source_language_id"`
 	TargetLanguageID    int16                 `json:"target_language_id"`
 	CreatedAt           time.Time             `json:"created_at"`
+	ServedAt            *time.Time            `json:"served_at,omitempty"` // when the question was handed out with its session
 	Options             []*GameQuestionOption `json:"options"`
 }
 
@@ -9,11 +9,12 @@ type GameSession struct {
 	Mode            string    `json:"mode"` // 'level' or 'topic'
 	SourceLanguageID int16    `json:"source_language_id"`
 	TargetLanguageID int16   `json:"target_language_id"`
-	TopicID         *int64   `json:"topic_id,omitempty"`
+	TopicIDs        []int64  `json:"topic_ids"` // empty means all topics
 	LevelID         *int64   `json:"level_id,omitempty"`
 	TotalQuestions  int16    `json:"total_questions"`
 	CorrectQuestions int16   `json:"correct_questions"`
 	StartedAt       time.Time `json:"started_at"`
 	EndedAt         *time.Time `json:"ended_at,omitempty"`
+	FlaggedAt       *time.Time `json:"flagged_at,omitempty"` // set when an integrity check fails
 }
 
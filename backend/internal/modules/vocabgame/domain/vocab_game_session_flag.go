@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// Integrity flag reasons recorded against a vocabgame session
+const (
+	FlagReasonTooFast            = "too_fast"             // answered faster than humanly plausible
+	FlagReasonOutOfOrder         = "out_of_order"         // answered a question before an earlier unanswered one
+	FlagReasonUnservedQuestion   = "unserved_question"    // answered a question that was never served
+	FlagReasonBurst              = "burst"                // too many answers in a short window
+	FlagReasonClientTimeMismatch = "client_time_mismatch" // client-reported time exceeds server elapsed time
+)
+
+// GameSessionFlag records why a session failed an integrity check.
+// Flagged sessions are excluded from leaderboards and statistics.
+type GameSessionFlag struct {
+	ID         int64     `json:"id"`
+	SessionID  int64     `json:"session_id"`
+	QuestionID *int64    `json:"question_id,omitempty"`
+	Reason     string    `json:"reason"`
+	Detail     *string   `json:"detail,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -26,16 +26,25 @@ func (r *gameAnswerRepository) Create(ctx context.Context, answer *domain.GameAn
 	if answer.ResponseTimeMs != nil {
 		responseTimeMs = pgtype.Int4{Int32: int32(*answer.ResponseTimeMs), Valid: true}
 	}
-	answeredAt := pgtype.Timestamp{Time: time.Now(), Valid: true}
+	var clientResponseTimeMs pgtype.Int4
+	if answer.ClientResponseTimeMs != nil {
+		clientResponseTimeMs = pgtype.Int4{Int32: int32(*answer.ClientResponseTimeMs), Valid: true}
+	}
+	answeredTime := answer.AnsweredAt
+	if answeredTime.IsZero() {
+		answeredTime = time.Now()
+	}
+	answeredAt := pgtype.Timestamp{Time: answeredTime, Valid: true}
 
 	result, err := r.queries.CreateGameAnswer(ctx, db.CreateGameAnswerParams{
-		QuestionID:       answer.QuestionID,
-		SessionID:        answer.SessionID,
-		UserID:           answer.UserID,
-		SelectedOptionID: selectedOptionID,
-		IsCorrect:        answer.IsCorrect,
-		ResponseTimeMs:   responseTimeMs,
-		AnsweredAt:       answeredAt,
+		QuestionID:           answer.QuestionID,
+		SessionID:            answer.SessionID,
+		UserID:               answer.UserID,
+		SelectedOptionID:     selectedOptionID,
+		IsCorrect:            answer.IsCorrect,
+		ResponseTimeMs:       responseTimeMs,
+		ClientResponseTimeMs: clientResponseTimeMs,
+		AnsweredAt:           answeredAt,
 	})
 	if err != nil {
 		return sharederrors.MapVocabGameRepositoryError(err, "Create")
@@ -58,7 +67,7 @@ func (r *gameAnswerRepository) FindGameAnswerByQuestionID(ctx context.Context, q
 	}
 
 	var selectedOptionID *int64
-	var responseTimeMs *int
+	var responseTimeMs, clientResponseTimeMs *int
 
 	if row.SelectedOptionID.Valid {
 		val := row.SelectedOptionID.Int64
@@ -68,16 +77,21 @@ func (r *gameAnswerRepository) FindGameAnswerByQuestionID(ctx context.Context, q
 		val := int(row.ResponseTimeMs.Int32)
 		responseTimeMs = &val
 	}
+	if row.ClientResponseTimeMs.Valid {
+		val := int(row.ClientResponseTimeMs.Int32)
+		clientResponseTimeMs = &val
+	}
 
 	return &domain.GameAnswer{
-		ID:               row.ID,
-		QuestionID:       row.QuestionID,
-		SessionID:        row.SessionID,
-		UserID:           row.UserID,
-		SelectedOptionID: selectedOptionID,
-		IsCorrect:        row.IsCorrect,
-		ResponseTimeMs:   responseTimeMs,
-		AnsweredAt:       row.AnsweredAt.Time,
+		ID:                   row.ID,
+		QuestionID:           row.QuestionID,
+		SessionID:            row.SessionID,
+		UserID:               row.UserID,
+		SelectedOptionID:     selectedOptionID,
+		IsCorrect:            row.IsCorrect,
+		ResponseTimeMs:       responseTimeMs,
+		ClientResponseTimeMs: clientResponseTimeMs,
+		AnsweredAt:           row.AnsweredAt.Time,
 	}, nil
 }
 
@@ -94,7 +108,7 @@ func (r *gameAnswerRepository) FindGameAnswersBySessionID(ctx context.Context, s
 	answers := make([]*domain.GameAnswer, 0, len(rows))
 	for _, row := range rows {
 		var selectedOptionID *int64
-		var responseTimeMs *int
+		var responseTimeMs, clientResponseTimeMs *int
 
 		if row.SelectedOptionID.Valid {
 			val := row.SelectedOptionID.Int64
@@ -104,16 +118,21 @@ func (r *gameAnswerRepository) FindGameAnswersBySessionID(ctx context.Context, s
 			val := int(row.ResponseTimeMs.Int32)
 			responseTimeMs = &val
 		}
+		if row.ClientResponseTimeMs.Valid {
+			val := int(row.ClientResponseTimeMs.Int32)
+			clientResponseTimeMs = &val
+		}
 
 		answers = append(answers, &domain.GameAnswer{
-			ID:               row.ID,
-			QuestionID:       row.QuestionID,
-			SessionID:        row.SessionID,
-			UserID:           row.UserID,
-			SelectedOptionID: selectedOptionID,
-			IsCorrect:        row.IsCorrect,
-			ResponseTimeMs:   responseTimeMs,
-			AnsweredAt:       row.AnsweredAt.Time,
+			ID:                   row.ID,
+			QuestionID:           row.QuestionID,
+			SessionID:            row.SessionID,
+			UserID:               row.UserID,
+			SelectedOptionID:     selectedOptionID,
+			IsCorrect:            row.IsCorrect,
+			ResponseTimeMs:       responseTimeMs,
+			ClientResponseTimeMs: clientResponseTimeMs,
+			AnsweredAt:           row.AnsweredAt.Time,
 		})
 	}
 
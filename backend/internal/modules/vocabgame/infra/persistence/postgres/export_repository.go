@@ -0,0 +1,110 @@
+package vocabgame
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/game"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// gameSessionExportRepository implements GameSessionExportRepository using sqlc
+type gameSessionExportRepository struct {
+	*GameRepository
+}
+
+// FindExportRows returns up to limit rows after the cursor, ordered by session ID and question order
+func (r *gameSessionExportRepository) FindExportRows(ctx context.Context, filter domain.GameSessionExportFilter, after domain.GameSessionExportCursor, limit int) ([]*domain.GameSessionExportRow, error) {
+	var startedFrom, startedTo pgtype.Timestamp
+	if filter.StartedFrom != nil {
+		startedFrom = pgtype.Timestamp{Time: *filter.StartedFrom, Valid: true}
+	}
+	if filter.StartedTo != nil {
+		startedTo = pgtype.Timestamp{Time: *filter.StartedTo, Valid: true}
+	}
+
+	rows, err := r.queries.FindGameSessionExportRows(ctx, db.FindGameSessionExportRowsParams{
+		UserID:             filter.UserID,
+		StartedFrom:        startedFrom,
+		StartedTo:          startedTo,
+		AfterSessionID:     after.SessionID,
+		AfterQuestionOrder: after.QuestionOrder,
+		Limit:              int32(limit),
+	})
+	if err != nil {
+		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindExportRows")
+	}
+
+	result := make([]*domain.GameSessionExportRow, 0, len(rows))
+	for _, row := range rows {
+		item := &domain.GameSessionExportRow{
+			SessionID:           row.SessionID,
+			Mode:                row.Mode,
+			SourceLanguageID:    row.SourceLanguageID,
+			TargetLanguageID:    row.TargetLanguageID,
+			TopicIDs:            row.TopicIds,
+			StartedAt:           row.StartedAt.Time,
+			QuestionID:          row.QuestionID,
+			QuestionOrder:       row.QuestionOrder,
+			QuestionType:        row.QuestionType,
+			SourceWordID:        row.SourceWordID,
+			SourceWord:          row.SourceWord,
+			CorrectTargetWordID: row.CorrectTargetWordID,
+			CorrectWord:         row.CorrectWord,
+		}
+
+		if item.TopicIDs == nil {
+			item.TopicIDs = []int64{}
+		}
+		if row.LevelID.Valid {
+			val := row.LevelID.Int64
+			item.LevelID = &val
+		}
+		if row.TotalQuestions.Valid {
+			item.TotalQuestions = row.TotalQuestions.Int16
+		}
+		if row.CorrectQuestions.Valid {
+			item.CorrectQuestions = row.CorrectQuestions.Int16
+		}
+		if row.EndedAt.Valid {
+			val := row.EndedAt.Time
+			item.EndedAt = &val
+		}
+		if row.FlaggedAt.Valid {
+			val := row.FlaggedAt.Time
+			item.FlaggedAt = &val
+		}
+
+		// is_correct is NOT NULL on answers, so a valid value means the question was answered
+		if row.IsCorrect.Valid {
+			item.Answered = true
+			item.IsCorrect = row.IsCorrect.Bool
+		}
+		if row.SelectedOptionLabel.Valid {
+			val := row.SelectedOptionLabel.String
+			item.SelectedOptionLabel = &val
+		}
+		if row.SelectedWordID.Valid {
+			val := row.SelectedWordID.Int64
+			item.SelectedWordID = &val
+		}
+		if row.SelectedWord.Valid {
+			val := row.SelectedWord.String
+			item.SelectedWord = &val
+		}
+		if row.ResponseTimeMs.Valid {
+			val := int(row.ResponseTimeMs.Int32)
+			item.ResponseTimeMs = &val
+		}
+		if row.AnsweredAt.Valid {
+			val := row.AnsweredAt.Time
+			item.AnsweredAt = &val
+		}
+
+		result = append(result, item)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,105 @@
+package vocabgame
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	db "github.com/english-coach/backend/internal/platform/db/sqlc/gen/game"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+)
+
+// gameSessionFlagRepository implements GameSessionFlagRepository using sqlc
+type gameSessionFlagRepository struct {
+	*GameRepository
+}
+
+// CreateFlags stores the flags and marks the session as flagged in a transaction
+func (r *gameSessionFlagRepository) CreateFlags(ctx context.Context, sessionID int64, flags []*domain.GameSessionFlag) error {
+	if len(flags) == 0 {
+		return nil
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return sharederrors.MapVocabGameRepositoryError(err, "CreateFlags")
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	now := pgtype.Timestamp{Time: time.Now(), Valid: true}
+
+	for _, flag := range flags {
+		var questionID pgtype.Int8
+		if flag.QuestionID != nil {
+			questionID = pgtype.Int8{Int64: *flag.QuestionID, Valid: true}
+		}
+		var detail pgtype.Text
+		if flag.Detail != nil {
+			detail = pgtype.Text{String: *flag.Detail, Valid: true}
+		}
+
+		result, err := qtx.CreateGameSessionFlag(ctx, db.CreateGameSessionFlagParams{
+			SessionID:  sessionID,
+			QuestionID: questionID,
+			Reason:     flag.Reason,
+			Detail:     detail,
+			CreatedAt:  now,
+		})
+		if err != nil {
+			return sharederrors.MapVocabGameRepositoryError(err, "CreateFlags")
+		}
+		flag.ID = result.ID
+		flag.SessionID = sessionID
+		flag.CreatedAt = result.CreatedAt.Time
+	}
+
+	// Only the first flag sets flagged_at; later flags keep the original time
+	if err := qtx.FlagGameSession(ctx, db.FlagGameSessionParams{
+		ID:        sessionID,
+		FlaggedAt: now,
+	}); err != nil {
+		return sharederrors.MapVocabGameRepositoryError(err, "CreateFlags")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return sharederrors.MapVocabGameRepositoryError(err, "CreateFlags")
+	}
+	return nil
+}
+
+// FindFlagsBySessionID returns all flags recorded for a session
+func (r *gameSessionFlagRepository) FindFlagsBySessionID(ctx context.Context, sessionID int64) ([]*domain.GameSessionFlag, error) {
+	rows, err := r.queries.FindGameSessionFlagsBySessionID(ctx, sessionID)
+	if err != nil {
+		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindFlagsBySessionID")
+	}
+
+	flags := make([]*domain.GameSessionFlag, 0, len(rows))
+	for _, row := range rows {
+		var questionID *int64
+		var detail *string
+
+		if row.QuestionID.Valid {
+			val := row.QuestionID.Int64
+			questionID = &val
+		}
+		if row.Detail.Valid {
+			val := row.Detail.String
+			detail = &val
+		}
+
+		flags = append(flags, &domain.GameSessionFlag{
+			ID:         row.ID,
+			SessionID:  row.SessionID,
+			QuestionID: questionID,
+			Reason:     row.Reason,
+			Detail:     detail,
+			CreatedAt:  row.CreatedAt.Time,
+		})
+	}
+
+	return flags, nil
+}
@@ -41,3 +41,17 @@ func (r *GameRepository) GameAnswerRepository() domain.GameAnswerRepository {
 		GameRepository: r,
 	}
 }
+
+// GameSessionFlagRepository returns a GameSessionFlagRepository implementation
+func (r *GameRepository) GameSessionFlagRepository() domain.GameSessionFlagRepository {
+	return &gameSessionFlagRepository{
+		GameRepository: r,
+	}
+}
+
+// GameSessionExportRepository returns a GameSessionExportRepository implementation
+func (r *GameRepository) GameSessionExportRepository() domain.GameSessionExportRepository {
+	return &gameSessionExportRepository{
+		GameRepository: r,
+	}
+}
@@ -107,10 +107,14 @@ func (r *gameQuestionRepository) FindGameQuestionsBySessionID(ctx context.Contex
 	questionIDs := make([]int64, 0, len(questionRows))
 	for _, row := range questionRows {
 		var sourceSenseID *int64
+		var servedAt *time.Time
 		if row.SourceSenseID.Valid {
 			val := row.SourceSenseID.Int64
 			sourceSenseID = &val
 		}
+		if row.ServedAt.Valid {
+			servedAt = &row.ServedAt.Time
+		}
 
 		question := &domain.GameQuestion{
 			ID:                  row.ID,
@@ -123,6 +127,7 @@ func (r *gameQuestionRepository) FindGameQuestionsBySessionID(ctx context.Contex
 			SourceLanguageID:    row.SourceLanguageID,
 			TargetLanguageID:    row.TargetLanguageID,
 			CreatedAt:           row.CreatedAt.Time,
+			ServedAt:            servedAt,
 			Options:             []*domain.GameQuestionOption{},
 		}
 		questions = append(questions, question)
@@ -169,10 +174,14 @@ func (r *gameQuestionRepository) FindGameQuestionByID(ctx context.Context, quest
 	}
 
 	var sourceSenseID *int64
+	var servedAt *time.Time
 	if questionRow.SourceSenseID.Valid {
 		val := questionRow.SourceSenseID.Int64
 		sourceSenseID = &val
 	}
+	if questionRow.ServedAt.Valid {
+		servedAt = &questionRow.ServedAt.Time
+	}
 
 	question := &domain.GameQuestion{
 		ID:                  questionRow.ID,
@@ -185,6 +194,7 @@ func (r *gameQuestionRepository) FindGameQuestionByID(ctx context.Context, quest
 		SourceLanguageID:    questionRow.SourceLanguageID,
 		TargetLanguageID:    questionRow.TargetLanguageID,
 		CreatedAt:           questionRow.CreatedAt.Time,
+		ServedAt:            servedAt,
 		Options:             []*domain.GameQuestionOption{},
 	}
 
@@ -208,3 +218,12 @@ func (r *gameQuestionRepository) FindGameQuestionByID(ctx context.Context, quest
 
 	return question, nil
 }
+
+// MarkGameQuestionsServed sets served_at on all not yet served questions of a session
+func (r *gameQuestionRepository) MarkGameQuestionsServed(ctx context.Context, sessionID int64, servedAt time.Time) error {
+	err := r.queries.MarkGameQuestionsServed(ctx, db.MarkGameQuestionsServedParams{
+		SessionID: sessionID,
+		ServedAt:  pgtype.Timestamp{Time: servedAt, Valid: true},
+	})
+	return sharederrors.MapVocabGameRepositoryError(err, "MarkGameQuestionsServed")
+}
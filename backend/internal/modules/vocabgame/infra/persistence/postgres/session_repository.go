@@ -18,10 +18,7 @@ type gameSessionRepository struct {
 
 // Create creates a new vocabgame session
 func (r *gameSessionRepository) Create(ctx context.Context, session *domain.GameSession) error {
-	var topicID, levelID pgtype.Int8
-	if session.TopicID != nil {
-		topicID = pgtype.Int8{Int64: *session.TopicID, Valid: true}
-	}
+	var levelID pgtype.Int8
 	if session.LevelID != nil {
 		levelID = pgtype.Int8{Int64: *session.LevelID, Valid: true}
 	}
@@ -30,12 +27,19 @@ func (r *gameSessionRepository) Create(ctx context.Context, session *domain.Game
 	correctQuestions := pgtype.Int2{Int16: session.CorrectQuestions, Valid: true}
 	startedAt := pgtype.Timestamp{Time: time.Now(), Valid: true}
 
-	result, err := r.queries.CreateGameSession(ctx, db.CreateGameSessionParams{
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return sharederrors.MapVocabGameRepositoryError(err, "Create")
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	result, err := qtx.CreateGameSession(ctx, db.CreateGameSessionParams{
 		UserID:           session.UserID,
 		Mode:             session.Mode,
 		SourceLanguageID: session.SourceLanguageID,
 		TargetLanguageID: session.TargetLanguageID,
-		TopicID:          topicID,
 		LevelID:          levelID,
 		TotalQuestions:   totalQuestions,
 		CorrectQuestions: correctQuestions,
@@ -45,6 +49,19 @@ func (r *gameSessionRepository) Create(ctx context.Context, session *domain.Game
 		return sharederrors.MapVocabGameRepositoryError(err, "Create")
 	}
 
+	if len(session.TopicIDs) > 0 {
+		if err := qtx.CreateGameSessionTopics(ctx, db.CreateGameSessionTopicsParams{
+			SessionID: result.ID,
+			TopicIds:  session.TopicIDs,
+		}); err != nil {
+			return sharederrors.MapVocabGameRepositoryError(err, "Create")
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return sharederrors.MapVocabGameRepositoryError(err, "Create")
+	}
+
 	session.ID = result.ID
 	session.StartedAt = result.StartedAt.Time
 	return nil
@@ -54,16 +71,12 @@ func (r *gameSessionRepository) Create(ctx context.Context, session *domain.Game
 func (r *gameSessionRepository) FindGameSessionByID(ctx context.Context, id int64) (*domain.GameSession, error) {
 	row, err := r.queries.FindGameSessionByID(ctx, id)
 	if err != nil {
-		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindSessionByID")
+		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindGameSessionByID")
 	}
 
-	var topicID, levelID *int64
-	var endedAt *time.Time
+	var levelID *int64
+	var endedAt, flaggedAt *time.Time
 
-	if row.TopicID.Valid {
-		val := row.TopicID.Int64
-		topicID = &val
-	}
 	if row.LevelID.Valid {
 		val := row.LevelID.Int64
 		levelID = &val
@@ -71,6 +84,14 @@ func (r *gameSessionRepository) FindGameSessionByID(ctx context.Context, id int6
 	if row.EndedAt.Valid {
 		endedAt = &row.EndedAt.Time
 	}
+	if row.FlaggedAt.Valid {
+		flaggedAt = &row.FlaggedAt.Time
+	}
+
+	topicIDs, err := r.findTopicIDsBySessionIDs(ctx, []int64{row.ID})
+	if err != nil {
+		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindGameSessionByID")
+	}
 
 	return &domain.GameSession{
 		ID:               row.ID,
@@ -78,12 +99,13 @@ func (r *gameSessionRepository) FindGameSessionByID(ctx context.Context, id int6
 		Mode:             row.Mode,
 		SourceLanguageID: row.SourceLanguageID,
 		TargetLanguageID: row.TargetLanguageID,
-		TopicID:          topicID,
+		TopicIDs:         topicIDsOrEmpty(topicIDs[row.ID]),
 		LevelID:          levelID,
 		TotalQuestions:   int16(row.TotalQuestions.Int16),
 		CorrectQuestions: int16(row.CorrectQuestions.Int16),
 		StartedAt:        row.StartedAt.Time,
 		EndedAt:          endedAt,
+		FlaggedAt:        flaggedAt,
 	}, nil
 }
 
@@ -116,15 +138,20 @@ func (r *gameSessionRepository) FindGameSessionsByUserID(ctx context.Context, us
 		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindGameSessionsByUserID")
 	}
 
+	sessionIDs := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		sessionIDs = append(sessionIDs, row.ID)
+	}
+	topicIDs, err := r.findTopicIDsBySessionIDs(ctx, sessionIDs)
+	if err != nil {
+		return nil, sharederrors.MapVocabGameRepositoryError(err, "FindGameSessionsByUserID")
+	}
+
 	sessions := make([]*domain.GameSession, 0, len(rows))
 	for _, row := range rows {
-		var topicID, levelID *int64
-		var endedAt *time.Time
+		var levelID *int64
+		var endedAt, flaggedAt *time.Time
 
-		if row.TopicID.Valid {
-			val := row.TopicID.Int64
-			topicID = &val
-		}
 		if row.LevelID.Valid {
 			val := row.LevelID.Int64
 			levelID = &val
@@ -132,6 +159,9 @@ func (r *gameSessionRepository) FindGameSessionsByUserID(ctx context.Context, us
 		if row.EndedAt.Valid {
 			endedAt = &row.EndedAt.Time
 		}
+		if row.FlaggedAt.Valid {
+			flaggedAt = &row.FlaggedAt.Time
+		}
 
 		sessions = append(sessions, &domain.GameSession{
 			ID:               row.ID,
@@ -139,12 +169,13 @@ func (r *gameSessionRepository) FindGameSessionsByUserID(ctx context.Context, us
 			Mode:             row.Mode,
 			SourceLanguageID: row.SourceLanguageID,
 			TargetLanguageID: row.TargetLanguageID,
-			TopicID:          topicID,
+			TopicIDs:         topicIDsOrEmpty(topicIDs[row.ID]),
 			LevelID:          levelID,
 			TotalQuestions:   int16(row.TotalQuestions.Int16),
 			CorrectQuestions: int16(row.CorrectQuestions.Int16),
 			StartedAt:        row.StartedAt.Time,
 			EndedAt:          endedAt,
+			FlaggedAt:        flaggedAt,
 		})
 	}
 
@@ -180,3 +211,28 @@ func (r *gameSessionRepository) EndSession(ctx context.Context, sessionID int64,
 	})
 	return sharederrors.MapVocabGameRepositoryError(err, "EndSession")
 }
+
+// findTopicIDsBySessionIDs returns the topic ids of each session, keyed by session id
+func (r *gameSessionRepository) findTopicIDsBySessionIDs(ctx context.Context, sessionIDs []int64) (map[int64][]int64, error) {
+	topicIDs := make(map[int64][]int64, len(sessionIDs))
+	if len(sessionIDs) == 0 {
+		return topicIDs, nil
+	}
+
+	rows, err := r.queries.FindGameSessionTopicsBySessionIDs(ctx, sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		topicIDs[row.SessionID] = append(topicIDs[row.SessionID], row.TopicID)
+	}
+	return topicIDs, nil
+}
+
+// topicIDsOrEmpty returns a non-nil slice so sessions without topics serialize as []
+func topicIDsOrEmpty(topicIDs []int64) []int64 {
+	if topicIDs == nil {
+		return []int64{}
+	}
+	return topicIDs
+}
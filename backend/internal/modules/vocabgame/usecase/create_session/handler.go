@@ -17,6 +17,7 @@ type Handler struct {
 	sessionRepo  domain.GameSessionRepository
 	questionRepo domain.GameQuestionRepository
 	wordRepo     dictdomain.WordRepository
+	pool         *QuestionPool
 	logger       logger.ILogger
 }
 
@@ -25,12 +26,14 @@ func NewHandler(
 	sessionRepo domain.GameSessionRepository,
 	questionRepo domain.GameQuestionRepository,
 	wordRepo dictdomain.WordRepository,
+	pool *QuestionPool,
 	logger logger.ILogger,
 ) *Handler {
 	return &Handler{
 		sessionRepo:  sessionRepo,
 		questionRepo: questionRepo,
 		wordRepo:     wordRepo,
+		pool:         pool,
 		logger:       logger,
 	}
 }
@@ -42,13 +45,8 @@ func (h *Handler) Execute(ctx context.Context, input CreateSessionInput, userID
 		return nil, sharederrors.ErrValidationError.WithDetails(err.Error())
 	}
 
-	// Create vocabgame session model
-	// Note: TopicID is kept for backward compatibility with DB schema, but we use TopicIDs array for filtering
-	var topicID *int64
-	if len(input.TopicIDs) > 0 {
-		// Store first topic ID for DB compatibility (schema still has single topic_id)
-		topicID = &input.TopicIDs[0]
-	}
+	// Create vocabgame session model with all selected topics
+	topicIDs := uniqueTopicIDs(input.TopicIDs)
 	levelID := &input.LevelID
 
 	session := &domain.GameSession{
@@ -56,7 +54,7 @@ func (h *Handler) Execute(ctx context.Context, input CreateSessionInput, userID
 		Mode:             input.Mode,
 		SourceLanguageID: input.SourceLanguageID,
 		TargetLanguageID: input.TargetLanguageID,
-		TopicID:          topicID,
+		TopicIDs:         topicIDs,
 		LevelID:          levelID,
 		TotalQuestions:   0, // Will be set when questions are generated
 		CorrectQuestions: 0,
@@ -73,17 +71,22 @@ func (h *Handler) Execute(ctx context.Context, input CreateSessionInput, userID
 		return nil, sharederrors.MapDomainErrorToAppError(err)
 	}
 
-	// Generate questions upfront - request up to MaxGameQuestionCount (20)
-	questions, options, err := h.generateQuestions(
-		ctx,
-		session.ID,
-		input.SourceLanguageID,
-		input.TargetLanguageID,
-		input.Mode,
-		input.TopicIDs,
-		input.LevelID,
-		constants.MaxGameQuestionCount,
-	)
+	// Draw questions from the pool, falling back to live generation on a miss
+	questions, options, fromPool := h.takeFromPool(session.ID, input)
+	var err error
+	if !fromPool {
+		// Generate questions upfront - request up to MaxGameQuestionCount (20)
+		questions, options, err = h.generateQuestions(
+			ctx,
+			session.ID,
+			input.SourceLanguageID,
+			input.TargetLanguageID,
+			input.Mode,
+			input.TopicIDs,
+			input.LevelID,
+			constants.MaxGameQuestionCount,
+		)
+	}
 	if err != nil {
 		h.logger.Error("failed to generate questions",
 			logger.Error(err),
@@ -121,6 +124,15 @@ func (h *Handler) Execute(ctx context.Context, input CreateSessionInput, userID
 		return nil, sharederrors.MapDomainErrorToAppError(err)
 	}
 
+	// The questions are handed out with the session, so their response time clock starts now
+	if err := h.questionRepo.MarkGameQuestionsServed(ctx, session.ID, time.Now()); err != nil {
+		h.logger.Error("failed to mark questions as served",
+			logger.Error(err),
+			logger.Int64("session_id", session.ID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
 	// Log session creation
 	h.logger.Info("vocabgame session created with questions",
 		logger.Int64("session_id", session.ID),
@@ -129,6 +141,7 @@ func (h *Handler) Execute(ctx context.Context, input CreateSessionInput, userID
 		logger.Int("source_language_id", int(input.SourceLanguageID)),
 		logger.Int("target_language_id", int(input.TargetLanguageID)),
 		logger.Int("question_count", len(questions)),
+		logger.Bool("from_pool", fromPool),
 	)
 
 	return &CreateSessionOutput{
@@ -137,7 +150,7 @@ func (h *Handler) Execute(ctx context.Context, input CreateSessionInput, userID
 		Mode:             session.Mode,
 		SourceLanguageID: session.SourceLanguageID,
 		TargetLanguageID: session.TargetLanguageID,
-		TopicID:          session.TopicID,
+		TopicIDs:         session.TopicIDs,
 		LevelID:          session.LevelID,
 		TotalQuestions:   session.TotalQuestions,
 		CorrectQuestions: session.CorrectQuestions,
@@ -146,6 +159,20 @@ func (h *Handler) Execute(ctx context.Context, input CreateSessionInput, userID
 	}, nil
 }
 
+// uniqueTopicIDs removes duplicate topic ids while keeping the requested order
+func uniqueTopicIDs(topicIDs []int64) []int64 {
+	seen := make(map[int64]bool, len(topicIDs))
+	unique := make([]int64, 0, len(topicIDs))
+	for _, topicID := range topicIDs {
+		if seen[topicID] {
+			continue
+		}
+		seen[topicID] = true
+		unique = append(unique, topicID)
+	}
+	return unique
+}
+
 // generateQuestions generates questions for a vocabgame session
 // This method encapsulates the question generation logic
 func (h *Handler) generateQuestions(
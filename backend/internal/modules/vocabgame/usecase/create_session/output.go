@@ -9,7 +9,7 @@ type CreateSessionOutput struct {
 	Mode             string
 	SourceLanguageID int16
 	TargetLanguageID int16
-	TopicID          *int64
+	TopicIDs         []int64
 	LevelID          *int64
 	TotalQuestions   int16
 	CorrectQuestions int16
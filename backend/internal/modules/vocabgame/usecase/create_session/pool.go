@@ -0,0 +1,278 @@
+package create_session
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// defaultRefillInterval is used when the configured refill interval is not positive
+const defaultRefillInterval = 30 * time.Second
+
+// PoolConfig holds question pool configuration
+type PoolConfig struct {
+	Enabled        bool
+	Size           int           // Question sets kept ready per pool key
+	RefillInterval time.Duration // How often the background generator tops up pools
+	RefillBatch    int           // Maximum sets generated per key on each refill
+	MaxKeys        int           // Maximum number of pool keys tracked
+}
+
+// PoolKey identifies a question pool. TopicID is 0 when the pool covers all topics.
+type PoolKey struct {
+	SourceLanguageID int16 `json:"source_language_id"`
+	TargetLanguageID int16 `json:"target_language_id"`
+	LevelID          int64 `json:"level_id"`
+	TopicID          int64 `json:"topic_id"`
+}
+
+// topicIDs returns the topic filter used to generate questions for the key
+func (k PoolKey) topicIDs() []int64 {
+	if k.TopicID == 0 {
+		return nil
+	}
+	return []int64{k.TopicID}
+}
+
+// pooledQuestion is a generated question with its four options, not yet bound to a session
+type pooledQuestion struct {
+	question *domain.GameQuestion
+	options  []*domain.GameQuestionOption
+}
+
+// questionSet is one session's worth of pre-generated questions
+type questionSet []pooledQuestion
+
+// questionSetGenerator generates a question set for a pool key
+type questionSetGenerator func(ctx context.Context, key PoolKey) (questionSet, error)
+
+// PoolKeyStats reports how many sets are ready for a pool key
+type PoolKeyStats struct {
+	PoolKey
+	Available int `json:"available"`
+}
+
+// PoolStats reports question pool hit/miss metrics
+type PoolStats struct {
+	Enabled bool           `json:"enabled"`
+	Hits    int64          `json:"hits"`
+	Misses  int64          `json:"misses"`
+	HitRate float64        `json:"hit_rate"`
+	Pools   []PoolKeyStats `json:"pools"`
+}
+
+// QuestionPool keeps pre-generated question sets per pool key and refills them in the background
+type QuestionPool struct {
+	cfg    PoolConfig
+	logger logger.ILogger
+
+	mu   sync.Mutex
+	sets map[PoolKey][]questionSet
+
+	hits   atomic.Int64
+	misses atomic.Int64
+
+	wake   chan struct{}
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewQuestionPool creates a new question pool
+func NewQuestionPool(cfg PoolConfig, logger logger.ILogger) *QuestionPool {
+	return &QuestionPool{
+		cfg:    cfg,
+		logger: logger,
+		sets:   make(map[PoolKey][]questionSet),
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// take pops one set from every key. It is a hit only if all keys have a set ready;
+// otherwise nothing is taken and the keys are registered for background refill.
+func (p *QuestionPool) take(keys []PoolKey) ([]questionSet, bool) {
+	if p == nil || !p.cfg.Enabled {
+		return nil, false
+	}
+
+	p.mu.Lock()
+	ready := true
+	for _, key := range keys {
+		if len(p.sets[key]) == 0 {
+			ready = false
+			p.register(key)
+		}
+	}
+	if !ready {
+		p.mu.Unlock()
+		p.misses.Add(1)
+		p.trigger()
+		return nil, false
+	}
+
+	taken := make([]questionSet, 0, len(keys))
+	for _, key := range keys {
+		sets := p.sets[key]
+		taken = append(taken, sets[0])
+		p.sets[key] = sets[1:]
+	}
+	p.mu.Unlock()
+
+	p.hits.Add(1)
+	p.trigger()
+	return taken, true
+}
+
+// register starts tracking a key. Callers must hold p.mu.
+func (p *QuestionPool) register(key PoolKey) {
+	if _, ok := p.sets[key]; ok {
+		return
+	}
+	if p.cfg.MaxKeys > 0 && len(p.sets) >= p.cfg.MaxKeys {
+		return
+	}
+	p.sets[key] = nil
+}
+
+// trigger wakes the background generator without blocking
+func (p *QuestionPool) trigger() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// start runs the background generator until Stop is called
+func (p *QuestionPool) start(generate questionSetGenerator) {
+	if p == nil || !p.cfg.Enabled || p.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	p.done = make(chan struct{})
+
+	go func() {
+		defer close(p.done)
+
+		interval := p.cfg.RefillInterval
+		if interval <= 0 {
+			interval = defaultRefillInterval
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-p.wake:
+			}
+			p.refill(ctx, generate)
+		}
+	}()
+
+	p.logger.Info("question pool started",
+		logger.Int("size", p.cfg.Size),
+		logger.Duration("refill_interval", p.cfg.RefillInterval),
+		logger.Int("refill_batch", p.cfg.RefillBatch),
+	)
+}
+
+// Stop stops the background generator and waits for it to exit
+func (p *QuestionPool) Stop() {
+	if p == nil || p.cancel == nil {
+		return
+	}
+	p.cancel()
+	<-p.done
+	p.cancel = nil
+}
+
+// refill tops up every tracked key that is below the configured size
+func (p *QuestionPool) refill(ctx context.Context, generate questionSetGenerator) {
+	p.mu.Lock()
+	keys := make([]PoolKey, 0, len(p.sets))
+	for key, sets := range p.sets {
+		if len(sets) < p.cfg.Size {
+			keys = append(keys, key)
+		}
+	}
+	p.mu.Unlock()
+
+	for _, key := range keys {
+		for i := 0; i < p.cfg.RefillBatch; i++ {
+			if ctx.Err() != nil {
+				return
+			}
+
+			set, err := generate(ctx, key)
+			if err != nil {
+				// Drop the key so it is only retried after the next miss
+				p.logger.Warn("question pool refill failed",
+					logger.Error(err),
+					logger.Any("pool_key", key),
+				)
+				p.mu.Lock()
+				delete(p.sets, key)
+				p.mu.Unlock()
+				break
+			}
+
+			p.mu.Lock()
+			full := false
+			if _, ok := p.sets[key]; ok {
+				p.sets[key] = append(p.sets[key], set)
+				full = len(p.sets[key]) >= p.cfg.Size
+			}
+			p.mu.Unlock()
+			if full {
+				break
+			}
+		}
+	}
+}
+
+// Stats returns the pool hit/miss metrics and the number of ready sets per key
+func (p *QuestionPool) Stats() PoolStats {
+	if p == nil {
+		return PoolStats{Pools: []PoolKeyStats{}}
+	}
+
+	stats := PoolStats{
+		Enabled: p.cfg.Enabled,
+		Hits:    p.hits.Load(),
+		Misses:  p.misses.Load(),
+		Pools:   []PoolKeyStats{},
+	}
+	if total := stats.Hits + stats.Misses; total > 0 {
+		stats.HitRate = float64(stats.Hits) / float64(total)
+	}
+
+	p.mu.Lock()
+	for key, sets := range p.sets {
+		stats.Pools = append(stats.Pools, PoolKeyStats{PoolKey: key, Available: len(sets)})
+	}
+	p.mu.Unlock()
+
+	sort.Slice(stats.Pools, func(i, j int) bool {
+		a, b := stats.Pools[i], stats.Pools[j]
+		if a.SourceLanguageID != b.SourceLanguageID {
+			return a.SourceLanguageID < b.SourceLanguageID
+		}
+		if a.TargetLanguageID != b.TargetLanguageID {
+			return a.TargetLanguageID < b.TargetLanguageID
+		}
+		if a.LevelID != b.LevelID {
+			return a.LevelID < b.LevelID
+		}
+		return a.TopicID < b.TopicID
+	})
+
+	return stats
+}
@@ -0,0 +1,122 @@
+package create_session
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	"github.com/english-coach/backend/internal/shared/constants"
+)
+
+// StartQuestionPool starts the background generator that keeps question pools filled
+func (h *Handler) StartQuestionPool() {
+	h.pool.start(h.generateQuestionSet)
+}
+
+// PoolStats returns the question pool hit/miss metrics
+func (h *Handler) PoolStats() PoolStats {
+	return h.pool.Stats()
+}
+
+// poolKeys returns the pool keys covering the requested topics
+func poolKeys(input CreateSessionInput) []PoolKey {
+	if len(input.TopicIDs) == 0 {
+		return []PoolKey{{
+			SourceLanguageID: input.SourceLanguageID,
+			TargetLanguageID: input.TargetLanguageID,
+			LevelID:          input.LevelID,
+		}}
+	}
+
+	topicIDs := uniqueTopicIDs(input.TopicIDs)
+	keys := make([]PoolKey, 0, len(topicIDs))
+	for _, topicID := range topicIDs {
+		keys = append(keys, PoolKey{
+			SourceLanguageID: input.SourceLanguageID,
+			TargetLanguageID: input.TargetLanguageID,
+			LevelID:          input.LevelID,
+			TopicID:          topicID,
+		})
+	}
+	return keys
+}
+
+// takeFromPool draws a question set per requested topic and merges them into one session.
+// It returns false on a pool miss so the caller can generate questions live.
+func (h *Handler) takeFromPool(sessionID int64, input CreateSessionInput) ([]*domain.GameQuestion, []*domain.GameQuestionOption, bool) {
+	sets, ok := h.pool.take(poolKeys(input))
+	if !ok {
+		return nil, nil, false
+	}
+
+	// Merge sets, skipping source words that appear in more than one topic
+	seen := make(map[int64]bool)
+	merged := make([]pooledQuestion, 0, constants.MaxGameQuestionCount)
+	for _, set := range sets {
+		for _, pq := range set {
+			if seen[pq.question.SourceWordID] {
+				continue
+			}
+			seen[pq.question.SourceWordID] = true
+			merged = append(merged, pq)
+		}
+	}
+	if len(merged) < constants.MinGameQuestionCount {
+		return nil, nil, false
+	}
+
+	if len(sets) > 1 {
+		rand.Shuffle(len(merged), func(i, j int) {
+			merged[i], merged[j] = merged[j], merged[i]
+		})
+	}
+	if len(merged) > constants.MaxGameQuestionCount {
+		merged = merged[:constants.MaxGameQuestionCount]
+	}
+
+	// Bind the pooled questions to this session
+	questions := make([]*domain.GameQuestion, 0, len(merged))
+	options := make([]*domain.GameQuestionOption, 0, len(merged)*4)
+	for i, pq := range merged {
+		pq.question.SessionID = sessionID
+		pq.question.QuestionOrder = int16(i + 1)
+		pq.question.CreatedAt = time.Now()
+		questions = append(questions, pq.question)
+		options = append(options, pq.options...)
+	}
+
+	return questions, options, true
+}
+
+// generateQuestionSet generates a question set for a pool key, not yet bound to a session
+func (h *Handler) generateQuestionSet(ctx context.Context, key PoolKey) (questionSet, error) {
+	questions, options, err := h.generateQuestions(
+		ctx,
+		0,
+		key.SourceLanguageID,
+		key.TargetLanguageID,
+		"level",
+		key.topicIDs(),
+		key.LevelID,
+		constants.MaxGameQuestionCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Options are generated four per question, in question order
+	const optionsPerQuestion = 4
+	set := make(questionSet, 0, len(questions))
+	for i, question := range questions {
+		start := i * optionsPerQuestion
+		if start+optionsPerQuestion > len(options) {
+			return nil, domain.ErrOptionNotFound
+		}
+		set = append(set, pooledQuestion{
+			question: question,
+			options:  options[start : start+optionsPerQuestion],
+		})
+	}
+	return set, nil
+}
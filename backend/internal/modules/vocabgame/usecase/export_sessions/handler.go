@@ -0,0 +1,136 @@
+package export_sessions
+
+import (
+	"context"
+	"io"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	"github.com/english-coach/backend/internal/shared/constants"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Flusher is implemented by writers that can push buffered data to the client.
+// Execute flushes after every page so the export streams instead of buffering.
+type Flusher interface {
+	Flush() error
+}
+
+// Handler handles exporting a user's vocabgame session history
+type Handler struct {
+	exportRepo domain.GameSessionExportRepository
+	logger     logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	exportRepo domain.GameSessionExportRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		exportRepo: exportRepo,
+		logger:     logger,
+	}
+}
+
+// validate checks the input before anything is written to the client
+func (h *Handler) validate(input ExportSessionsInput) error {
+	if input.Format != FormatCSV && input.Format != FormatNDJSON {
+		return sharederrors.MapDomainErrorToAppError(domain.ErrInvalidExportFormat)
+	}
+	if input.From != nil && input.To != nil && !input.From.Before(*input.To) {
+		return sharederrors.MapDomainErrorToAppError(domain.ErrInvalidExportRange)
+	}
+	return nil
+}
+
+// Execute streams every session, question and answer matching the input to w.
+// Rows are read page by page with a keyset cursor so memory use stays bounded;
+// only the rows of the session currently being assembled are kept.
+func (h *Handler) Execute(ctx context.Context, input ExportSessionsInput, w io.Writer) (*ExportSessionsOutput, error) {
+	if err := h.validate(input); err != nil {
+		return nil, err
+	}
+
+	records := newRecordWriter(input.Format, w)
+	filter := domain.GameSessionExportFilter{
+		UserID:      input.UserID,
+		StartedFrom: input.From,
+		StartedTo:   input.To,
+	}
+
+	output := &ExportSessionsOutput{}
+	var cursor domain.GameSessionExportCursor
+	var pending []*domain.GameSessionExportRow
+
+	writePending := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		if err := records.writeSession(pending); err != nil {
+			return err
+		}
+		output.SessionCount++
+		pending = pending[:0]
+		return nil
+	}
+
+	for {
+		rows, err := h.exportRepo.FindExportRows(ctx, filter, cursor, constants.SessionExportBatchSize)
+		if err != nil {
+			h.logger.Error("failed to read session export page",
+				logger.Error(err),
+				logger.Int64("user_id", input.UserID),
+				logger.Int64("after_session_id", cursor.SessionID),
+			)
+			return nil, sharederrors.MapDomainErrorToAppError(err)
+		}
+
+		for _, row := range rows {
+			// Rows are ordered by session, so a new session ID completes the pending one
+			if len(pending) > 0 && pending[0].SessionID != row.SessionID {
+				if err := writePending(); err != nil {
+					return nil, err
+				}
+			}
+			pending = append(pending, row)
+			output.QuestionCount++
+		}
+
+		if len(rows) < constants.SessionExportBatchSize {
+			break
+		}
+		cursor = rows[len(rows)-1].Cursor()
+
+		if err := h.flush(records, w); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writePending(); err != nil {
+		return nil, err
+	}
+	if err := h.flush(records, w); err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("vocabgame session export completed",
+		logger.Int64("user_id", input.UserID),
+		logger.String("format", input.Format),
+		logger.Int("session_count", output.SessionCount),
+		logger.Int("question_count", output.QuestionCount),
+	)
+
+	return output, nil
+}
+
+// flush pushes the encoder buffer and then the underlying writer, if it supports flushing
+func (h *Handler) flush(records recordWriter, w io.Writer) error {
+	if err := records.flush(); err != nil {
+		return err
+	}
+	if f, ok := w.(Flusher); ok {
+		return f.Flush()
+	}
+	return nil
+}
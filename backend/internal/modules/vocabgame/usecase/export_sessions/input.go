@@ -0,0 +1,18 @@
+package export_sessions
+
+import "time"
+
+// Supported export formats
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+// ExportSessionsInput represents the input for exporting a user's session history.
+// From is inclusive and To is exclusive; nil bounds are open.
+type ExportSessionsInput struct {
+	UserID int64
+	Format string
+	From   *time.Time
+	To     *time.Time
+}
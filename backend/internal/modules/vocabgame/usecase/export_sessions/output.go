@@ -0,0 +1,47 @@
+package export_sessions
+
+import "time"
+
+// ExportSessionsOutput summarizes a finished export
+type ExportSessionsOutput struct {
+	SessionCount  int
+	QuestionCount int
+}
+
+// ExportedSession is one NDJSON line: a session with its questions and answers
+type ExportedSession struct {
+	ID               int64              `json:"id"`
+	Mode             string             `json:"mode"`
+	SourceLanguageID int16              `json:"source_language_id"`
+	TargetLanguageID int16              `json:"target_language_id"`
+	LevelID          *int64             `json:"level_id,omitempty"`
+	TopicIDs         []int64            `json:"topic_ids"`
+	TotalQuestions   int16              `json:"total_questions"`
+	CorrectQuestions int16              `json:"correct_questions"`
+	StartedAt        time.Time          `json:"started_at"`
+	EndedAt          *time.Time         `json:"ended_at,omitempty"`
+	FlaggedAt        *time.Time         `json:"flagged_at,omitempty"`
+	Questions        []ExportedQuestion `json:"questions"`
+}
+
+// ExportedQuestion is a question of an exported session
+type ExportedQuestion struct {
+	ID            int64           `json:"id"`
+	QuestionOrder int16           `json:"question_order"`
+	QuestionType  string          `json:"question_type"`
+	SourceWordID  int64           `json:"source_word_id"`
+	SourceWord    string          `json:"source_word"`
+	CorrectWordID int64           `json:"correct_word_id"`
+	CorrectWord   string          `json:"correct_word"`
+	Answer        *ExportedAnswer `json:"answer"` // null when the question was not answered
+}
+
+// ExportedAnswer is the user's answer to an exported question
+type ExportedAnswer struct {
+	SelectedOptionLabel *string    `json:"selected_option_label,omitempty"`
+	SelectedWordID      *int64     `json:"selected_word_id,omitempty"`
+	SelectedWord        *string    `json:"selected_word,omitempty"`
+	IsCorrect           bool       `json:"is_correct"`
+	ResponseTimeMs      *int       `json:"response_time_ms,omitempty"`
+	AnsweredAt          *time.Time `json:"answered_at,omitempty"`
+}
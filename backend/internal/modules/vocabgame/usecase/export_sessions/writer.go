@@ -0,0 +1,189 @@
+package export_sessions
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+)
+
+// csvHeader lists the CSV columns; each CSV record is one question of a session
+var csvHeader = []string{
+	"session_id", "mode", "source_language_id", "target_language_id", "level_id", "topic_ids",
+	"total_questions", "correct_questions", "started_at", "ended_at", "flagged_at",
+	"question_id", "question_order", "question_type",
+	"source_word_id", "source_word", "correct_word_id", "correct_word",
+	"answered", "selected_option_label", "selected_word_id", "selected_word",
+	"is_correct", "response_time_ms", "answered_at",
+}
+
+// recordWriter encodes export rows in a specific format
+type recordWriter interface {
+	// writeSession writes every row of one session; rows share the same session ID
+	writeSession(rows []*domain.GameSessionExportRow) error
+	// flush pushes buffered output to the underlying writer
+	flush() error
+}
+
+// newRecordWriter returns the writer for the format, or nil if the format is unsupported
+func newRecordWriter(format string, w io.Writer) recordWriter {
+	switch format {
+	case FormatCSV:
+		return &csvRecordWriter{w: csv.NewWriter(w)}
+	case FormatNDJSON:
+		return &ndjsonRecordWriter{enc: json.NewEncoder(w)}
+	default:
+		return nil
+	}
+}
+
+// csvRecordWriter writes one CSV record per question
+type csvRecordWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (cw *csvRecordWriter) writeSession(rows []*domain.GameSessionExportRow) error {
+	if !cw.headerWritten {
+		if err := cw.w.Write(csvHeader); err != nil {
+			return err
+		}
+		cw.headerWritten = true
+	}
+
+	for _, row := range rows {
+		topicIDs := make([]string, 0, len(row.TopicIDs))
+		for _, id := range row.TopicIDs {
+			topicIDs = append(topicIDs, strconv.FormatInt(id, 10))
+		}
+
+		record := []string{
+			strconv.FormatInt(row.SessionID, 10),
+			row.Mode,
+			strconv.Itoa(int(row.SourceLanguageID)),
+			strconv.Itoa(int(row.TargetLanguageID)),
+			formatInt64Ptr(row.LevelID),
+			strings.Join(topicIDs, ";"),
+			strconv.Itoa(int(row.TotalQuestions)),
+			strconv.Itoa(int(row.CorrectQuestions)),
+			row.StartedAt.Format(time.RFC3339),
+			formatTimePtr(row.EndedAt),
+			formatTimePtr(row.FlaggedAt),
+			strconv.FormatInt(row.QuestionID, 10),
+			strconv.Itoa(int(row.QuestionOrder)),
+			row.QuestionType,
+			strconv.FormatInt(row.SourceWordID, 10),
+			row.SourceWord,
+			strconv.FormatInt(row.CorrectTargetWordID, 10),
+			row.CorrectWord,
+			strconv.FormatBool(row.Answered),
+			formatStringPtr(row.SelectedOptionLabel),
+			formatInt64Ptr(row.SelectedWordID),
+			formatStringPtr(row.SelectedWord),
+			"",
+			"",
+			formatTimePtr(row.AnsweredAt),
+		}
+		if row.Answered {
+			record[22] = strconv.FormatBool(row.IsCorrect)
+		}
+		if row.ResponseTimeMs != nil {
+			record[23] = strconv.Itoa(*row.ResponseTimeMs)
+		}
+
+		if err := cw.w.Write(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cw *csvRecordWriter) flush() error {
+	// An empty export still gets the header row
+	if !cw.headerWritten {
+		if err := cw.w.Write(csvHeader); err != nil {
+			return err
+		}
+		cw.headerWritten = true
+	}
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// ndjsonRecordWriter writes one JSON object per session
+type ndjsonRecordWriter struct {
+	enc *json.Encoder
+}
+
+func (nw *ndjsonRecordWriter) writeSession(rows []*domain.GameSessionExportRow) error {
+	first := rows[0]
+	session := ExportedSession{
+		ID:               first.SessionID,
+		Mode:             first.Mode,
+		SourceLanguageID: first.SourceLanguageID,
+		TargetLanguageID: first.TargetLanguageID,
+		LevelID:          first.LevelID,
+		TopicIDs:         first.TopicIDs,
+		TotalQuestions:   first.TotalQuestions,
+		CorrectQuestions: first.CorrectQuestions,
+		StartedAt:        first.StartedAt,
+		EndedAt:          first.EndedAt,
+		FlaggedAt:        first.FlaggedAt,
+		Questions:        make([]ExportedQuestion, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		question := ExportedQuestion{
+			ID:            row.QuestionID,
+			QuestionOrder: row.QuestionOrder,
+			QuestionType:  row.QuestionType,
+			SourceWordID:  row.SourceWordID,
+			SourceWord:    row.SourceWord,
+			CorrectWordID: row.CorrectTargetWordID,
+			CorrectWord:   row.CorrectWord,
+		}
+		if row.Answered {
+			question.Answer = &ExportedAnswer{
+				SelectedOptionLabel: row.SelectedOptionLabel,
+				SelectedWordID:      row.SelectedWordID,
+				SelectedWord:        row.SelectedWord,
+				IsCorrect:           row.IsCorrect,
+				ResponseTimeMs:      row.ResponseTimeMs,
+				AnsweredAt:          row.AnsweredAt,
+			}
+		}
+		session.Questions = append(session.Questions, question)
+	}
+
+	// Encode appends the newline that terminates the NDJSON line
+	return nw.enc.Encode(session)
+}
+
+func (nw *ndjsonRecordWriter) flush() error {
+	return nil
+}
+
+func formatInt64Ptr(v *int64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatInt(*v, 10)
+}
+
+func formatStringPtr(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+func formatTimePtr(v *time.Time) string {
+	if v == nil {
+		return ""
+	}
+	return v.Format(time.RFC3339)
+}
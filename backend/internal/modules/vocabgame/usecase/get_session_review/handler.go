@@ -0,0 +1,215 @@
+package get_session_review
+
+import (
+	"context"
+
+	dictdomain "github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	sharederrors "github.com/english-coach/backend/internal/shared/errors"
+	"github.com/english-coach/backend/internal/shared/logger"
+)
+
+// Handler handles reviewing an ended vocabgame session
+type Handler struct {
+	sessionRepo  domain.GameSessionRepository
+	questionRepo domain.GameQuestionRepository
+	answerRepo   domain.GameAnswerRepository
+	wordRepo     dictdomain.WordRepository
+	senseRepo    dictdomain.SenseRepository
+	exampleRepo  dictdomain.ExampleRepository
+	logger       logger.ILogger
+}
+
+// NewHandler creates a new use case
+func NewHandler(
+	sessionRepo domain.GameSessionRepository,
+	questionRepo domain.GameQuestionRepository,
+	answerRepo domain.GameAnswerRepository,
+	wordRepo dictdomain.WordRepository,
+	senseRepo dictdomain.SenseRepository,
+	exampleRepo dictdomain.ExampleRepository,
+	logger logger.ILogger,
+) *Handler {
+	return &Handler{
+		sessionRepo:  sessionRepo,
+		questionRepo: questionRepo,
+		answerRepo:   answerRepo,
+		wordRepo:     wordRepo,
+		senseRepo:    senseRepo,
+		exampleRepo:  exampleRepo,
+		logger:       logger,
+	}
+}
+
+// Execute returns the review of an ended session owned by the user
+func (h *Handler) Execute(ctx context.Context, input GetSessionReviewInput) (*GetSessionReviewOutput, error) {
+	session, err := h.sessionRepo.FindGameSessionByID(ctx, input.SessionID)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	if session.UserID != input.UserID {
+		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrSessionNotOwned)
+	}
+	if session.EndedAt == nil {
+		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrSessionNotEnded)
+	}
+
+	questions, err := h.questionRepo.FindGameQuestionsBySessionID(ctx, input.SessionID)
+	if err != nil {
+		h.logger.Error("failed to find session questions",
+			logger.Error(err),
+			logger.Int64("session_id", input.SessionID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	answers, err := h.answerRepo.FindGameAnswersBySessionID(ctx, input.SessionID, input.UserID)
+	if err != nil {
+		h.logger.Error("failed to find session answers",
+			logger.Error(err),
+			logger.Int64("session_id", input.SessionID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	answerByQuestionID := make(map[int64]*domain.GameAnswer, len(answers))
+	for _, answer := range answers {
+		answerByQuestionID[answer.QuestionID] = answer
+	}
+
+	// Batch-load words, senses and examples for all questions
+	wordMap, err := h.loadWords(ctx, questions)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	mainSenses, err := h.loadMainSenses(ctx, questions)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	examples, err := h.loadExamples(ctx, mainSenses)
+	if err != nil {
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	reviews := make([]QuestionReview, 0, len(questions))
+	for _, q := range questions {
+		review := QuestionReview{
+			Question:   q,
+			SourceWord: wordMap[q.SourceWordID],
+		}
+
+		answer := answerByQuestionID[q.ID]
+		for _, opt := range q.Options {
+			optionReview := &OptionReview{Option: opt, Word: wordMap[opt.TargetWordID]}
+			if opt.IsCorrect {
+				review.CorrectOption = optionReview
+			}
+			if answer != nil && answer.SelectedOptionID != nil && *answer.SelectedOptionID == opt.ID {
+				review.ChosenOption = optionReview
+			}
+		}
+
+		if answer != nil {
+			answeredAt := answer.AnsweredAt
+			review.IsCorrect = answer.IsCorrect
+			review.ResponseTimeMs = answer.ResponseTimeMs
+			review.AnsweredAt = &answeredAt
+		}
+
+		if sense := mainSenses[q.ID]; sense != nil {
+			review.MainSense = sense
+			if senseExamples := examples[sense.ID]; len(senseExamples) > 0 {
+				review.Example = senseExamples[0]
+			}
+		}
+
+		reviews = append(reviews, review)
+	}
+
+	h.logger.Info("vocabgame session review loaded",
+		logger.Int64("session_id", input.SessionID),
+		logger.Int64("user_id", input.UserID),
+		logger.Int("question_count", len(reviews)),
+	)
+
+	return &GetSessionReviewOutput{
+		Session:   session,
+		Questions: reviews,
+	}, nil
+}
+
+// loadWords fetches source and option words of all questions in one batch
+func (h *Handler) loadWords(ctx context.Context, questions []*domain.GameQuestion) (map[int64]*dictdomain.Word, error) {
+	seen := make(map[int64]bool)
+	wordIDs := make([]int64, 0, len(questions)*5)
+	for _, q := range questions {
+		ids := []int64{q.SourceWordID}
+		for _, opt := range q.Options {
+			ids = append(ids, opt.TargetWordID)
+		}
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				wordIDs = append(wordIDs, id)
+			}
+		}
+	}
+
+	wordMap := make(map[int64]*dictdomain.Word, len(wordIDs))
+	if len(wordIDs) == 0 {
+		return wordMap, nil
+	}
+
+	words, err := h.wordRepo.FindWordsByIDs(ctx, wordIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, word := range words {
+		wordMap[word.ID] = word
+	}
+	return wordMap, nil
+}
+
+// loadMainSenses returns the main sense of each question's source word, keyed by question ID.
+// The main sense is the sense the question was built from, or the first sense of the word.
+func (h *Handler) loadMainSenses(ctx context.Context, questions []*domain.GameQuestion) (map[int64]*dictdomain.Sense, error) {
+	wordIDs := make([]int64, 0, len(questions))
+	for _, q := range questions {
+		wordIDs = append(wordIDs, q.SourceWordID)
+	}
+
+	sensesByWord, err := h.senseRepo.FindSensesByWordIDs(ctx, wordIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	mainSenses := make(map[int64]*dictdomain.Sense, len(questions))
+	for _, q := range questions {
+		senses := sensesByWord[q.SourceWordID]
+		if len(senses) == 0 {
+			continue
+		}
+		mainSenses[q.ID] = senses[0] // ordered by sense_order
+		if q.SourceSenseID != nil {
+			for _, sense := range senses {
+				if sense.ID == *q.SourceSenseID {
+					mainSenses[q.ID] = sense
+					break
+				}
+			}
+		}
+	}
+	return mainSenses, nil
+}
+
+// loadExamples fetches examples for the main senses, keyed by sense ID
+func (h *Handler) loadExamples(ctx context.Context, mainSenses map[int64]*dictdomain.Sense) (map[int64][]*dictdomain.Example, error) {
+	seen := make(map[int64]bool, len(mainSenses))
+	senseIDs := make([]int64, 0, len(mainSenses))
+	for _, sense := range mainSenses {
+		if !seen[sense.ID] {
+			seen[sense.ID] = true
+			senseIDs = append(senseIDs, sense.ID)
+		}
+	}
+	return h.exampleRepo.FindExamplesBySenseIDs(ctx, senseIDs)
+}
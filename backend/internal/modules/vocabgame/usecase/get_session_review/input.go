@@ -0,0 +1,7 @@
+package get_session_review
+
+// GetSessionReviewInput represents the input for reviewing an ended session use case.
+type GetSessionReviewInput struct {
+	SessionID int64
+	UserID    int64
+}
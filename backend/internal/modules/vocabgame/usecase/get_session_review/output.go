@@ -0,0 +1,33 @@
+package get_session_review
+
+import (
+	"time"
+
+	dictdomain "github.com/english-coach/backend/internal/modules/dictionary/domain"
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+)
+
+// GetSessionReviewOutput represents an ended session with every question reviewed.
+type GetSessionReviewOutput struct {
+	Session   *domain.GameSession
+	Questions []QuestionReview
+}
+
+// QuestionReview represents one question with the correct and chosen options.
+type QuestionReview struct {
+	Question       *domain.GameQuestion
+	SourceWord     *dictdomain.Word
+	CorrectOption  *OptionReview
+	ChosenOption   *OptionReview // nil when the question was not answered
+	IsCorrect      bool
+	ResponseTimeMs *int
+	AnsweredAt     *time.Time
+	MainSense      *dictdomain.Sense   // sense the question was built from, or the first sense
+	Example        *dictdomain.Example // first example of the main sense
+}
+
+// OptionReview represents an option with the word it stands for.
+type OptionReview struct {
+	Option *domain.GameQuestionOption
+	Word   *dictdomain.Word
+}
@@ -14,6 +14,7 @@ type Handler struct {
 	answerRepo   domain.GameAnswerRepository
 	questionRepo domain.GameQuestionRepository
 	sessionRepo  domain.GameSessionRepository
+	flagRepo     domain.GameSessionFlagRepository
 	logger       logger.ILogger
 }
 
@@ -22,12 +23,14 @@ func NewHandler(
 	answerRepo domain.GameAnswerRepository,
 	questionRepo domain.GameQuestionRepository,
 	sessionRepo domain.GameSessionRepository,
+	flagRepo domain.GameSessionFlagRepository,
 	logger logger.ILogger,
 ) *Handler {
 	return &Handler{
 		answerRepo:   answerRepo,
 		questionRepo: questionRepo,
 		sessionRepo:  sessionRepo,
+		flagRepo:     flagRepo,
 		logger:       logger,
 	}
 }
@@ -113,15 +116,38 @@ func (h *Handler) Execute(ctx context.Context, input SubmitAnswerInput, sessionI
 		return nil, sharederrors.MapDomainErrorToAppError(domain.ErrAnswerAlreadySubmitted)
 	}
 
+	// Load the rest of the session for integrity checks
+	questions, err := h.questionRepo.FindGameQuestionsBySessionID(ctx, sessionID)
+	if err != nil {
+		h.logger.Error("failed to find session questions",
+			logger.Error(err),
+			logger.Int64("session_id", sessionID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+	answers, err := h.answerRepo.FindGameAnswersBySessionID(ctx, sessionID, userID)
+	if err != nil {
+		h.logger.Error("failed to find session answers",
+			logger.Error(err),
+			logger.Int64("session_id", sessionID),
+		)
+		return nil, sharederrors.MapDomainErrorToAppError(err)
+	}
+
+	// Response time is measured by the server; the client value is only kept for review
+	answeredAt := time.Now()
+	integrity := checkIntegrity(session, question, questions, answers, input.ResponseTimeMs, answeredAt)
+
 	// Create answer
 	answer := &domain.GameAnswer{
-		QuestionID:       input.QuestionID,
-		SessionID:        sessionID,
-		UserID:           userID,
-		SelectedOptionID: &input.SelectedOptionID,
-		IsCorrect:        isCorrect,
-		ResponseTimeMs:   input.ResponseTimeMs,
-		AnsweredAt:       time.Now(),
+		QuestionID:           input.QuestionID,
+		SessionID:            sessionID,
+		UserID:               userID,
+		SelectedOptionID:     &input.SelectedOptionID,
+		IsCorrect:            isCorrect,
+		ResponseTimeMs:       integrity.ResponseTimeMs,
+		ClientResponseTimeMs: input.ResponseTimeMs,
+		AnsweredAt:           answeredAt,
 	}
 
 	if err := h.answerRepo.Create(ctx, answer); err != nil {
@@ -132,11 +158,17 @@ func (h *Handler) Execute(ctx context.Context, input SubmitAnswerInput, sessionI
 		return nil, sharederrors.MapDomainErrorToAppError(err)
 	}
 
-	// Update session correct count if answer is correct
-	if isCorrect {
-		session.CorrectQuestions++
+	// Update session correct count, and end the session once every question is answered
+	sessionEnded := len(answers)+1 >= len(questions)
+	if isCorrect || sessionEnded {
+		if isCorrect {
+			session.CorrectQuestions++
+		}
+		if sessionEnded {
+			session.EndedAt = &answeredAt
+		}
 		if err := h.sessionRepo.Update(ctx, session); err != nil {
-			h.logger.Error("failed to update session correct count",
+			h.logger.Error("failed to update session",
 				logger.Error(err),
 				logger.Int64("session_id", sessionID),
 			)
@@ -144,6 +176,26 @@ func (h *Handler) Execute(ctx context.Context, input SubmitAnswerInput, sessionI
 		}
 	}
 
+	// Flagged answers are still accepted, but the session is excluded from statistics
+	if len(integrity.Flags) > 0 {
+		reasons := make([]string, 0, len(integrity.Flags))
+		for _, flag := range integrity.Flags {
+			reasons = append(reasons, flag.Reason)
+		}
+		h.logger.Warn("answer failed integrity checks",
+			logger.Int64("session_id", sessionID),
+			logger.Int64("question_id", input.QuestionID),
+			logger.Int64("user_id", userID),
+			logger.Any("reasons", reasons),
+		)
+		if err := h.flagRepo.CreateFlags(ctx, sessionID, integrity.Flags); err != nil {
+			h.logger.Error("failed to store integrity flags",
+				logger.Error(err),
+				logger.Int64("session_id", sessionID),
+			)
+		}
+	}
+
 	// Log answer submission
 	fields := []map[string]interface{}{
 		logger.Int64("answer_id", answer.ID),
@@ -151,9 +203,13 @@ func (h *Handler) Execute(ctx context.Context, input SubmitAnswerInput, sessionI
 		logger.Int64("session_id", sessionID),
 		logger.Int64("user_id", userID),
 		logger.Bool("is_correct", isCorrect),
+		logger.Bool("session_ended", sessionEnded),
+	}
+	if answer.ResponseTimeMs != nil {
+		fields = append(fields, logger.Int("response_time_ms", *answer.ResponseTimeMs))
 	}
 	if input.ResponseTimeMs != nil {
-		fields = append(fields, logger.Int("response_time_ms", *input.ResponseTimeMs))
+		fields = append(fields, logger.Int("client_response_time_ms", *input.ResponseTimeMs))
 	}
 	h.logger.Info("answer submitted", fields...)
 
@@ -0,0 +1,96 @@
+package submit_answer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/english-coach/backend/internal/modules/vocabgame/domain"
+	"github.com/english-coach/backend/internal/shared/constants"
+)
+
+// integrityResult holds the server-measured response time and any flags raised for an answer
+type integrityResult struct {
+	ResponseTimeMs *int
+	Flags          []*domain.GameSessionFlag
+}
+
+// checkIntegrity measures the response time on the server and flags suspicious answers.
+// The response time runs from when the question was served, or from the previous
+// answer if that is later, since all questions of a session are served together.
+func checkIntegrity(
+	session *domain.GameSession,
+	question *domain.GameQuestion,
+	questions []*domain.GameQuestion,
+	answers []*domain.GameAnswer,
+	clientResponseTimeMs *int,
+	answeredAt time.Time,
+) integrityResult {
+	var result integrityResult
+	questionID := question.ID
+
+	addFlag := func(reason, detail string) {
+		result.Flags = append(result.Flags, &domain.GameSessionFlag{
+			SessionID:  session.ID,
+			QuestionID: &questionID,
+			Reason:     reason,
+			Detail:     &detail,
+		})
+	}
+
+	// Determine when the clock started for this answer
+	start := session.StartedAt
+	if question.ServedAt != nil {
+		start = *question.ServedAt
+	} else {
+		addFlag(domain.FlagReasonUnservedQuestion, "question was answered before it was served")
+	}
+	for _, a := range answers {
+		if a.AnsweredAt.After(start) {
+			start = a.AnsweredAt
+		}
+	}
+
+	elapsedMs := int(answeredAt.Sub(start) / time.Millisecond)
+	if elapsedMs < 0 {
+		elapsedMs = 0
+	}
+	result.ResponseTimeMs = &elapsedMs
+
+	if elapsedMs < constants.MinAnswerResponseTimeMs {
+		addFlag(domain.FlagReasonTooFast,
+			fmt.Sprintf("answered in %d ms (minimum %d ms)", elapsedMs, constants.MinAnswerResponseTimeMs))
+	}
+
+	if clientResponseTimeMs != nil && *clientResponseTimeMs > elapsedMs+constants.ClientResponseTimeToleranceMs {
+		addFlag(domain.FlagReasonClientTimeMismatch,
+			fmt.Sprintf("client reported %d ms but server measured %d ms", *clientResponseTimeMs, elapsedMs))
+	}
+
+	// Every earlier question must already be answered
+	answered := make(map[int64]bool, len(answers))
+	for _, a := range answers {
+		answered[a.QuestionID] = true
+	}
+	for _, q := range questions {
+		if q.QuestionOrder < question.QuestionOrder && !answered[q.ID] {
+			addFlag(domain.FlagReasonOutOfOrder,
+				fmt.Sprintf("question %d answered before question %d", question.QuestionOrder, q.QuestionOrder))
+			break
+		}
+	}
+
+	// Count answers within the burst window, including this one
+	windowStart := answeredAt.Add(-constants.AnswerBurstWindowMs * time.Millisecond)
+	recent := 1
+	for _, a := range answers {
+		if !a.AnsweredAt.Before(windowStart) {
+			recent++
+		}
+	}
+	if recent > constants.AnswerBurstMaxAnswers {
+		addFlag(domain.FlagReasonBurst,
+			fmt.Sprintf("%d answers within %d ms", recent, constants.AnswerBurstWindowMs))
+	}
+
+	return result
+}
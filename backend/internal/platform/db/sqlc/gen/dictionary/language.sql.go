@@ -60,3 +60,30 @@ func (q *Queries) FindLanguageByID(ctx context.Context, id int16) (Language, err
 	err := row.Scan(&i.ID, &i.Code, &i.Name)
 	return i, err
 }
+
+const findLanguagesByIDs = `-- name: FindLanguagesByIDs :many
+SELECT id, code, name
+FROM languages
+WHERE id = ANY($1::smallint[])
+ORDER BY id
+`
+
+func (q *Queries) FindLanguagesByIDs(ctx context.Context, dollar_1 []int16) ([]Language, error) {
+	rows, err := q.db.Query(ctx, findLanguagesByIDs, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Language{}
+	for rows.Next() {
+		var i Language
+		if err := rows.Scan(&i.ID, &i.Code, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
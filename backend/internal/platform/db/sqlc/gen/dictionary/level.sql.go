@@ -128,3 +128,37 @@ func (q *Queries) FindLevelsByLanguageID(ctx context.Context, languageID pgtype.
 	}
 	return items, nil
 }
+
+const findLevelsByIDs = `-- name: FindLevelsByIDs :many
+SELECT id, code, name, description, language_id, difficulty_order
+FROM levels
+WHERE id = ANY($1::bigint[])
+ORDER BY id
+`
+
+func (q *Queries) FindLevelsByIDs(ctx context.Context, dollar_1 []int64) ([]Level, error) {
+	rows, err := q.db.Query(ctx, findLevelsByIDs, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Level{}
+	for rows.Next() {
+		var i Level
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.Name,
+			&i.Description,
+			&i.LanguageID,
+			&i.DifficultyOrder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
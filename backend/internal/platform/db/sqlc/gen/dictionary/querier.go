@@ -11,30 +11,152 @@ import (
 )
 
 type Querier interface {
+	// Filters are optional; level and part of speech match any sense of the word.
+	// The page is cut before the gloss is joined: the first sense's definition and that sense's
+	// first translation, optionally restricted to translation_language_id.
+	BrowseWords(ctx context.Context, arg BrowseWordsParams) ([]BrowseWordsRow, error)
+	CountBrowseWords(ctx context.Context, arg CountBrowseWordsParams) (int64, error)
+	CountProposals(ctx context.Context, arg CountProposalsParams) (int64, error)
 	CountSearchWords(ctx context.Context, arg CountSearchWordsParams) (int64, error)
+	CountWordRevisions(ctx context.Context, wordID int64) (int64, error)
+	CountWordsByCharacterID(ctx context.Context, characterID int64) (int64, error)
+	CreateProposal(ctx context.Context, arg CreateProposalParams) (int64, error)
+	CreateTopic(ctx context.Context, arg CreateTopicParams) (Topic, error)
+	// Appends a revision; callers hold the word lock so revision numbers do not collide
+	CreateWordRevision(ctx context.Context, arg CreateWordRevisionParams) (int32, error)
+	// Appends a revision to each word whose latest revision does not already hold its snapshot;
+	// callers hold the word locks so revision numbers do not collide
+	CreateWordRevisions(ctx context.Context, arg CreateWordRevisionsParams) (int64, error)
+	DeleteExample(ctx context.Context, id int64) (int64, error)
+	DeleteExampleTranslations(ctx context.Context, exampleID int64) error
+	DeleteExampleTranslationsOfSenses(ctx context.Context, senseIds []int64) error
+	DeleteExamplesOfSenses(ctx context.Context, senseIds []int64) error
+	DeletePronunciation(ctx context.Context, id int64) (int64, error)
+	DeletePronunciationsOfWord(ctx context.Context, wordID int64) error
+	DeleteSenseTranslation(ctx context.Context, arg DeleteSenseTranslationParams) (int64, error)
+	DeleteSenseTranslationsOfSenses(ctx context.Context, senseIds []int64) error
+	DeleteSenseTranslationsToWord(ctx context.Context, targetWordID int64) error
+	DeleteSenses(ctx context.Context, senseIds []int64) (int64, error)
+	DeleteTopic(ctx context.Context, id int64) (int64, error)
+	DeleteWord(ctx context.Context, id int64) (int64, error)
+	DeleteWordCharactersOfWord(ctx context.Context, wordID int64) error
+	DeleteWordRelation(ctx context.Context, arg DeleteWordRelationParams) (int64, error)
+	DeleteWordRelationsFromWord(ctx context.Context, fromWordID int64) error
+	DeleteWordRelationsOfWord(ctx context.Context, fromWordID int64) error
+	DeleteWordTopics(ctx context.Context, wordID int64) error
+	DeleteWordTopicsOfTopic(ctx context.Context, topicID int64) error
+	// Reports whether another sense of the word already uses the order
+	ExistsSenseOrder(ctx context.Context, arg ExistsSenseOrderParams) (bool, error)
 	FindAllLanguages(ctx context.Context) ([]Language, error)
 	FindAllLevels(ctx context.Context) ([]Level, error)
 	FindAllPartsOfSpeech(ctx context.Context) ([]PartsOfSpeech, error)
+	// Imported pairs come first so they win when a character has several counterparts
+	FindAllScriptConversions(ctx context.Context) ([]FindAllScriptConversionsRow, error)
 	FindAllTopics(ctx context.Context) ([]Topic, error)
+	// Words of a language whose lemma_normalized is one of the forms cut from an analyzed text.
+	// Each word comes with the easiest level among its senses and the gloss the browse list shows:
+	// the first sense's definition and that sense's first translation, optionally restricted to
+	// translation_language_id.
+	FindAnalyzerWords(ctx context.Context, arg FindAnalyzerWordsParams) ([]FindAnalyzerWordsRow, error)
+	// Matches the literal itself first, then a character whose simplified or traditional form it is
+	FindCharacterByLiteral(ctx context.Context, literal string) (Character, error)
+	FindCharacterReadingsByCharacterIDs(ctx context.Context, dollar_1 []int64) ([]CharacterReading, error)
+	FindExampleTranslationsByExampleIDs(ctx context.Context, dollar_1 []int64) ([]FindExampleTranslationsByExampleIDsRow, error)
+	FindExampleWordID(ctx context.Context, id int64) (int64, error)
+	FindExamplesBySenseIDs(ctx context.Context, dollar_1 []int64) ([]Example, error)
+	// One keyset page of the words selected for an export; filters are optional
+	FindExportWordIDs(ctx context.Context, arg FindExportWordIDsParams) ([]int64, error)
 	FindLanguageByCode(ctx context.Context, code string) (Language, error)
 	FindLanguageByID(ctx context.Context, id int16) (Language, error)
+	FindLanguagesByIDs(ctx context.Context, dollar_1 []int16) ([]Language, error)
 	FindLevelByCode(ctx context.Context, code string) (Level, error)
 	FindLevelByID(ctx context.Context, id int64) (Level, error)
+	FindLevelsByIDs(ctx context.Context, dollar_1 []int64) ([]Level, error)
 	FindLevelsByLanguageID(ctx context.Context, languageID pgtype.Int2) ([]Level, error)
 	FindPartOfSpeechByCode(ctx context.Context, code string) (PartsOfSpeech, error)
 	FindPartOfSpeechByID(ctx context.Context, id int16) (PartsOfSpeech, error)
 	FindPartsOfSpeechByIDs(ctx context.Context, dollar_1 []int16) ([]PartsOfSpeech, error)
+	FindPronunciationWordID(ctx context.Context, id int64) (int64, error)
+	// Moderation queue, oldest first; status, word and user filters are optional
+	FindProposals(ctx context.Context, arg FindProposalsParams) ([]FindProposalsRow, error)
+	// Finds source senses whose translations include the queried word. Only the best tier of
+	// query matches is used: exact lemma matches win over diacritic/tone-insensitive ones.
+	// Relations touching any of the words, in either direction
+	FindRelationEdgesByWordIDs(ctx context.Context, arg FindRelationEdgesByWordIDsParams) ([]FindRelationEdgesByWordIDsRow, error)
+	FindReverseTranslations(ctx context.Context, arg FindReverseTranslationsParams) ([]FindReverseTranslationsRow, error)
+	FindSenseIDsByWordID(ctx context.Context, wordID int64) ([]int64, error)
+	FindSenseWordID(ctx context.Context, id int64) (int64, error)
 	FindSensesByWordID(ctx context.Context, wordID int64) ([]Sense, error)
 	FindSensesByWordIDs(ctx context.Context, dollar_1 []int64) ([]Sense, error)
+	// Pairs each word with up to neighbor_limit other words per shared character, most frequent first
+	FindSharedCharacterEdgesByWordIDs(ctx context.Context, arg FindSharedCharacterEdgesByWordIDsParams) ([]FindSharedCharacterEdgesByWordIDsRow, error)
+	FindSnapshotExampleTranslations(ctx context.Context, exampleIds []int64) ([]FindSnapshotExampleTranslationsRow, error)
+	FindSnapshotExamples(ctx context.Context, senseIds []int64) ([]FindSnapshotExamplesRow, error)
+	FindSnapshotPronunciations(ctx context.Context, wordIds []int64) ([]FindSnapshotPronunciationsRow, error)
+	FindSnapshotRelations(ctx context.Context, wordIds []int64) ([]FindSnapshotRelationsRow, error)
+	FindSnapshotSenseTranslations(ctx context.Context, senseIds []int64) ([]FindSnapshotSenseTranslationsRow, error)
+	FindSnapshotSenses(ctx context.Context, wordIds []int64) ([]FindSnapshotSensesRow, error)
+	FindSnapshotTopics(ctx context.Context, wordIds []int64) ([]FindSnapshotTopicsRow, error)
+	// Word fields of revision snapshots and exports
+	FindSnapshotWords(ctx context.Context, wordIds []int64) ([]FindSnapshotWordsRow, error)
 	FindTopicByCode(ctx context.Context, code string) (Topic, error)
 	FindTopicByID(ctx context.Context, id int64) (Topic, error)
+	// Collapses sense translations into word-to-word edges touching any of the words, in either direction
+	FindTranslationEdgesByWordIDs(ctx context.Context, dollar_1 []int64) ([]FindTranslationEdgesByWordIDsRow, error)
 	FindTranslationsForWord(ctx context.Context, arg FindTranslationsForWordParams) ([]Word, error)
 	FindWordByID(ctx context.Context, id int64) (Word, error)
+	FindWordIDsByTopicID(ctx context.Context, topicID int64) ([]int64, error)
+	FindWordRevisions(ctx context.Context, arg FindWordRevisionsParams) ([]FindWordRevisionsRow, error)
+	// Loads the fields the in-memory suggest index is built from
+	FindWordSuggestEntries(ctx context.Context) ([]FindWordSuggestEntriesRow, error)
+	FindWordsByCharacterID(ctx context.Context, arg FindWordsByCharacterIDParams) ([]FindWordsByCharacterIDRow, error)
 	FindWordsByIDs(ctx context.Context, dollar_1 []int64) ([]Word, error)
 	FindWordsByLevelAndLanguages(ctx context.Context, arg FindWordsByLevelAndLanguagesParams) ([]Word, error)
 	FindWordsByLevelAndTopicsAndLanguages(ctx context.Context, arg FindWordsByLevelAndTopicsAndLanguagesParams) ([]Word, error)
 	FindWordsByTopicAndLanguages(ctx context.Context, arg FindWordsByTopicAndLanguagesParams) ([]Word, error)
-	SearchWords(ctx context.Context, arg SearchWordsParams) ([]Word, error)
+	// Words whose detail shows the given word as a translation or relation target
+	FindWordsReferencingWord(ctx context.Context, targetWordID int64) ([]int64, error)
+	// Words changed after the given time; cached word details are evicted from these
+	FindWordsUpdatedSince(ctx context.Context, updatedAt pgtype.Timestamp) ([]FindWordsUpdatedSinceRow, error)
+	GetProposal(ctx context.Context, id int64) (GetProposalRow, error)
+	// Cheap fingerprint of the conversion table; the in-memory converter reloads when it changes
+	GetScriptConversionsVersion(ctx context.Context) (GetScriptConversionsVersionRow, error)
+	GetWordRevision(ctx context.Context, arg GetWordRevisionParams) (GetWordRevisionRow, error)
+	// Word fields of a revision snapshot
+	// Cheap fingerprint of the words table; the suggest index rebuilds when it changes
+	GetWordsVersion(ctx context.Context) (GetWordsVersionRow, error)
+	// Reports whether the latest revision of the word already holds the snapshot
+	LatestWordRevisionMatches(ctx context.Context, arg LatestWordRevisionMatchesParams) (bool, error)
+	LockProposal(ctx context.Context, id int64) (LockProposalRow, error)
+	// Locks a word for the rest of the transaction so concurrent edits of it are serialized
+	LockWord(ctx context.Context, id int64) (LockWordRow, error)
+	// Re-points the duplicates of a proposal that is itself merged into another
+	MoveMergedProposals(ctx context.Context, arg MoveMergedProposalsParams) error
+	// Carries the history of a deleted word over to the word restored from it
+	MoveWordRevisions(ctx context.Context, arg MoveWordRevisionsParams) error
+	// Puts a proposal back in the queue when applying it failed
+	ReopenProposal(ctx context.Context, id int64) error
+	// Settles a pending proposal; affects no rows when it was already reviewed
+	ReviewProposal(ctx context.Context, arg ReviewProposalParams) (int64, error)
+	SearchCharacters(ctx context.Context, arg SearchCharactersParams) ([]SearchCharactersRow, error)
+	// Full-text search over definitions, examples and example translations written in language_id.
+	// Snippets are highlighted with ts_headline on the requested page only.
+	SearchTextFullText(ctx context.Context, arg SearchTextFullTextParams) ([]SearchTextFullTextRow, error)
+	// Substring search for languages without word boundaries (zh, ja, ko), backed by trigram indexes.
+	// Returns the full content; the caller cuts and highlights the snippet.
+	SearchTextSubstring(ctx context.Context, arg SearchTextSubstringParams) ([]SearchTextSubstringRow, error)
+	SearchWords(ctx context.Context, arg SearchWordsParams) ([]SearchWordsRow, error)
+	// Database fallback for suggestions while the in-memory index is not ready.
+	// match_rank: 1 lemma, 2 search_key/lemma_normalized, 3 romanization
+	SuggestWords(ctx context.Context, arg SuggestWordsParams) ([]SuggestWordsRow, error)
+	// Bumps updated_at so cached details of these words are refreshed
+	TouchWords(ctx context.Context, wordIds []int64) error
+	UpdateExample(ctx context.Context, arg UpdateExampleParams) error
+	UpdateSense(ctx context.Context, arg UpdateSenseParams) error
+	UpdateTopic(ctx context.Context, arg UpdateTopicParams) (int64, error)
+	UpdateWord(ctx context.Context, arg UpdateWordParams) error
+	// Reports whether game questions or word statistics point to the word or one of its senses
+	WordHasLearningHistory(ctx context.Context, wordID int64) (bool, error)
 }
 
 var _ Querier = (*Queries)(nil)
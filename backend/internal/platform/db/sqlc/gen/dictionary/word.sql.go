@@ -12,28 +12,173 @@ import (
 )
 
 const countSearchWords = `-- name: CountSearchWords :one
-SELECT COUNT(DISTINCT w.id)
+SELECT COUNT(*)
 FROM words w
 WHERE w.language_id = $1
   AND (
-    w.lemma ILIKE $2
-    OR w.lemma_normalized ILIKE $2
-    OR w.search_key ILIKE $2
+    lower(w.lemma) LIKE '%' || $2 || '%'
+    OR lower(w.lemma_normalized) LIKE '%' || $3 || '%'
+    OR lower(w.search_key) LIKE '%' || $3 || '%'
+    OR lower(w.search_key) LIKE '%' || $4 || '%'
+    OR ($5::text <> ''
+        AND translate(lower(w.search_key), 'ü012345 ', 'v') LIKE '%' || $5 || '%')
+    OR lower(w.lemma) % $6
+    OR lower(w.lemma_normalized) % $7
+    OR lower(w.search_key) % $8
   )
 `
 
 type CountSearchWordsParams struct {
-	LanguageID    int16  `json:"language_id"`
-	SearchPattern string `json:"search_pattern"`
+	LanguageID   int16  `json:"language_id"`
+	LikeQuery    string `json:"like_query"`
+	LikeFolded   string `json:"like_folded"`
+	LikeNumbered string `json:"like_numbered"`
+	LikeToneless string `json:"like_toneless"`
+	Query        string `json:"query"`
+	Folded       string `json:"folded"`
+	Numbered     string `json:"numbered"`
 }
 
 func (q *Queries) CountSearchWords(ctx context.Context, arg CountSearchWordsParams) (int64, error) {
-	row := q.db.QueryRow(ctx, countSearchWords, arg.LanguageID, arg.SearchPattern)
+	row := q.db.QueryRow(ctx, countSearchWords,
+		arg.LanguageID,
+		arg.LikeQuery,
+		arg.LikeFolded,
+		arg.LikeNumbered,
+		arg.LikeToneless,
+		arg.Query,
+		arg.Folded,
+		arg.Numbered,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
+const findReverseTranslations = `-- name: FindReverseTranslations :many
+WITH candidates AS (
+  SELECT tw.id, tw.lemma,
+         CASE WHEN lower(tw.lemma) = $1 THEN 1 ELSE 2 END AS match_rank
+  FROM words tw
+  WHERE tw.language_id = $2
+    AND (
+      lower(tw.lemma) = $1
+      OR lower(tw.lemma_normalized) = $3
+      OR lower(tw.search_key) = $3
+      OR lower(tw.search_key) = $4
+      OR ($5::text <> ''
+          AND translate(lower(tw.search_key), 'ü012345 ', 'v') = $5)
+    )
+),
+targets AS (
+  SELECT c.id, c.lemma
+  FROM candidates c
+  WHERE c.match_rank = (SELECT MIN(match_rank) FROM candidates)
+)
+SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
+       w.romanization, w.script_code, w.frequency_rank,
+       w.note, w.created_at, w.updated_at,
+       s.id AS sense_id, s.sense_order, s.part_of_speech_id, s.definition,
+       s.definition_language_id, s.usage_label, s.level_id, s.note AS sense_note,
+       st.priority, t.id AS target_word_id, t.lemma AS target_lemma
+FROM targets t
+JOIN sense_translations st ON st.target_word_id = t.id
+JOIN senses s ON s.id = st.source_sense_id
+JOIN words w ON w.id = s.word_id
+WHERE w.language_id <> $2
+  AND ($6::smallint = 0 OR w.language_id = $6)
+ORDER BY w.language_id, st.priority NULLS LAST, w.frequency_rank NULLS LAST, w.id, s.sense_order
+LIMIT $7
+`
+
+type FindReverseTranslationsParams struct {
+	Query            string `json:"query"`
+	TargetLanguageID int16  `json:"target_language_id"`
+	Folded           string `json:"folded"`
+	Numbered         string `json:"numbered"`
+	Toneless         string `json:"toneless"`
+	SourceLanguageID int16  `json:"source_language_id"`
+	Limit            int32  `json:"limit"`
+}
+
+type FindReverseTranslationsRow struct {
+	ID                   int64            `json:"id"`
+	LanguageID           int16            `json:"language_id"`
+	Lemma                string           `json:"lemma"`
+	LemmaNormalized      pgtype.Text      `json:"lemma_normalized"`
+	SearchKey            pgtype.Text      `json:"search_key"`
+	Romanization         pgtype.Text      `json:"romanization"`
+	ScriptCode           pgtype.Text      `json:"script_code"`
+	FrequencyRank        pgtype.Int4      `json:"frequency_rank"`
+	Note                 pgtype.Text      `json:"note"`
+	CreatedAt            pgtype.Timestamp `json:"created_at"`
+	UpdatedAt            pgtype.Timestamp `json:"updated_at"`
+	SenseID              int64            `json:"sense_id"`
+	SenseOrder           int16            `json:"sense_order"`
+	PartOfSpeechID       int16            `json:"part_of_speech_id"`
+	Definition           string           `json:"definition"`
+	DefinitionLanguageID int16            `json:"definition_language_id"`
+	UsageLabel           pgtype.Text      `json:"usage_label"`
+	LevelID              pgtype.Int8      `json:"level_id"`
+	SenseNote            pgtype.Text      `json:"sense_note"`
+	Priority             pgtype.Int2      `json:"priority"`
+	TargetWordID         int64            `json:"target_word_id"`
+	TargetLemma          string           `json:"target_lemma"`
+}
+
+// Finds source senses whose translations include the queried word. Only the best tier of
+// query matches is used: exact lemma matches win over diacritic/tone-insensitive ones.
+func (q *Queries) FindReverseTranslations(ctx context.Context, arg FindReverseTranslationsParams) ([]FindReverseTranslationsRow, error) {
+	rows, err := q.db.Query(ctx, findReverseTranslations,
+		arg.Query,
+		arg.TargetLanguageID,
+		arg.Folded,
+		arg.Numbered,
+		arg.Toneless,
+		arg.SourceLanguageID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindReverseTranslationsRow{}
+	for rows.Next() {
+		var i FindReverseTranslationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.LanguageID,
+			&i.Lemma,
+			&i.LemmaNormalized,
+			&i.SearchKey,
+			&i.Romanization,
+			&i.ScriptCode,
+			&i.FrequencyRank,
+			&i.Note,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SenseID,
+			&i.SenseOrder,
+			&i.PartOfSpeechID,
+			&i.Definition,
+			&i.DefinitionLanguageID,
+			&i.UsageLabel,
+			&i.LevelID,
+			&i.SenseNote,
+			&i.Priority,
+			&i.TargetWordID,
+			&i.TargetLemma,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findTranslationsForWord = `-- name: FindTranslationsForWord :many
 WITH ranked AS (
   SELECT
@@ -368,52 +513,105 @@ func (q *Queries) FindWordsByTopicAndLanguages(ctx context.Context, arg FindWord
 }
 
 const searchWords = `-- name: SearchWords :many
-SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
-       w.romanization, w.script_code, w.frequency_rank,
-       w.note, w.created_at, w.updated_at
-FROM words w
-WHERE w.language_id = $1
-  AND (
-    w.lemma ILIKE $2
-    OR w.lemma_normalized ILIKE $2
-    OR w.search_key ILIKE $2
-  )
-ORDER BY 
-  CASE 
-    WHEN w.lemma = $3 THEN 1
-    WHEN w.lemma ILIKE $2 THEN 2
-    WHEN w.lemma_normalized ILIKE $2 THEN 3
-    WHEN w.search_key ILIKE $2 THEN 4
-    ELSE 5
-  END,
-  w.frequency_rank NULLS LAST,
-  w.id
-LIMIT $5 OFFSET $4
+WITH matches AS (
+  SELECT w.id, w.language_id, w.lemma, w.lemma_normalized, w.search_key,
+         w.romanization, w.script_code, w.frequency_rank,
+         w.note, w.created_at, w.updated_at,
+         CASE
+           WHEN lower(w.lemma) = $1 THEN 1
+           WHEN lower(w.lemma) LIKE $2 || '%' THEN 2
+           WHEN lower(w.lemma_normalized) LIKE $3 || '%'
+             OR lower(w.search_key) LIKE $3 || '%'
+             OR lower(w.search_key) LIKE $4 || '%'
+             OR ($5::text <> ''
+                 AND translate(lower(w.search_key), 'ü012345 ', 'v') LIKE $5 || '%') THEN 3
+           WHEN lower(w.lemma) LIKE '%' || $2 || '%'
+             OR lower(w.lemma_normalized) LIKE '%' || $3 || '%'
+             OR lower(w.search_key) LIKE '%' || $3 || '%'
+             OR lower(w.search_key) LIKE '%' || $4 || '%'
+             OR ($5::text <> ''
+                 AND translate(lower(w.search_key), 'ü012345 ', 'v') LIKE '%' || $5 || '%') THEN 4
+           ELSE 5
+         END::int AS match_rank,
+         GREATEST(
+           similarity(lower(w.lemma), $1),
+           similarity(lower(w.lemma_normalized), $6),
+           similarity(lower(w.search_key), $7)
+         )::real AS score
+  FROM words w
+  WHERE w.language_id = $8
+    AND (
+      lower(w.lemma) LIKE '%' || $2 || '%'
+      OR lower(w.lemma_normalized) LIKE '%' || $3 || '%'
+      OR lower(w.search_key) LIKE '%' || $3 || '%'
+      OR lower(w.search_key) LIKE '%' || $4 || '%'
+      OR ($5::text <> ''
+          AND translate(lower(w.search_key), 'ü012345 ', 'v') LIKE '%' || $5 || '%')
+      OR lower(w.lemma) % $1
+      OR lower(w.lemma_normalized) % $6
+      OR lower(w.search_key) % $7
+    )
+)
+SELECT m.id, m.language_id, m.lemma, m.lemma_normalized, m.search_key,
+       m.romanization, m.script_code, m.frequency_rank,
+       m.note, m.created_at, m.updated_at,
+       m.match_rank, m.score,
+       COUNT(*) OVER () AS total_count
+FROM matches m
+ORDER BY m.match_rank, m.score DESC, m.frequency_rank NULLS LAST, m.id
+LIMIT $9 OFFSET $10
 `
 
 type SearchWordsParams struct {
-	LanguageID    int16  `json:"language_id"`
-	SearchPattern string `json:"search_pattern"`
-	ExactMatch    string `json:"exact_match"`
-	Offset        int32  `json:"offset"`
-	Limit         int32  `json:"limit"`
+	Query        string `json:"query"`
+	LikeQuery    string `json:"like_query"`
+	LikeFolded   string `json:"like_folded"`
+	LikeNumbered string `json:"like_numbered"`
+	LikeToneless string `json:"like_toneless"`
+	Folded       string `json:"folded"`
+	Numbered     string `json:"numbered"`
+	LanguageID   int16  `json:"language_id"`
+	Limit        int32  `json:"limit"`
+	Offset       int32  `json:"offset"`
+}
+
+type SearchWordsRow struct {
+	ID              int64            `json:"id"`
+	LanguageID      int16            `json:"language_id"`
+	Lemma           string           `json:"lemma"`
+	LemmaNormalized pgtype.Text      `json:"lemma_normalized"`
+	SearchKey       pgtype.Text      `json:"search_key"`
+	Romanization    pgtype.Text      `json:"romanization"`
+	ScriptCode      pgtype.Text      `json:"script_code"`
+	FrequencyRank   pgtype.Int4      `json:"frequency_rank"`
+	Note            pgtype.Text      `json:"note"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	UpdatedAt       pgtype.Timestamp `json:"updated_at"`
+	MatchRank       int32            `json:"match_rank"`
+	Score           float32          `json:"score"`
+	TotalCount      int64            `json:"total_count"`
 }
 
-func (q *Queries) SearchWords(ctx context.Context, arg SearchWordsParams) ([]Word, error) {
+func (q *Queries) SearchWords(ctx context.Context, arg SearchWordsParams) ([]SearchWordsRow, error) {
 	rows, err := q.db.Query(ctx, searchWords,
+		arg.Query,
+		arg.LikeQuery,
+		arg.LikeFolded,
+		arg.LikeNumbered,
+		arg.LikeToneless,
+		arg.Folded,
+		arg.Numbered,
 		arg.LanguageID,
-		arg.SearchPattern,
-		arg.ExactMatch,
-		arg.Offset,
 		arg.Limit,
+		arg.Offset,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []Word{}
+	items := []SearchWordsRow{}
 	for rows.Next() {
-		var i Word
+		var i SearchWordsRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.LanguageID,
@@ -426,6 +624,9 @@ func (q *Queries) SearchWords(ctx context.Context, arg SearchWordsParams) ([]Wor
 			&i.Note,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.MatchRank,
+			&i.Score,
+			&i.TotalCount,
 		); err != nil {
 			return nil, err
 		}
@@ -436,3 +637,36 @@ func (q *Queries) SearchWords(ctx context.Context, arg SearchWordsParams) ([]Wor
 	}
 	return items, nil
 }
+
+const findWordsUpdatedSince = `-- name: FindWordsUpdatedSince :many
+SELECT id, updated_at
+FROM words
+WHERE updated_at >= $1
+ORDER BY updated_at, id
+`
+
+type FindWordsUpdatedSinceRow struct {
+	ID        int64            `json:"id"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+}
+
+// Words changed at or after the given time; cached word details are evicted from these
+func (q *Queries) FindWordsUpdatedSince(ctx context.Context, updatedAt pgtype.Timestamp) ([]FindWordsUpdatedSinceRow, error) {
+	rows, err := q.db.Query(ctx, findWordsUpdatedSince, updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindWordsUpdatedSinceRow{}
+	for rows.Next() {
+		var i FindWordsUpdatedSinceRow
+		if err := rows.Scan(&i.ID, &i.UpdatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -14,19 +14,21 @@ import (
 const createGameAnswer = `-- name: CreateGameAnswer :one
 INSERT INTO vocab_game_question_answers (
     question_id, session_id, user_id,
-    selected_option_id, is_correct, response_time_ms, answered_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7)
+    selected_option_id, is_correct, response_time_ms,
+    client_response_time_ms, answered_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING id, answered_at
 `
 
 type CreateGameAnswerParams struct {
-	QuestionID       int64            `json:"question_id"`
-	SessionID        int64            `json:"session_id"`
-	UserID           int64            `json:"user_id"`
-	SelectedOptionID pgtype.Int8      `json:"selected_option_id"`
-	IsCorrect        bool             `json:"is_correct"`
-	ResponseTimeMs   pgtype.Int4      `json:"response_time_ms"`
-	AnsweredAt       pgtype.Timestamp `json:"answered_at"`
+	QuestionID           int64            `json:"question_id"`
+	SessionID            int64            `json:"session_id"`
+	UserID               int64            `json:"user_id"`
+	SelectedOptionID     pgtype.Int8      `json:"selected_option_id"`
+	IsCorrect            bool             `json:"is_correct"`
+	ResponseTimeMs       pgtype.Int4      `json:"response_time_ms"`
+	ClientResponseTimeMs pgtype.Int4      `json:"client_response_time_ms"`
+	AnsweredAt           pgtype.Timestamp `json:"answered_at"`
 }
 
 type CreateGameAnswerRow struct {
@@ -42,6 +44,7 @@ func (q *Queries) CreateGameAnswer(ctx context.Context, arg CreateGameAnswerPara
 		arg.SelectedOptionID,
 		arg.IsCorrect,
 		arg.ResponseTimeMs,
+		arg.ClientResponseTimeMs,
 		arg.AnsweredAt,
 	)
 	var i CreateGameAnswerRow
@@ -51,7 +54,8 @@ func (q *Queries) CreateGameAnswer(ctx context.Context, arg CreateGameAnswerPara
 
 const findGameAnswerByQuestionID = `-- name: FindGameAnswerByQuestionID :one
 SELECT id, question_id, session_id, user_id,
-       selected_option_id, is_correct, response_time_ms, answered_at
+       selected_option_id, is_correct, response_time_ms, answered_at,
+       client_response_time_ms
 FROM vocab_game_question_answers
 WHERE question_id = $1 AND session_id = $2 AND user_id = $3
 LIMIT 1
@@ -75,13 +79,15 @@ func (q *Queries) FindGameAnswerByQuestionID(ctx context.Context, arg FindGameAn
 		&i.IsCorrect,
 		&i.ResponseTimeMs,
 		&i.AnsweredAt,
+		&i.ClientResponseTimeMs,
 	)
 	return i, err
 }
 
 const findGameAnswersBySessionID = `-- name: FindGameAnswersBySessionID :many
 SELECT id, question_id, session_id, user_id,
-       selected_option_id, is_correct, response_time_ms, answered_at
+       selected_option_id, is_correct, response_time_ms, answered_at,
+       client_response_time_ms
 FROM vocab_game_question_answers
 WHERE session_id = $1 AND user_id = $2
 ORDER BY answered_at
@@ -110,6 +116,7 @@ func (q *Queries) FindGameAnswersBySessionID(ctx context.Context, arg FindGameAn
 			&i.IsCorrect,
 			&i.ResponseTimeMs,
 			&i.AnsweredAt,
+			&i.ClientResponseTimeMs,
 		); err != nil {
 			return nil, err
 		}
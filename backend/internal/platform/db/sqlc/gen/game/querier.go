@@ -14,6 +14,8 @@ type Querier interface {
 	CreateGameQuestion(ctx context.Context, arg CreateGameQuestionParams) (CreateGameQuestionRow, error)
 	CreateGameQuestionOption(ctx context.Context, arg CreateGameQuestionOptionParams) (int64, error)
 	CreateGameSession(ctx context.Context, arg CreateGameSessionParams) (CreateGameSessionRow, error)
+	CreateGameSessionFlag(ctx context.Context, arg CreateGameSessionFlagParams) (CreateGameSessionFlagRow, error)
+	CreateGameSessionTopics(ctx context.Context, arg CreateGameSessionTopicsParams) error
 	EndGameSession(ctx context.Context, arg EndGameSessionParams) error
 	FindGameAnswerByQuestionID(ctx context.Context, arg FindGameAnswerByQuestionIDParams) (VocabGameQuestionAnswer, error)
 	FindGameAnswersBySessionID(ctx context.Context, arg FindGameAnswersBySessionIDParams) ([]VocabGameQuestionAnswer, error)
@@ -22,7 +24,12 @@ type Querier interface {
 	FindGameQuestionOptionsByQuestionIDs(ctx context.Context, dollar_1 []int64) ([]VocabGameQuestionOption, error)
 	FindGameQuestionsBySessionID(ctx context.Context, sessionID int64) ([]VocabGameQuestion, error)
 	FindGameSessionByID(ctx context.Context, id int64) (VocabGameSession, error)
+	FindGameSessionExportRows(ctx context.Context, arg FindGameSessionExportRowsParams) ([]FindGameSessionExportRowsRow, error)
+	FindGameSessionFlagsBySessionID(ctx context.Context, sessionID int64) ([]VocabGameSessionFlag, error)
+	FindGameSessionTopicsBySessionIDs(ctx context.Context, sessionIds []int64) ([]VocabGameSessionTopic, error)
 	FindGameSessionsByUserID(ctx context.Context, arg FindGameSessionsByUserIDParams) ([]VocabGameSession, error)
+	FlagGameSession(ctx context.Context, arg FlagGameSessionParams) error
+	MarkGameQuestionsServed(ctx context.Context, arg MarkGameQuestionsServedParams) error
 	UpdateGameSession(ctx context.Context, arg UpdateGameSessionParams) error
 }
 
@@ -83,7 +83,7 @@ func (q *Queries) CreateGameQuestionOption(ctx context.Context, arg CreateGameQu
 const findGameQuestionByID = `-- name: FindGameQuestionByID :one
 SELECT id, session_id, question_order, question_type,
        source_word_id, source_sense_id, correct_target_word_id,
-       source_language_id, target_language_id, created_at
+       source_language_id, target_language_id, created_at, served_at
 FROM vocab_game_questions
 WHERE id = $1
 `
@@ -102,6 +102,7 @@ func (q *Queries) FindGameQuestionByID(ctx context.Context, id int64) (VocabGame
 		&i.SourceLanguageID,
 		&i.TargetLanguageID,
 		&i.CreatedAt,
+		&i.ServedAt,
 	)
 	return i, err
 }
@@ -175,7 +176,7 @@ func (q *Queries) FindGameQuestionOptionsByQuestionIDs(ctx context.Context, doll
 const findGameQuestionsBySessionID = `-- name: FindGameQuestionsBySessionID :many
 SELECT id, session_id, question_order, question_type,
        source_word_id, source_sense_id, correct_target_word_id,
-       source_language_id, target_language_id, created_at
+       source_language_id, target_language_id, created_at, served_at
 FROM vocab_game_questions
 WHERE session_id = $1
 ORDER BY question_order
@@ -201,6 +202,7 @@ func (q *Queries) FindGameQuestionsBySessionID(ctx context.Context, sessionID in
 			&i.SourceLanguageID,
 			&i.TargetLanguageID,
 			&i.CreatedAt,
+			&i.ServedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -211,3 +213,19 @@ func (q *Queries) FindGameQuestionsBySessionID(ctx context.Context, sessionID in
 	}
 	return items, nil
 }
+
+const markGameQuestionsServed = `-- name: MarkGameQuestionsServed :exec
+UPDATE vocab_game_questions
+SET served_at = $2
+WHERE session_id = $1 AND served_at IS NULL
+`
+
+type MarkGameQuestionsServedParams struct {
+	SessionID int64            `json:"session_id"`
+	ServedAt  pgtype.Timestamp `json:"served_at"`
+}
+
+func (q *Queries) MarkGameQuestionsServed(ctx context.Context, arg MarkGameQuestionsServedParams) error {
+	_, err := q.db.Exec(ctx, markGameQuestionsServed, arg.SessionID, arg.ServedAt)
+	return err
+}
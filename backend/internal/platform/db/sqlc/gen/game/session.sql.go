@@ -27,9 +27,8 @@ func (q *Queries) CountGameSessionsByUserID(ctx context.Context, userID int64) (
 const createGameSession = `-- name: CreateGameSession :one
 INSERT INTO vocab_game_sessions (
     user_id, mode, source_language_id, target_language_id,
-    topic_id, level_id, total_questions, correct_questions,
-    started_at
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    level_id, total_questions, correct_questions, started_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 RETURNING id, started_at
 `
 
@@ -38,7 +37,6 @@ type CreateGameSessionParams struct {
 	Mode             string           `json:"mode"`
 	SourceLanguageID int16            `json:"source_language_id"`
 	TargetLanguageID int16            `json:"target_language_id"`
-	TopicID          pgtype.Int8      `json:"topic_id"`
 	LevelID          pgtype.Int8      `json:"level_id"`
 	TotalQuestions   pgtype.Int2      `json:"total_questions"`
 	CorrectQuestions pgtype.Int2      `json:"correct_questions"`
@@ -56,7 +54,6 @@ func (q *Queries) CreateGameSession(ctx context.Context, arg CreateGameSessionPa
 		arg.Mode,
 		arg.SourceLanguageID,
 		arg.TargetLanguageID,
-		arg.TopicID,
 		arg.LevelID,
 		arg.TotalQuestions,
 		arg.CorrectQuestions,
@@ -85,8 +82,8 @@ func (q *Queries) EndGameSession(ctx context.Context, arg EndGameSessionParams)
 
 const findGameSessionByID = `-- name: FindGameSessionByID :one
 SELECT id, user_id, mode, source_language_id, target_language_id,
-       topic_id, level_id, total_questions, correct_questions,
-       started_at, ended_at
+       level_id, total_questions, correct_questions,
+       started_at, ended_at, flagged_at
 FROM vocab_game_sessions
 WHERE id = $1
 `
@@ -100,20 +97,20 @@ func (q *Queries) FindGameSessionByID(ctx context.Context, id int64) (VocabGameS
 		&i.Mode,
 		&i.SourceLanguageID,
 		&i.TargetLanguageID,
-		&i.TopicID,
 		&i.LevelID,
 		&i.TotalQuestions,
 		&i.CorrectQuestions,
 		&i.StartedAt,
 		&i.EndedAt,
+		&i.FlaggedAt,
 	)
 	return i, err
 }
 
 const findGameSessionsByUserID = `-- name: FindGameSessionsByUserID :many
 SELECT id, user_id, mode, source_language_id, target_language_id,
-       topic_id, level_id, total_questions, correct_questions,
-       started_at, ended_at
+       level_id, total_questions, correct_questions,
+       started_at, ended_at, flagged_at
 FROM vocab_game_sessions
 WHERE user_id = $1
 ORDER BY started_at DESC
@@ -141,12 +138,12 @@ func (q *Queries) FindGameSessionsByUserID(ctx context.Context, arg FindGameSess
 			&i.Mode,
 			&i.SourceLanguageID,
 			&i.TargetLanguageID,
-			&i.TopicID,
 			&i.LevelID,
 			&i.TotalQuestions,
 			&i.CorrectQuestions,
 			&i.StartedAt,
 			&i.EndedAt,
+			&i.FlaggedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -158,6 +155,22 @@ func (q *Queries) FindGameSessionsByUserID(ctx context.Context, arg FindGameSess
 	return items, nil
 }
 
+const flagGameSession = `-- name: FlagGameSession :exec
+UPDATE vocab_game_sessions
+SET flagged_at = $2
+WHERE id = $1 AND flagged_at IS NULL
+`
+
+type FlagGameSessionParams struct {
+	ID        int64            `json:"id"`
+	FlaggedAt pgtype.Timestamp `json:"flagged_at"`
+}
+
+func (q *Queries) FlagGameSession(ctx context.Context, arg FlagGameSessionParams) error {
+	_, err := q.db.Exec(ctx, flagGameSession, arg.ID, arg.FlaggedAt)
+	return err
+}
+
 const updateGameSession = `-- name: UpdateGameSession :exec
 UPDATE vocab_game_sessions
 SET total_questions = $2,
@@ -74,6 +74,14 @@ type Pronunciation struct {
 	AudioUrl pgtype.Text `json:"audio_url"`
 }
 
+type ScriptConversion struct {
+	Simplified  string           `json:"simplified"`
+	Traditional string           `json:"traditional"`
+	Source      string           `json:"source"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+}
+
 type Sense struct {
 	ID                   int64       `json:"id"`
 	WordID               int64       `json:"word_id"`
@@ -108,16 +116,18 @@ type User struct {
 	CreatedAt    pgtype.Timestamp `json:"created_at"`
 	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
 	IsActive     pgtype.Bool      `json:"is_active"`
+	Role         string           `json:"role"`
 }
 
 type UserProfile struct {
-	UserID      int64            `json:"user_id"`
-	DisplayName pgtype.Text      `json:"display_name"`
-	AvatarUrl   pgtype.Text      `json:"avatar_url"`
-	BirthDay    pgtype.Date      `json:"birth_day"`
-	Bio         pgtype.Text      `json:"bio"`
-	CreatedAt   pgtype.Timestamp `json:"created_at"`
-	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	UserID          int64            `json:"user_id"`
+	DisplayName     pgtype.Text      `json:"display_name"`
+	AvatarUrl       pgtype.Text      `json:"avatar_url"`
+	BirthDay        pgtype.Date      `json:"birth_day"`
+	Bio             pgtype.Text      `json:"bio"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	UpdatedAt       pgtype.Timestamp `json:"updated_at"`
+	PreferredScript pgtype.Text      `json:"preferred_script"`
 }
 
 type UserStatistic struct {
@@ -157,17 +167,19 @@ type VocabGameQuestion struct {
 	SourceLanguageID    int16            `json:"source_language_id"`
 	TargetLanguageID    int16            `json:"target_language_id"`
 	CreatedAt           pgtype.Timestamp `json:"created_at"`
+	ServedAt            pgtype.Timestamp `json:"served_at"`
 }
 
 type VocabGameQuestionAnswer struct {
-	ID               int64            `json:"id"`
-	QuestionID       int64            `json:"question_id"`
-	SessionID        int64            `json:"session_id"`
-	UserID           int64            `json:"user_id"`
-	SelectedOptionID pgtype.Int8      `json:"selected_option_id"`
-	IsCorrect        bool             `json:"is_correct"`
-	ResponseTimeMs   pgtype.Int4      `json:"response_time_ms"`
-	AnsweredAt       pgtype.Timestamp `json:"answered_at"`
+	ID                   int64            `json:"id"`
+	QuestionID           int64            `json:"question_id"`
+	SessionID            int64            `json:"session_id"`
+	UserID               int64            `json:"user_id"`
+	SelectedOptionID     pgtype.Int8      `json:"selected_option_id"`
+	IsCorrect            bool             `json:"is_correct"`
+	ResponseTimeMs       pgtype.Int4      `json:"response_time_ms"`
+	AnsweredAt           pgtype.Timestamp `json:"answered_at"`
+	ClientResponseTimeMs pgtype.Int4      `json:"client_response_time_ms"`
 }
 
 type VocabGameQuestionOption struct {
@@ -184,12 +196,26 @@ type VocabGameSession struct {
 	Mode             string           `json:"mode"`
 	SourceLanguageID int16            `json:"source_language_id"`
 	TargetLanguageID int16            `json:"target_language_id"`
-	TopicID          pgtype.Int8      `json:"topic_id"`
 	LevelID          pgtype.Int8      `json:"level_id"`
 	TotalQuestions   pgtype.Int2      `json:"total_questions"`
 	CorrectQuestions pgtype.Int2      `json:"correct_questions"`
 	StartedAt        pgtype.Timestamp `json:"started_at"`
 	EndedAt          pgtype.Timestamp `json:"ended_at"`
+	FlaggedAt        pgtype.Timestamp `json:"flagged_at"`
+}
+
+type VocabGameSessionFlag struct {
+	ID         int64            `json:"id"`
+	SessionID  int64            `json:"session_id"`
+	QuestionID pgtype.Int8      `json:"question_id"`
+	Reason     string           `json:"reason"`
+	Detail     pgtype.Text      `json:"detail"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type VocabGameSessionTopic struct {
+	SessionID int64 `json:"session_id"`
+	TopicID   int64 `json:"topic_id"`
 }
 
 type Word struct {
@@ -212,6 +238,24 @@ type WordCharacter struct {
 	CharOrder   int16 `json:"char_order"`
 }
 
+type WordProposal struct {
+	ID           int64            `json:"id"`
+	WordID       int64            `json:"word_id"`
+	Kind         string           `json:"kind"`
+	SenseID      pgtype.Int8      `json:"sense_id"`
+	ExampleID    pgtype.Int8      `json:"example_id"`
+	TargetWordID pgtype.Int8      `json:"target_word_id"`
+	Payload      []byte           `json:"payload"`
+	Comment      pgtype.Text      `json:"comment"`
+	Status       string           `json:"status"`
+	UserID       int64            `json:"user_id"`
+	ModeratorID  pgtype.Int8      `json:"moderator_id"`
+	Reason       pgtype.Text      `json:"reason"`
+	MergedIntoID pgtype.Int8      `json:"merged_into_id"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	ReviewedAt   pgtype.Timestamp `json:"reviewed_at"`
+}
+
 type WordRelation struct {
 	ID           int64       `json:"id"`
 	FromWordID   int64       `json:"from_word_id"`
@@ -220,6 +264,18 @@ type WordRelation struct {
 	Note         pgtype.Text `json:"note"`
 }
 
+type WordRevision struct {
+	ID        int64            `json:"id"`
+	WordID    int64            `json:"word_id"`
+	Revision  int32            `json:"revision"`
+	Action    string           `json:"action"`
+	Source    string           `json:"source"`
+	UserID    pgtype.Int8      `json:"user_id"`
+	Summary   pgtype.Text      `json:"summary"`
+	Snapshot  []byte           `json:"snapshot"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
 type WordTopic struct {
 	WordID  int64 `json:"word_id"`
 	TopicID int64 `json:"topic_id"`
@@ -14,7 +14,7 @@ import (
 const createUserProfile = `-- name: CreateUserProfile :one
 INSERT INTO user_profiles (user_id, display_name, avatar_url, birth_day, bio)
 VALUES ($1, $2, $3, $4, $5)
-RETURNING user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at
+RETURNING user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at, preferred_script
 `
 
 type CreateUserProfileParams struct {
@@ -42,12 +42,13 @@ func (q *Queries) CreateUserProfile(ctx context.Context, arg CreateUserProfilePa
 		&i.Bio,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PreferredScript,
 	)
 	return i, err
 }
 
 const getUserProfile = `-- name: GetUserProfile :one
-SELECT user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at
+SELECT user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at, preferred_script
 FROM user_profiles
 WHERE user_id = $1
 `
@@ -63,6 +64,7 @@ func (q *Queries) GetUserProfile(ctx context.Context, userID int64) (UserProfile
 		&i.Bio,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PreferredScript,
 	)
 	return i, err
 }
@@ -73,17 +75,19 @@ SET display_name = COALESCE($2, display_name),
     avatar_url = COALESCE($3, avatar_url),
     birth_day = COALESCE($4, birth_day),
     bio = COALESCE($5, bio),
+    preferred_script = COALESCE($6, preferred_script),
     updated_at = CURRENT_TIMESTAMP
 WHERE user_id = $1
-RETURNING user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at
+RETURNING user_id, display_name, avatar_url, birth_day, bio, created_at, updated_at, preferred_script
 `
 
 type UpdateUserProfileParams struct {
-	UserID      int64       `json:"user_id"`
-	DisplayName pgtype.Text `json:"display_name"`
-	AvatarUrl   pgtype.Text `json:"avatar_url"`
-	BirthDay    pgtype.Date `json:"birth_day"`
-	Bio         pgtype.Text `json:"bio"`
+	UserID          int64       `json:"user_id"`
+	DisplayName     pgtype.Text `json:"display_name"`
+	AvatarUrl       pgtype.Text `json:"avatar_url"`
+	BirthDay        pgtype.Date `json:"birth_day"`
+	Bio             pgtype.Text `json:"bio"`
+	PreferredScript pgtype.Text `json:"preferred_script"`
 }
 
 func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) (UserProfile, error) {
@@ -93,6 +97,7 @@ func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfilePa
 		arg.AvatarUrl,
 		arg.BirthDay,
 		arg.Bio,
+		arg.PreferredScript,
 	)
 	var i UserProfile
 	err := row.Scan(
@@ -103,6 +108,7 @@ func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfilePa
 		&i.Bio,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PreferredScript,
 	)
 	return i, err
 }
@@ -36,7 +36,7 @@ func (q *Queries) CheckUsernameExists(ctx context.Context, username pgtype.Text)
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (email, username, password_hash, is_active)
 VALUES ($1, $2, $3, $4)
-RETURNING id, email, username, password_hash, created_at, updated_at, is_active
+RETURNING id, email, username, password_hash, created_at, updated_at, is_active, role
 `
 
 type CreateUserParams struct {
@@ -62,12 +62,13 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.IsActive,
+		&i.Role,
 	)
 	return i, err
 }
 
 const findUserByEmail = `-- name: FindUserByEmail :one
-SELECT id, email, username, password_hash, created_at, updated_at, is_active
+SELECT id, email, username, password_hash, created_at, updated_at, is_active, role
 FROM users
 WHERE email = $1
 `
@@ -83,12 +84,13 @@ func (q *Queries) FindUserByEmail(ctx context.Context, email pgtype.Text) (User,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.IsActive,
+		&i.Role,
 	)
 	return i, err
 }
 
 const findUserByID = `-- name: FindUserByID :one
-SELECT id, email, username, password_hash, created_at, updated_at, is_active
+SELECT id, email, username, password_hash, created_at, updated_at, is_active, role
 FROM users
 WHERE id = $1
 `
@@ -104,12 +106,13 @@ func (q *Queries) FindUserByID(ctx context.Context, id int64) (User, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.IsActive,
+		&i.Role,
 	)
 	return i, err
 }
 
 const findUserByUsername = `-- name: FindUserByUsername :one
-SELECT id, email, username, password_hash, created_at, updated_at, is_active
+SELECT id, email, username, password_hash, created_at, updated_at, is_active, role
 FROM users
 WHERE username = $1
 `
@@ -125,6 +128,7 @@ func (q *Queries) FindUserByUsername(ctx context.Context, username pgtype.Text)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.IsActive,
+		&i.Role,
 	)
 	return i, err
 }
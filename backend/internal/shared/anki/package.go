@@ -0,0 +1,271 @@
+// Package anki writes Anki deck packages (.apkg): a zip holding a schema 11 collection database
+// and a media manifest. Packages contain one deck of basic front/back notes, which Anki imports
+// into an existing collection.
+package anki
+
+import (
+	"archive/zip"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/english-coach/backend/internal/shared/sqlitefile"
+)
+
+// collectionSchema is the schema of an Anki 2.1 collection at schema version 11
+var collectionSchema = []struct{ name, sql string }{
+	{"col", "CREATE TABLE col (id integer primary key, crt integer not null, mod integer not null, scm integer not null, ver integer not null, dty integer not null, usn integer not null, ls integer not null, conf text not null, models text not null, decks text not null, dconf text not null, tags text not null)"},
+	{"notes", "CREATE TABLE notes (id integer primary key, guid text not null, mid integer not null, mod integer not null, usn integer not null, tags text not null, flds text not null, sfld integer not null, csum integer not null, flags integer not null, data text not null)"},
+	{"cards", "CREATE TABLE cards (id integer primary key, nid integer not null, did integer not null, ord integer not null, mod integer not null, usn integer not null, type integer not null, queue integer not null, due integer not null, ivl integer not null, factor integer not null, reps integer not null, lapses integer not null, left integer not null, odue integer not null, odid integer not null, flags integer not null, data text not null)"},
+	{"revlog", "CREATE TABLE revlog (id integer primary key, cid integer not null, usn integer not null, ease integer not null, ivl integer not null, lastIvl integer not null, factor integer not null, time integer not null, type integer not null)"},
+	{"graves", "CREATE TABLE graves (usn integer not null, oid integer not null, type integer not null)"},
+}
+
+// Note fields are separated by the unit separator
+const fieldSeparator = "\x1f"
+
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+// Note is a basic note with HTML front and back fields
+type Note struct {
+	// Key identifies the note across exports, so importing a newer package updates the note
+	// instead of adding a duplicate
+	Key   string
+	Front string
+	Back  string
+	Tags  []string
+}
+
+// Package collects the notes of one deck
+type Package struct {
+	deckName string
+	notes    []Note
+}
+
+// NewPackage creates an empty package for a deck. "::" in the name nests decks.
+func NewPackage(deckName string) *Package {
+	return &Package{deckName: deckName}
+}
+
+// AddNote adds a note; each note gets one card
+func (p *Package) AddNote(note Note) {
+	p.notes = append(p.notes, note)
+}
+
+// Len returns the number of notes added
+func (p *Package) Len() int {
+	return len(p.notes)
+}
+
+// WriteTo writes the .apkg file to w
+func (p *Package) WriteTo(w io.Writer) (int64, error) {
+	counter := &countingWriter{w: w}
+	archive := zip.NewWriter(counter)
+
+	collection, err := archive.Create("collection.anki2")
+	if err != nil {
+		return counter.n, err
+	}
+	if err := p.writeCollection(collection); err != nil {
+		return counter.n, err
+	}
+
+	media, err := archive.Create("media")
+	if err != nil {
+		return counter.n, err
+	}
+	if _, err := io.WriteString(media, "{}"); err != nil {
+		return counter.n, err
+	}
+
+	if err := archive.Close(); err != nil {
+		return counter.n, err
+	}
+	return counter.n, nil
+}
+
+// writeCollection writes the collection database
+func (p *Package) writeCollection(w io.Writer) error {
+	now := time.Now()
+	nowSec, nowMs := now.Unix(), now.UnixMilli()
+	deckID := stableID("deck:" + p.deckName)
+	modelID := stableID("model:" + p.deckName)
+
+	db := sqlitefile.NewWriter()
+	tables := make(map[string]*sqlitefile.Table, len(collectionSchema))
+	for _, table := range collectionSchema {
+		tables[table.name] = db.CreateTable(table.name, table.sql)
+	}
+	db.CreateIndex("ix_notes_usn", tables["notes"], "CREATE INDEX ix_notes_usn on notes (usn)", 4)
+	db.CreateIndex("ix_cards_usn", tables["cards"], "CREATE INDEX ix_cards_usn on cards (usn)", 5)
+	db.CreateIndex("ix_revlog_usn", tables["revlog"], "CREATE INDEX ix_revlog_usn on revlog (usn)", 2)
+	db.CreateIndex("ix_cards_nid", tables["cards"], "CREATE INDEX ix_cards_nid on cards (nid)", 1)
+	db.CreateIndex("ix_cards_sched", tables["cards"], "CREATE INDEX ix_cards_sched on cards (did, queue, due)", 2, 7, 8)
+	db.CreateIndex("ix_revlog_cid", tables["revlog"], "CREATE INDEX ix_revlog_cid on revlog (cid)", 1)
+	db.CreateIndex("ix_notes_csum", tables["notes"], "CREATE INDEX ix_notes_csum on notes (csum)", 8)
+
+	conf, models, decks, dconf, err := p.collectionConfig(deckID, modelID, nowSec)
+	if err != nil {
+		return err
+	}
+	if err := tables["col"].Insert(1, nil, nowSec, nowMs, nowMs, 11, 0, 0, 0, conf, models, decks, dconf, "{}"); err != nil {
+		return err
+	}
+
+	for i, note := range p.notes {
+		// Note and card IDs are creation times in milliseconds; Anki reassigns them on conflicts
+		id := nowMs + int64(i)
+		sortField := plainText(note.Front)
+		if err := tables["notes"].Insert(id,
+			nil, guid(note.Key), modelID, nowSec, -1, formatTags(note.Tags),
+			note.Front+fieldSeparator+note.Back, sortFieldValue(sortField), checksum(sortField), 0, "",
+		); err != nil {
+			return err
+		}
+		if err := tables["cards"].Insert(id,
+			nil, id, deckID, 0, nowSec, -1, 0, 0, i+1, 0, 0, 0, 0, 0, 0, 0, 0, "",
+		); err != nil {
+			return err
+		}
+	}
+
+	_, err = db.WriteTo(w)
+	return err
+}
+
+// collectionConfig returns the JSON columns of the col row: the collection configuration, the
+// note type, the decks and the deck options
+func (p *Package) collectionConfig(deckID, modelID, now int64) (conf, models, decks, dconf string, err error) {
+	deckKey, modelKey := strconv.FormatInt(deckID, 10), strconv.FormatInt(modelID, 10)
+	values := []any{
+		map[string]any{
+			"activeDecks": []int64{1}, "addToCur": true, "collapseTime": 1200, "curDeck": 1,
+			"curModel": modelKey, "dueCounts": true, "estTimes": true, "newBury": true, "newSpread": 0,
+			"nextPos": 1, "sortBackwards": false, "sortType": "noteFld", "timeLim": 0,
+		},
+		map[string]any{modelKey: map[string]any{
+			"id": modelID, "name": p.deckName, "type": 0, "mod": now, "usn": -1, "sortf": 0, "did": deckID,
+			"css":       ".card { font-family: arial; font-size: 20px; text-align: center; color: black; background-color: white; }",
+			"latexPre":  "\\documentclass[12pt]{article}\n\\special{papersize=3in,5in}\n\\usepackage[utf8]{inputenc}\n\\usepackage{amssymb,amsmath}\n\\pagestyle{empty}\n\\setlength{\\parindent}{0in}\n\\begin{document}\n",
+			"latexPost": "\\end{document}",
+			"flds": []map[string]any{
+				{"name": "Front", "ord": 0, "font": "Arial", "size": 20, "media": []string{}, "rtl": false, "sticky": false},
+				{"name": "Back", "ord": 1, "font": "Arial", "size": 20, "media": []string{}, "rtl": false, "sticky": false},
+			},
+			"tmpls": []map[string]any{{
+				"name": "Card 1", "ord": 0, "did": nil, "bqfmt": "", "bafmt": "",
+				"qfmt": "{{Front}}",
+				"afmt": "{{FrontSide}}<hr id=answer>{{Back}}",
+			}},
+			"req":  []any{[]any{0, "all", []int{0}}},
+			"tags": []string{},
+			"vers": []any{},
+		}},
+		map[string]any{
+			"1":     deckConfig(1, "Default", now),
+			deckKey: deckConfig(deckID, p.deckName, now),
+		},
+		map[string]any{"1": map[string]any{
+			"id": 1, "name": "Default", "mod": 0, "usn": 0, "autoplay": true, "maxTaken": 60,
+			"replayq": true, "timer": 0,
+			"new": map[string]any{
+				"bury": true, "delays": []int{1, 10}, "initialFactor": 2500, "ints": []int{1, 4, 7},
+				"order": 1, "perDay": 20, "separate": true,
+			},
+			"rev": map[string]any{
+				"bury": true, "ease4": 1.3, "fuzz": 0.05, "ivlFct": 1, "maxIvl": 36500,
+				"minSpace": 1, "perDay": 100,
+			},
+			"lapse": map[string]any{"delays": []int{10}, "leechAction": 0, "leechFails": 8, "minInt": 1, "mult": 0},
+		}},
+	}
+
+	encoded := make([]string, len(values))
+	for i, v := range values {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", "", "", "", fmt.Errorf("encode collection config: %w", err)
+		}
+		encoded[i] = string(data)
+	}
+	return encoded[0], encoded[1], encoded[2], encoded[3], nil
+}
+
+// deckConfig returns the JSON object of a deck
+func deckConfig(id int64, name string, now int64) map[string]any {
+	return map[string]any{
+		"id": id, "name": name, "desc": "", "mod": now, "usn": -1, "conf": 1, "dyn": 0,
+		"collapsed": false, "extendNew": 10, "extendRev": 50,
+		"newToday": []int{0, 0}, "revToday": []int{0, 0}, "lrnToday": []int{0, 0}, "timeToday": []int{0, 0},
+	}
+}
+
+// stableID derives a deck or note type ID from a name, so repeated exports of the same deck
+// import into the same deck and note type
+func stableID(name string) int64 {
+	sum := sha256.Sum256([]byte(name))
+	return int64(binary.BigEndian.Uint32(sum[:4])>>1) + 1<<30
+}
+
+// guid derives the note GUID from its key
+func guid(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return base64.RawStdEncoding.EncodeToString(sum[:8])
+}
+
+// formatTags joins tags the way Anki stores them: space separated and padded with spaces
+func formatTags(tags []string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	cleaned := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = strings.Join(strings.Fields(tag), "_"); tag != "" {
+			cleaned = append(cleaned, tag)
+		}
+	}
+	if len(cleaned) == 0 {
+		return ""
+	}
+	return " " + strings.Join(cleaned, " ") + " "
+}
+
+// plainText strips HTML from a field, as Anki does for the sort field and checksum
+func plainText(field string) string {
+	return strings.TrimSpace(html.UnescapeString(htmlTag.ReplaceAllString(field, "")))
+}
+
+// sortFieldValue stores the sort field with the column's integer affinity applied
+func sortFieldValue(field string) any {
+	if n, err := strconv.ParseInt(field, 10, 64); err == nil && strconv.FormatInt(n, 10) == field {
+		return n
+	}
+	return field
+}
+
+// checksum is the first 32 bits of the SHA-1 of the sort field, used by Anki to find duplicates
+func checksum(field string) int64 {
+	sum := sha1.Sum([]byte(field))
+	return int64(binary.BigEndian.Uint32(sum[:4]))
+}
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
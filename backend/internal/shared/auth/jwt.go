@@ -17,6 +17,7 @@ var (
 type Claims struct {
 	UserID   int64  `json:"user_id"`
 	Username string `json:"username"`
+	Role     string `json:"role,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -35,11 +36,12 @@ func NewJWTManager(secret string, expiration time.Duration) *JWTManager {
 }
 
 // GenerateToken generates a new JWT token for a user
-func (m *JWTManager) GenerateToken(userID int64, username string) (string, error) {
+func (m *JWTManager) GenerateToken(userID int64, username, role string) (string, error) {
 	now := time.Now()
 	claims := &Claims{
 		UserID:   userID,
 		Username: username,
+		Role:     role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(m.expiration)),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -12,6 +12,66 @@ const (
 	MinGameQuestionCount = 1
 )
 
+// Answer integrity constants (in milliseconds unless noted)
+const (
+	// MinAnswerResponseTimeMs is the fastest plausible time to read a question and answer it
+	MinAnswerResponseTimeMs = 300
+
+	// AnswerBurstWindowMs is the sliding window used to detect answer bursts
+	AnswerBurstWindowMs = 3000
+
+	// AnswerBurstMaxAnswers is the maximum number of answers allowed within the burst window
+	AnswerBurstMaxAnswers = 4
+
+	// ClientResponseTimeToleranceMs is how far a client-reported time may exceed the server-measured time
+	ClientResponseTimeToleranceMs = 1000
+)
+
+// Export constants
+const (
+	// SessionExportBatchSize is the number of rows fetched per cursor page when exporting session history
+	SessionExportBatchSize = 500
+
+	// WordExportBatchSize is the number of words fetched per cursor page when exporting the dictionary
+	WordExportBatchSize = 200
+)
+
+// Dictionary lookup constants
+const (
+	// DefaultSuggestLimit is the default number of autocomplete suggestions
+	DefaultSuggestLimit = 10
+
+	// MaxSuggestLimit is the maximum number of autocomplete suggestions
+	MaxSuggestLimit = 20
+
+	// DefaultReverseLookupLimit is the default number of source senses returned by a reverse lookup
+	DefaultReverseLookupLimit = 50
+
+	// MaxReverseLookupLimit is the maximum number of source senses returned by a reverse lookup
+	MaxReverseLookupLimit = 200
+
+	// DefaultCharacterWordsLimit is the default number of words listed on a character detail
+	DefaultCharacterWordsLimit = 50
+
+	// MaxCharacterWordsLimit is the maximum number of words listed on a character detail
+	MaxCharacterWordsLimit = 200
+
+	// DefaultWordGraphDepth is the default number of hops the word graph expands from its root
+	DefaultWordGraphDepth = 2
+
+	// MaxWordGraphDepth is the maximum number of hops the word graph expands from its root
+	MaxWordGraphDepth = 3
+
+	// MaxWordGraphNodes caps the number of words in one word graph
+	MaxWordGraphNodes = 150
+
+	// WordGraphCharacterNeighbors is how many words per shared character a graph node links to
+	WordGraphCharacterNeighbors = 8
+
+	// MaxAnalyzeTextLength is the maximum number of characters the text analyzer accepts
+	MaxAnalyzeTextLength = 3000
+)
+
 // API constants
 const (
 	// DefaultPageLimit is the default pagination limit
@@ -33,6 +33,7 @@ const (
 	CodeInsufficientWords      = "INSUFFICIENT_WORDS"
 	CodeSessionNotFound        = "SESSION_NOT_FOUND"
 	CodeSessionEnded           = "SESSION_ENDED"
+	CodeSessionNotEnded        = "SESSION_NOT_ENDED"
 	CodeQuestionNotFound       = "QUESTION_NOT_FOUND"
 	CodeQuestionNotInSession   = "QUESTION_NOT_IN_SESSION"
 	CodeOptionNotFound         = "OPTION_NOT_FOUND"
@@ -40,6 +41,8 @@ const (
 	CodeInvalidMode            = "INVALID_MODE"
 	CodeSessionNotOwned        = "SESSION_NOT_OWNED"
 	CodeTranslationNotFound    = "TRANSLATION_NOT_FOUND"
+	CodeInvalidExportFormat    = "INVALID_EXPORT_FORMAT"
+	CodeInvalidExportRange     = "INVALID_EXPORT_RANGE"
 )
 
 // Dictionary domain error codes
@@ -50,4 +53,26 @@ const (
 	CodeLanguageNotFound     = "LANGUAGE_NOT_FOUND"
 	CodePartOfSpeechNotFound = "PART_OF_SPEECH_NOT_FOUND"
 	CodeSenseNotFound        = "SENSE_NOT_FOUND"
+	CodeCharacterNotFound    = "CHARACTER_NOT_FOUND"
+)
+
+// Dictionary editing error codes
+const (
+	CodeWordExists               = "WORD_EXISTS"
+	CodeSenseOrderTaken          = "SENSE_ORDER_TAKEN"
+	CodeSelfRelation             = "SELF_RELATION"
+	CodeExampleNotFound          = "EXAMPLE_NOT_FOUND"
+	CodePronunciationNotFound    = "PRONUNCIATION_NOT_FOUND"
+	CodeSenseTranslationNotFound = "SENSE_TRANSLATION_NOT_FOUND"
+	CodeRelationNotFound         = "RELATION_NOT_FOUND"
+	CodeTopicExists              = "TOPIC_EXISTS"
+	CodeEntryInUse               = "ENTRY_IN_USE"
+	CodeRevisionNotFound         = "REVISION_NOT_FOUND"
+)
+
+// Correction proposal error codes
+const (
+	CodeProposalNotFound     = "PROPOSAL_NOT_FOUND"
+	CodeProposalNotPending   = "PROPOSAL_NOT_PENDING"
+	CodeProposalMergeInvalid = "PROPOSAL_MERGE_INVALID"
 )
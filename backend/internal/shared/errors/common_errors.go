@@ -29,6 +29,7 @@ var (
 	ErrInsufficientWords      = NewAppError(CodeInsufficientWords, "Không đủ từ vựng để tạo phiên chơi. Vui lòng chọn chủ đề hoặc cấp độ khác")
 	ErrSessionNotFound        = NewAppError(CodeSessionNotFound, "Không tìm thấy phiên chơi")
 	ErrSessionEnded           = NewAppError(CodeSessionEnded, "Phiên chơi đã kết thúc")
+	ErrSessionNotEnded        = NewAppError(CodeSessionNotEnded, "Phiên chơi chưa kết thúc")
 	ErrQuestionNotFound       = NewAppError(CodeQuestionNotFound, "Không tìm thấy câu hỏi")
 	ErrQuestionNotInSession   = NewAppError(CodeQuestionNotInSession, "Câu hỏi không thuộc về phiên chơi này")
 	ErrOptionNotFound         = NewAppError(CodeOptionNotFound, "Không tìm thấy lựa chọn đã chọn")
@@ -36,6 +37,8 @@ var (
 	ErrInvalidMode            = NewAppError(CodeInvalidMode, "Chế độ không hợp lệ")
 	ErrSessionNotOwned        = NewAppError(CodeSessionNotOwned, "Phiên chơi không thuộc về người dùng này")
 	ErrTranslationNotFound    = NewAppError(CodeTranslationNotFound, "Không tìm thấy bản dịch cho từ này")
+	ErrInvalidExportFormat    = NewAppError(CodeInvalidExportFormat, "Định dạng xuất không hợp lệ")
+	ErrInvalidExportRange     = NewAppError(CodeInvalidExportRange, "Khoảng thời gian xuất không hợp lệ")
 
 	// Dictionary domain errors
 	ErrWordNotFound         = NewAppError(CodeWordNotFound, "Không tìm thấy từ")
@@ -44,4 +47,22 @@ var (
 	ErrLanguageNotFound     = NewAppError(CodeLanguageNotFound, "Không tìm thấy ngôn ngữ")
 	ErrPartOfSpeechNotFound = NewAppError(CodePartOfSpeechNotFound, "Không tìm thấy từ loại")
 	ErrSenseNotFound        = NewAppError(CodeSenseNotFound, "Không tìm thấy nghĩa")
+	ErrCharacterNotFound    = NewAppError(CodeCharacterNotFound, "Không tìm thấy chữ Hán")
+
+	// Dictionary editing errors
+	ErrWordExists               = NewAppError(CodeWordExists, "Từ đã tồn tại")
+	ErrSenseOrderTaken          = NewAppError(CodeSenseOrderTaken, "Thứ tự nghĩa đã được sử dụng cho từ này")
+	ErrSelfRelation             = NewAppError(CodeSelfRelation, "Không thể liên kết một từ với chính nó")
+	ErrExampleNotFound          = NewAppError(CodeExampleNotFound, "Không tìm thấy câu ví dụ")
+	ErrPronunciationNotFound    = NewAppError(CodePronunciationNotFound, "Không tìm thấy phát âm")
+	ErrSenseTranslationNotFound = NewAppError(CodeSenseTranslationNotFound, "Không tìm thấy bản dịch của nghĩa")
+	ErrRelationNotFound         = NewAppError(CodeRelationNotFound, "Không tìm thấy quan hệ từ")
+	ErrTopicExists              = NewAppError(CodeTopicExists, "Chủ đề đã tồn tại")
+	ErrEntryInUse               = NewAppError(CodeEntryInUse, "Mục từ đang được dùng trong lịch sử học và không thể xóa")
+	ErrRevisionNotFound         = NewAppError(CodeRevisionNotFound, "Không tìm thấy phiên bản")
+
+	// Correction proposal errors
+	ErrProposalNotFound     = NewAppError(CodeProposalNotFound, "Không tìm thấy đề xuất sửa đổi")
+	ErrProposalNotPending   = NewAppError(CodeProposalNotPending, "Đề xuất đã được xét duyệt")
+	ErrProposalMergeInvalid = NewAppError(CodeProposalMergeInvalid, "Chỉ có thể gộp vào một đề xuất khác đang chờ duyệt của cùng từ")
 )
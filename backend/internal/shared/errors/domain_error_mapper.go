@@ -1,6 +1,8 @@
 package errors
 
 import (
+	"errors"
+
 	dictionarydomain "github.com/english-coach/backend/internal/modules/dictionary/domain"
 	userdomain "github.com/english-coach/backend/internal/modules/user/domain"
 	vocabgamedomain "github.com/english-coach/backend/internal/modules/vocabgame/domain"
@@ -102,27 +104,77 @@ func MapVocabGameRepositoryError(err error, operation string) error {
 	return err
 }
 
+// dictionaryEditErrors are domain errors that editor operations may return wrapped with context
+var dictionaryEditErrors = []error{
+	dictionarydomain.ErrWordNotFound, dictionarydomain.ErrSenseNotFound, dictionarydomain.ErrTopicNotFound,
+	dictionarydomain.ErrLevelNotFound, dictionarydomain.ErrLanguageNotFound, dictionarydomain.ErrPartOfSpeechNotFound,
+	dictionarydomain.ErrWordExists, dictionarydomain.ErrSenseOrderTaken, dictionarydomain.ErrSelfRelation,
+	dictionarydomain.ErrExampleNotFound, dictionarydomain.ErrPronunciationNotFound,
+	dictionarydomain.ErrSenseTranslationNotFound, dictionarydomain.ErrRelationNotFound,
+	dictionarydomain.ErrTopicExists, dictionarydomain.ErrEntryInUse, dictionarydomain.ErrRevisionNotFound,
+	dictionarydomain.ErrProposalNotFound, dictionarydomain.ErrProposalNotPending, dictionarydomain.ErrProposalMergeInvalid,
+}
+
 // MapDictionaryRepositoryError translates technical errors to dictionary domain errors
 func MapDictionaryRepositoryError(err error, operation string) error {
 	if err == nil {
 		return nil
 	}
 
+	// Unwrap domain errors so usecases can match them directly
+	for _, target := range dictionaryEditErrors {
+		if errors.Is(err, target) {
+			return target
+		}
+	}
+
+	// Rows still referenced elsewhere (game history, statistics) cannot be deleted
+	if IsForeignKeyViolation(err) {
+		switch operation {
+		case "DeleteWord", "DeleteSense", "DeleteTopic":
+			return dictionarydomain.ErrEntryInUse
+		}
+		// The only foreign key a proposal can miss is its word; the author is the logged-in user
+		switch operation {
+		case "CreateProposal":
+			return dictionarydomain.ErrWordNotFound
+		}
+	}
+
 	// Check for "not found" errors
 	if IsNotFound(err) {
 		// Word operations - specific operation name
 		switch operation {
-		case "FindWordByID":
+		case "FindWordByID", "LockWord":
 			return dictionarydomain.ErrWordNotFound
 		}
 
+		// Editor operations look up the word that owns the edited entry
+		switch operation {
+		case "FindSenseWordID":
+			return dictionarydomain.ErrSenseNotFound
+		case "FindExampleWordID":
+			return dictionarydomain.ErrExampleNotFound
+		case "FindPronunciationWordID":
+			return dictionarydomain.ErrPronunciationNotFound
+		case "GetWordRevision":
+			return dictionarydomain.ErrRevisionNotFound
+		case "GetProposal", "LockProposal":
+			return dictionarydomain.ErrProposalNotFound
+		}
+
 		// Operations that return collections (empty slice/map if not found, not an error)
 		// These should not return "not found" errors, but if they do, it's a DB error
 		switch operation {
 		case "FindWordsByIDs", "FindWordsByTopicAndLanguages", "FindWordsByLevelAndLanguages",
 			"FindWordsByLevelAndTopicsAndLanguages", "FindTranslationsForWord",
 			"SearchWords", "CountSearchWords", "FindAllLanguages", "FindAllTopics", "FindAllLevels", "FindAllPartsOfSpeech",
-			"FindLevelsByLanguageID", "FindSensesByWordID", "FindSensesByWordIDs":
+			"FindLevelsByLanguageID", "FindSensesByWordID", "FindSensesByWordIDs", "FindExamplesBySenseIDs",
+			"FindReverseTranslations", "FindCharacterReadingsByCharacterIDs", "FindWordsByCharacterID", "SearchCharacters",
+			"FindAllScriptConversions", "FindRelationEdgesByWordIDs", "FindTranslationEdgesByWordIDs",
+			"FindSharedCharacterEdgesByWordIDs", "BrowseWords", "CountBrowseWords",
+			"FindLanguagesByIDs", "FindLevelsByIDs", "FindWordsUpdatedSince", "FindWordRevisions",
+			"FindProposals":
 			// These operations return empty results if not found, not an error
 			// But if there's a DB error, return as-is
 			return err
@@ -138,6 +190,8 @@ func MapDictionaryRepositoryError(err error, operation string) error {
 			return dictionarydomain.ErrLevelNotFound
 		case "FindPartOfSpeechByID", "FindPartOfSpeechByCode":
 			return dictionarydomain.ErrPartOfSpeechNotFound
+		case "FindCharacterByLiteral":
+			return dictionarydomain.ErrCharacterNotFound
 		case "FindPartsOfSpeechByIDs":
 			// Returns map, empty map if not found, not an error
 			return err
@@ -146,9 +200,12 @@ func MapDictionaryRepositoryError(err error, operation string) error {
 		}
 	}
 
-	// Check for unique violation errors (if any unique constraints exist)
+	// Check for unique violation errors
 	if IsUniqueViolation(err) {
-		// Dictionary domain doesn't have unique constraints that need special handling
+		switch operation {
+		case "CreateTopic", "UpdateTopic":
+			return dictionarydomain.ErrTopicExists
+		}
 		// Return as-is, let usecase handle
 		return err
 	}
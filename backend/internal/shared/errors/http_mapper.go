@@ -38,8 +38,9 @@ func mapCodeToHTTPStatus(code string) int {
 	// 400 Bad Request
 	case CodeInvalidRequest, CodeInvalidParameter, CodeValidationError,
 		CodeEmailRequired, CodeInvalidPassword, CodeInvalidMode,
-		CodeInsufficientWords, CodeSessionEnded, CodeQuestionNotInSession,
-		CodeAnswerAlreadySubmitted:
+		CodeInsufficientWords, CodeSessionEnded, CodeSessionNotEnded, CodeQuestionNotInSession,
+		CodeAnswerAlreadySubmitted, CodeInvalidExportFormat, CodeInvalidExportRange,
+		CodeSelfRelation:
 		return http.StatusBadRequest
 
 	// 401 Unauthorized
@@ -53,11 +54,16 @@ func mapCodeToHTTPStatus(code string) int {
 	// 404 Not Found
 	case CodeNotFound, CodeUserNotFound, CodeProfileNotFound,
 		CodeSessionNotFound, CodeQuestionNotFound, CodeOptionNotFound,
-		CodeWordNotFound:
+		CodeWordNotFound, CodeCharacterNotFound,
+		CodeTopicNotFound, CodeLevelNotFound, CodeLanguageNotFound, CodePartOfSpeechNotFound, CodeSenseNotFound,
+		CodeExampleNotFound, CodePronunciationNotFound, CodeSenseTranslationNotFound, CodeRelationNotFound,
+		CodeRevisionNotFound, CodeProposalNotFound:
 		return http.StatusNotFound
 
 	// 409 Conflict
-	case CodeConflict, CodeEmailExists, CodeUsernameExists:
+	case CodeConflict, CodeEmailExists, CodeUsernameExists,
+		CodeWordExists, CodeSenseOrderTaken, CodeTopicExists, CodeEntryInUse,
+		CodeProposalNotPending, CodeProposalMergeInvalid:
 		return http.StatusConflict
 
 	// 500 Internal Server Error (default)
@@ -16,6 +16,15 @@ func IsUniqueViolation(err error) bool {
 	return false
 }
 
+// IsForeignKeyViolation checks if the error is a foreign key constraint violation
+func IsForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "23503" // foreign_key_violation
+	}
+	return false
+}
+
 // IsNotFound checks if the error is a "not found" error (pgx.ErrNoRows)
 func IsNotFound(err error) bool {
 	return errors.Is(err, pgx.ErrNoRows)
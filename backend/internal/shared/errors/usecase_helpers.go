@@ -71,6 +71,8 @@ func mapvocabgamedomainErrorToAppError(err error) *AppError {
 		return ErrSessionNotFound
 	case vocabgamedomain.ErrSessionEnded:
 		return ErrSessionEnded
+	case vocabgamedomain.ErrSessionNotEnded:
+		return ErrSessionNotEnded
 	case vocabgamedomain.ErrQuestionNotFound:
 		return ErrQuestionNotFound
 	case vocabgamedomain.ErrQuestionNotInSession:
@@ -85,6 +87,10 @@ func mapvocabgamedomainErrorToAppError(err error) *AppError {
 		return ErrSessionNotOwned
 	case vocabgamedomain.ErrTranslationNotFound:
 		return ErrTranslationNotFound
+	case vocabgamedomain.ErrInvalidExportFormat:
+		return ErrInvalidExportFormat
+	case vocabgamedomain.ErrInvalidExportRange:
+		return ErrInvalidExportRange
 	default:
 		return nil
 	}
@@ -105,6 +111,34 @@ func mapDictionaryDomainErrorToAppError(err error) *AppError {
 		return ErrPartOfSpeechNotFound
 	case dictionarydomain.ErrSenseNotFound:
 		return ErrSenseNotFound
+	case dictionarydomain.ErrCharacterNotFound:
+		return ErrCharacterNotFound
+	case dictionarydomain.ErrWordExists:
+		return ErrWordExists
+	case dictionarydomain.ErrSenseOrderTaken:
+		return ErrSenseOrderTaken
+	case dictionarydomain.ErrSelfRelation:
+		return ErrSelfRelation
+	case dictionarydomain.ErrExampleNotFound:
+		return ErrExampleNotFound
+	case dictionarydomain.ErrPronunciationNotFound:
+		return ErrPronunciationNotFound
+	case dictionarydomain.ErrSenseTranslationNotFound:
+		return ErrSenseTranslationNotFound
+	case dictionarydomain.ErrRelationNotFound:
+		return ErrRelationNotFound
+	case dictionarydomain.ErrTopicExists:
+		return ErrTopicExists
+	case dictionarydomain.ErrEntryInUse:
+		return ErrEntryInUse
+	case dictionarydomain.ErrRevisionNotFound:
+		return ErrRevisionNotFound
+	case dictionarydomain.ErrProposalNotFound:
+		return ErrProposalNotFound
+	case dictionarydomain.ErrProposalNotPending:
+		return ErrProposalNotPending
+	case dictionarydomain.ErrProposalMergeInvalid:
+		return ErrProposalMergeInvalid
 	default:
 		return nil
 	}
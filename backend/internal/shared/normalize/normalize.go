@@ -0,0 +1,131 @@
+// Package normalize provides text normalization for dictionary lemmas, search keys and queries.
+package normalize
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// Combining marks that carry meaning for pinyin
+const (
+	markMacron    = '\u0304' // tone 1
+	markAcute     = '\u0301' // tone 2
+	markCaron     = '\u030c' // tone 3
+	markGrave     = '\u0300' // tone 4
+	markDiaeresis = '\u0308' // ü
+)
+
+// Width folds full-width ASCII to half-width and half-width katakana to full-width
+func Width(s string) string {
+	return width.Fold.String(s)
+}
+
+// Text returns s width-folded, NFC-composed, lower-cased and with whitespace collapsed.
+// It keeps diacritics, so it is the form compared against lemmas.
+func Text(s string) string {
+	s = norm.NFC.String(Width(s))
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+// Fold returns Text(s) with diacritics removed and đ mapped to d.
+// It is the lemma_normalized form of Latin-script words ("Nghiên cứu" → "nghien cuu").
+func Fold(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(Text(s)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case r == 'đ':
+			b.WriteRune('d')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Toneless returns a compact key with diacritics, tone numbers, whitespace and
+// apostrophes removed; ü becomes v. Marked, numbered and toneless pinyin
+// ("xuéxí", "xue2 xi2", "xuexi") and folded Vietnamese ("học", "hoc") all map
+// to the same key.
+func Toneless(s string) string {
+	runes := []rune(norm.NFD.String(Text(s)))
+	var b strings.Builder
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == 'u' && i+1 < len(runes) && runes[i+1] == markDiaeresis:
+			b.WriteRune('v')
+			i++
+		case r == 'u' && i+1 < len(runes) && runes[i+1] == ':':
+			b.WriteRune('v')
+			i++
+		case r == 'đ':
+			b.WriteRune('d')
+		case unicode.Is(unicode.Mn, r), unicode.IsDigit(r), unicode.IsSpace(r), r == '\'', r == '’':
+			continue
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Compact removes whitespace and apostrophes, e.g. "xue2 xi2" → "xue2xi2"
+func Compact(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '\'' || r == '’' {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// Keys computes lemma_normalized and search_key for a word the way the seed files do:
+//   - zh: lemma_normalized is the lemma in simplified script ("學習" → "学习"), search_key is
+//     compact numbered pinyin derived from the romanization ("xuéxí" → "xue2xi2"), empty without one
+//   - other languages: both are the folded lemma ("học" → "hoc")
+//
+// scripts may be nil, in which case zh lemmas are kept in their original script.
+func Keys(languageCode, lemma string, romanization *string, scripts Simplifier) (lemmaNormalized, searchKey string) {
+	if languageCode == "zh" {
+		lemmaNormalized = norm.NFC.String(Width(strings.TrimSpace(lemma)))
+		if scripts != nil {
+			lemmaNormalized = scripts.ToSimplified(lemmaNormalized)
+		}
+		if romanization != nil && strings.TrimSpace(*romanization) != "" {
+			searchKey = Compact(PinyinToNumbered(*romanization))
+		}
+		return lemmaNormalized, searchKey
+	}
+
+	folded := Fold(lemma)
+	return folded, folded
+}
+
+// QueryForms holds the variants of a search query matched against the words table
+type QueryForms struct {
+	Text     string // compared with lemma
+	Folded   string // compared with lemma_normalized and search_key; Chinese characters are simplified
+	Numbered string // compact numbered pinyin, compared with search_key
+	Toneless string // compared with search_key stripped of tones and spaces
+}
+
+// Query returns the normalized forms of a user search query. When scripts is not nil,
+// traditional characters in the folded form are simplified so "學習" matches lemma_normalized "学习".
+func Query(q string, scripts Simplifier) QueryForms {
+	text := Text(q)
+	folded := Fold(text)
+	if scripts != nil {
+		folded = scripts.ToSimplified(folded)
+	}
+	return QueryForms{
+		Text:     text,
+		Folded:   folded,
+		Numbered: Compact(PinyinToNumbered(text)),
+		Toneless: Toneless(text),
+	}
+}
@@ -0,0 +1,340 @@
+package normalize
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// pinyinSyllables lists every toneless Mandarin syllable, with ü written as v
+var pinyinSyllables = func() map[string]bool {
+	const list = `
+a ai an ang ao
+ba bai ban bang bao bei ben beng bi bian biao bie bin bing bo bu
+ca cai can cang cao ce cen ceng cha chai chan chang chao che chen cheng chi chong chou
+chu chua chuai chuan chuang chui chun chuo ci cong cou cu cuan cui cun cuo
+da dai dan dang dao de dei den deng di dia dian diao die ding diu dong dou du duan dui dun duo
+e ei en eng er
+fa fan fang fei fen feng fo fou fu
+ga gai gan gang gao ge gei gen geng gong gou gu gua guai guan guang gui gun guo
+ha hai han hang hao he hei hen heng hm hng hong hou hu hua huai huan huang hui hun huo
+ji jia jian jiang jiao jie jin jing jiong jiu ju juan jue jun
+ka kai kan kang kao ke kei ken keng kong kou ku kua kuai kuan kuang kui kun kuo
+la lai lan lang lao le lei leng li lia lian liang liao lie lin ling liu lo long lou
+lu luan lun luo lv lve lue
+m ma mai man mang mao me mei men meng mi mian miao mie min ming miu mo mou mu
+n na nai nan nang nao ne nei nen neng ng ni nian niang niao nie nin ning niu nong nou
+nu nuan nun nuo nv nve nue
+o ou
+pa pai pan pang pao pei pen peng pi pian piao pie pin ping po pou pu
+qi qia qian qiang qiao qie qin qing qiong qiu qu quan que qun
+r ran rang rao re ren reng ri rong rou ru rua ruan rui run ruo
+sa sai san sang sao se sen seng sha shai shan shang shao she shei shen sheng shi shou
+shu shua shuai shuan shuang shui shun shuo si song sou su suan sui sun suo
+ta tai tan tang tao te tei teng ti tian tiao tie ting tong tou tu tuan tui tun tuo
+wa wai wan wang wei wen weng wo wu
+xi xia xian xiang xiao xie xin xing xiong xiu xu xuan xue xun
+ya yan yang yao ye yi yin ying yo yong you yu yuan yue yun
+za zai zan zang zao ze zei zen zeng zha zhai zhan zhang zhao zhe zhei zhen zheng zhi
+zhong zhou zhu zhua zhuai zhuan zhuang zhui zhun zhuo zi zong zou zu zuan zui zun zuo
+`
+	set := make(map[string]bool)
+	for _, s := range strings.Fields(list) {
+		set[s] = true
+	}
+	return set
+}()
+
+// maxSyllableLen is the length of the longest pinyin syllable ("chuang", "zhuang")
+const maxSyllableLen = 6
+
+// toneMarks maps tone numbers 1-4 to combining marks
+var toneMarks = [...]rune{1: markMacron, 2: markAcute, 3: markCaron, 4: markGrave}
+
+// pinyinLetter is one letter of a pinyin run with the tone marked on it, if any
+type pinyinLetter struct {
+	base rune // a-z, with ü as v
+	tone int  // 0 when unmarked
+}
+
+// toneOf returns the tone carried by a combining mark, or 0
+func toneOf(r rune) int {
+	switch r {
+	case markMacron:
+		return 1
+	case markAcute:
+		return 2
+	case markCaron:
+		return 3
+	case markGrave:
+		return 4
+	}
+	return 0
+}
+
+// segmentPinyin splits letters into syllables and returns the end index of each.
+// It prefers the fewest syllables, but avoids vowel-initial syllables after the
+// first (those need an apostrophe: "fangan" is fan-gan) and syllables carrying
+// more than one tone mark ("xīān" is xi-an).
+func segmentPinyin(letters []pinyinLetter) ([]int, bool) {
+	const inf = 1 << 30
+	n := len(letters)
+	cost := make([]int, n+1)
+	prev := make([]int, n+1)
+	for i := 1; i <= n; i++ {
+		cost[i] = inf
+	}
+
+	bases := make([]rune, n)
+	for i, l := range letters {
+		bases[i] = l.base
+	}
+
+	for i := 0; i < n; i++ {
+		if cost[i] == inf {
+			continue
+		}
+		for l := 1; l <= maxSyllableLen && i+l <= n; l++ {
+			if !pinyinSyllables[string(bases[i:i+l])] {
+				continue
+			}
+			c := cost[i] + 1
+			if i > 0 && strings.ContainsRune("aoe", bases[i]) {
+				c += 10
+			}
+			marks := 0
+			for _, letter := range letters[i : i+l] {
+				if letter.tone > 0 {
+					marks++
+				}
+			}
+			if marks > 1 {
+				c += 20 * (marks - 1)
+			}
+			if c < cost[i+l] {
+				cost[i+l] = c
+				prev[i+l] = i
+			}
+		}
+	}
+	if n == 0 || cost[n] == inf {
+		return nil, false
+	}
+
+	var ends []int
+	for i := n; i > 0; i = prev[i] {
+		ends = append(ends, i)
+	}
+	for l, r := 0, len(ends)-1; l < r; l, r = l+1, r-1 {
+		ends[l], ends[r] = ends[r], ends[l]
+	}
+	return ends, true
+}
+
+// isPinyinRune reports whether r belongs to a pinyin letter run after NFD decomposition
+func isPinyinRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || r == ':' || unicode.Is(unicode.Mn, r)
+}
+
+// splitRuns calls fn for each maximal run of pinyin letters and writes everything
+// else to b unchanged, except apostrophes, which only separate syllables
+func splitRuns(s string, b *strings.Builder, fn func(run []rune)) {
+	runes := []rune(norm.NFD.String(Text(s)))
+	start := -1
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && isPinyinRune(runes[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			fn(runes[start:i])
+			start = -1
+		}
+		if i < len(runes) && runes[i] != '\'' && runes[i] != '’' {
+			b.WriteRune(runes[i])
+		}
+	}
+}
+
+// parseRun turns a decomposed run into letters. ok is false when the run carries
+// marks that pinyin does not use, such as Vietnamese diacritics.
+func parseRun(run []rune) (letters []pinyinLetter, marked bool, ok bool) {
+	for i := 0; i < len(run); i++ {
+		r := run[i]
+		switch {
+		case r >= 'a' && r <= 'z':
+			if r == 'u' && i+1 < len(run) && (run[i+1] == markDiaeresis || run[i+1] == ':') {
+				r = 'v'
+				i++
+			}
+			letters = append(letters, pinyinLetter{base: r})
+		case toneOf(r) > 0 && len(letters) > 0:
+			letters[len(letters)-1].tone = toneOf(r)
+			marked = true
+		default:
+			return nil, false, false
+		}
+	}
+	return letters, marked, true
+}
+
+// PinyinToNumbered converts tone-marked pinyin to numbered pinyin: "xuéxí" → "xue2xi2",
+// "lǜ sè" → "lv4 se4". Unmarked syllables in a marked run get the neutral tone 5.
+// Runs that carry no tone marks or are not pinyin are returned unchanged.
+func PinyinToNumbered(s string) string {
+	var b strings.Builder
+	splitRuns(s, &b, func(run []rune) {
+		letters, marked, ok := parseRun(run)
+		if !ok || !marked {
+			b.WriteString(norm.NFC.String(string(run)))
+			return
+		}
+		ends, ok := segmentPinyin(letters)
+		if !ok {
+			b.WriteString(norm.NFC.String(string(run)))
+			return
+		}
+		start := 0
+		for _, end := range ends {
+			tone := 5
+			for _, l := range letters[start:end] {
+				b.WriteRune(l.base)
+				if l.tone > 0 {
+					tone = l.tone
+				}
+			}
+			b.WriteByte(byte('0' + tone))
+			start = end
+		}
+	})
+	return b.String()
+}
+
+// PinyinNumberedToMarked converts numbered pinyin to tone-marked pinyin:
+// "xue2xi2" → "xuéxí", "lv4" → "lǜ". Tones 5 and 0 are neutral and get no mark.
+func PinyinNumberedToMarked(s string) string {
+	var b strings.Builder
+	var chunk []rune
+
+	flush := func(tone int, digit rune) {
+		if len(chunk) == 0 {
+			if digit != 0 {
+				b.WriteRune(digit)
+			}
+			return
+		}
+		letters, _, ok := parseRun(chunk)
+		var ends []int
+		if ok {
+			ends, ok = segmentPinyin(letters)
+		}
+		if !ok {
+			b.WriteString(string(chunk))
+			if digit != 0 {
+				b.WriteRune(digit)
+			}
+			chunk = chunk[:0]
+			return
+		}
+
+		// The number belongs to the last syllable of the chunk
+		start := 0
+		for i, end := range ends {
+			syllableTone := 0
+			if i == len(ends)-1 {
+				syllableTone = tone
+			}
+			b.WriteString(markSyllable(letters[start:end], syllableTone))
+			start = end
+		}
+		chunk = chunk[:0]
+	}
+
+	for _, r := range []rune(norm.NFD.String(Text(s))) {
+		switch {
+		case (r >= 'a' && r <= 'z') || r == ':' || r == markDiaeresis:
+			chunk = append(chunk, r)
+		case r >= '0' && r <= '5':
+			flush(int(r-'0'), r)
+		default:
+			flush(0, 0)
+			if r != '\'' && r != '’' {
+				b.WriteRune(r)
+			}
+		}
+	}
+	flush(0, 0)
+	return b.String()
+}
+
+// markSyllable writes a syllable with its tone mark: on a or e if present, on the o
+// of "ou", otherwise on the last vowel
+func markSyllable(letters []pinyinLetter, tone int) string {
+	at := -1
+	if tone >= 1 && tone <= 4 {
+		for _, target := range []rune{'a', 'e'} {
+			for i, l := range letters {
+				if l.base == target {
+					at = i
+					break
+				}
+			}
+			if at >= 0 {
+				break
+			}
+		}
+		if at < 0 {
+			for i := 0; i+1 < len(letters); i++ {
+				if letters[i].base == 'o' && letters[i+1].base == 'u' {
+					at = i
+					break
+				}
+			}
+		}
+		if at < 0 {
+			for i := len(letters) - 1; i >= 0; i-- {
+				if strings.ContainsRune("iouv", letters[i].base) {
+					at = i
+					break
+				}
+			}
+		}
+	}
+
+	var b strings.Builder
+	for i, l := range letters {
+		if l.base == 'v' {
+			b.WriteRune('u')
+			b.WriteRune(markDiaeresis)
+		} else {
+			b.WriteRune(l.base)
+		}
+		if i == at {
+			b.WriteRune(toneMarks[tone])
+		}
+	}
+	return norm.NFC.String(b.String())
+}
+
+// PinyinToneless removes tone marks and tone numbers from pinyin, keeping spacing:
+// "xuéxí" → "xuexi", "xue2 xi2" → "xue xi", "lǜ" → "lv"
+func PinyinToneless(s string) string {
+	var b strings.Builder
+	runes := []rune(norm.NFD.String(Text(s)))
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == 'u' && i+1 < len(runes) && (runes[i+1] == markDiaeresis || runes[i+1] == ':'):
+			b.WriteRune('v')
+			i++
+		case toneOf(r) > 0, r >= '0' && r <= '5':
+			continue
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return norm.NFC.String(b.String())
+}
@@ -0,0 +1,104 @@
+package normalize
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ScriptPair maps one simplified Chinese character to one traditional character
+type ScriptPair struct {
+	Simplified  string
+	Traditional string
+}
+
+// Simplifier converts traditional Chinese characters to their simplified forms
+type Simplifier interface {
+	ToSimplified(s string) string
+}
+
+// ScriptTable converts Chinese text between simplified and traditional script character by character.
+// A nil table leaves text unchanged.
+type ScriptTable struct {
+	toSimplified  map[rune]rune
+	toTraditional map[rune]rune
+}
+
+// NewScriptTable builds a table from character pairs. Pairs that are not single characters
+// or map a character to itself are skipped. When a character has several counterparts
+// (发 → 發, 髮) the first pair wins, so callers pass the preferred mapping first.
+func NewScriptTable(pairs []ScriptPair) *ScriptTable {
+	t := &ScriptTable{
+		toSimplified:  make(map[rune]rune, len(pairs)),
+		toTraditional: make(map[rune]rune, len(pairs)),
+	}
+	for _, p := range pairs {
+		s, sok := singleRune(p.Simplified)
+		tr, tok := singleRune(p.Traditional)
+		if !sok || !tok || s == tr {
+			continue
+		}
+		if _, ok := t.toSimplified[tr]; !ok {
+			t.toSimplified[tr] = s
+		}
+		if _, ok := t.toTraditional[s]; !ok {
+			t.toTraditional[s] = tr
+		}
+	}
+	return t
+}
+
+// Len returns the number of traditional characters the table can simplify
+func (t *ScriptTable) Len() int {
+	if t == nil {
+		return 0
+	}
+	return len(t.toSimplified)
+}
+
+// ToSimplified replaces traditional characters in s with their simplified forms ("學習" → "学习")
+func (t *ScriptTable) ToSimplified(s string) string {
+	if t == nil {
+		return s
+	}
+	return mapRunes(s, t.toSimplified)
+}
+
+// ToTraditional replaces simplified characters in s with their traditional forms ("学习" → "學習")
+func (t *ScriptTable) ToTraditional(s string) string {
+	if t == nil {
+		return s
+	}
+	return mapRunes(s, t.toTraditional)
+}
+
+// mapRunes replaces each rune of s found in m, returning s itself when nothing changes
+func mapRunes(s string, m map[rune]rune) string {
+	if len(m) == 0 {
+		return s
+	}
+	changed := false
+	for _, r := range s {
+		if _, ok := m[r]; ok {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		if to, ok := m[r]; ok {
+			return to
+		}
+		return r
+	}, s)
+}
+
+// singleRune returns the only rune of s
+func singleRune(s string) (rune, bool) {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError || size != len(s) {
+		return 0, false
+	}
+	return r, true
+}
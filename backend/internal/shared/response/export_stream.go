@@ -0,0 +1,109 @@
+package response
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExportStream writes an export to the response. Headers are sent on the first
+// write, so errors that happen before any data is produced can still be returned
+// as a regular JSON error response.
+type ExportStream struct {
+	c           *gin.Context
+	contentType string
+	filename    string
+	compress    bool
+	gz          *gzip.Writer
+	started     bool
+}
+
+// NewExportStream creates a stream that gzips the body when the client accepts it
+func NewExportStream(c *gin.Context, contentType, filename string) *ExportStream {
+	return &ExportStream{
+		c:           c,
+		contentType: contentType,
+		filename:    filename,
+		compress:    acceptsGzip(c.GetHeader("Accept-Encoding")),
+	}
+}
+
+// start sends the response headers
+func (s *ExportStream) start() {
+	s.started = true
+
+	header := s.c.Writer.Header()
+	header.Set("Content-Type", s.contentType)
+	header.Set("Content-Disposition", `attachment; filename="`+s.filename+`"`)
+	header.Set("Cache-Control", "no-store")
+	header.Add("Vary", "Accept-Encoding")
+	if s.compress {
+		header.Set("Content-Encoding", "gzip")
+		s.gz = gzip.NewWriter(s.c.Writer)
+	}
+	s.c.Status(http.StatusOK)
+	s.c.Writer.WriteHeaderNow()
+}
+
+// Started reports whether headers have been sent
+func (s *ExportStream) Started() bool {
+	return s.started
+}
+
+// Write implements io.Writer
+func (s *ExportStream) Write(p []byte) (int, error) {
+	if !s.started {
+		s.start()
+	}
+	if s.gz != nil {
+		return s.gz.Write(p)
+	}
+	return s.c.Writer.Write(p)
+}
+
+// Flush pushes compressed and buffered data to the client
+func (s *ExportStream) Flush() error {
+	if !s.started {
+		return nil
+	}
+	if s.gz != nil {
+		if err := s.gz.Flush(); err != nil {
+			return err
+		}
+	}
+	s.c.Writer.Flush()
+	return nil
+}
+
+// Close finishes the response, sending headers if nothing was written
+func (s *ExportStream) Close() error {
+	if !s.started {
+		s.start()
+	}
+	if s.gz != nil {
+		return s.gz.Close()
+	}
+	return nil
+}
+
+// acceptsGzip reports whether an Accept-Encoding header allows gzip
+func acceptsGzip(acceptEncoding string) bool {
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.TrimSpace(coding)
+		if coding != "gzip" && coding != "*" {
+			continue
+		}
+		// gzip;q=0 explicitly refuses the encoding
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if v, err := strconv.ParseFloat(q, 64); err == nil && v == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
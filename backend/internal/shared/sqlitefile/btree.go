@@ -0,0 +1,300 @@
+package sqlitefile
+
+import "encoding/binary"
+
+// B-tree page types
+const (
+	pageIndexInterior = 0x02
+	pageTableInterior = 0x05
+	pageIndexLeaf     = 0x0a
+	pageTableLeaf     = 0x0d
+)
+
+const (
+	leafHeaderSize     = 8
+	interiorHeaderSize = 12
+	// cellPointerSize is the size of a cell's entry in the page's cell pointer array
+	cellPointerSize = 2
+)
+
+// indexPayload is an index entry as stored in a cell: the payload size, the part of the payload
+// kept on the page and the first overflow page number, if any. Leaf and interior cells of index
+// b-trees store payloads the same way, so an entry can move up a level unchanged.
+type indexPayload []byte
+
+// allocate reserves the next page and returns its number
+func (w *Writer) allocate() uint32 {
+	w.pages = append(w.pages, nil)
+	return uint32(len(w.pages))
+}
+
+// setPage stores the contents of an allocated page
+func (w *Writer) setPage(pgno uint32, page []byte) {
+	w.pages[pgno-1] = page
+}
+
+// localPayloadSize returns how many payload bytes stay on the b-tree page, following the
+// overflow rules of the file format
+func localPayloadSize(payloadSize int, table bool) int {
+	usable := pageSize
+	maxLocal := (usable-12)*64/255 - 23
+	if table {
+		maxLocal = usable - 35
+	}
+	if payloadSize <= maxLocal {
+		return payloadSize
+	}
+	minLocal := (usable-12)*32/255 - 23
+	local := minLocal + (payloadSize-minLocal)%(usable-4)
+	if local <= maxLocal {
+		return local
+	}
+	return minLocal
+}
+
+// appendPayload appends the local part of a payload to a cell and writes the rest to a chain of
+// overflow pages, appending the number of the first one
+func (w *Writer) appendPayload(cell, payload []byte, table bool) []byte {
+	local := localPayloadSize(len(payload), table)
+	cell = append(cell, payload[:local]...)
+	rest := payload[local:]
+	if len(rest) == 0 {
+		return cell
+	}
+
+	first := w.allocate()
+	cell = binary.BigEndian.AppendUint32(cell, first)
+	pgno := first
+	for {
+		chunk := min(len(rest), pageSize-4)
+		page := make([]byte, pageSize)
+		copy(page[4:], rest[:chunk])
+		rest = rest[chunk:]
+		if len(rest) == 0 {
+			w.setPage(pgno, page)
+			return cell
+		}
+		next := w.allocate()
+		binary.BigEndian.PutUint32(page, next)
+		w.setPage(pgno, page)
+		pgno = next
+	}
+}
+
+// tableLeafCell builds a table leaf cell holding a row
+func (w *Writer) tableLeafCell(rowid int64, record []byte) []byte {
+	cell := appendVarint(nil, uint64(len(record)))
+	cell = appendVarint(cell, uint64(rowid))
+	return w.appendPayload(cell, record, true)
+}
+
+// indexPayload prepares an index entry for a cell
+func (w *Writer) indexPayload(record []byte) indexPayload {
+	cell := appendVarint(nil, uint64(len(record)))
+	return w.appendPayload(cell, record, false)
+}
+
+// tableInteriorCell builds a table interior cell pointing at a child whose largest rowid is key
+func tableInteriorCell(child uint32, key int64) []byte {
+	cell := binary.BigEndian.AppendUint32(nil, child)
+	return appendVarint(cell, uint64(key))
+}
+
+// indexInteriorCell builds an index interior cell holding an entry that sorts after every entry
+// of the child
+func indexInteriorCell(child uint32, entry indexPayload) []byte {
+	cell := binary.BigEndian.AppendUint32(nil, child)
+	return append(cell, entry...)
+}
+
+// cellsSize returns the space cells take on a page including their cell pointers
+func cellsSize(cells [][]byte) int {
+	size := 0
+	for _, cell := range cells {
+		size += len(cell) + cellPointerSize
+	}
+	return size
+}
+
+// buildPage lays out a b-tree page. offset is the start of the b-tree header, which is 100 on
+// page 1 and 0 elsewhere. Cell content is packed at the end of the page in cell order.
+func buildPage(pageType byte, cells [][]byte, rightChild uint32, offset int) []byte {
+	page := make([]byte, pageSize)
+	header := leafHeaderSize
+	if pageType == pageTableInterior || pageType == pageIndexInterior {
+		header = interiorHeaderSize
+		binary.BigEndian.PutUint32(page[offset+8:], rightChild)
+	}
+	page[offset] = pageType
+	binary.BigEndian.PutUint16(page[offset+3:], uint16(len(cells)))
+
+	content := pageSize
+	pointer := offset + header
+	for _, cell := range cells {
+		content -= len(cell)
+		copy(page[content:], cell)
+		binary.BigEndian.PutUint16(page[pointer:], uint16(content))
+		pointer += cellPointerSize
+	}
+	binary.BigEndian.PutUint16(page[offset+5:], uint16(content))
+	return page
+}
+
+// tableChild is a page of a table b-tree with the largest rowid stored under it
+type tableChild struct {
+	pgno   uint32
+	maxKey int64
+}
+
+// buildTableTree packs table leaf cells into leaves, then builds interior levels up to a single
+// root page and returns it
+func (w *Writer) buildTableTree(cells [][]byte, keys []int64) uint32 {
+	var level []tableChild
+	start, used := 0, 0
+	flush := func(end int) {
+		pgno := w.allocate()
+		w.setPage(pgno, buildPage(pageTableLeaf, cells[start:end], 0, 0))
+		maxKey := int64(0)
+		if end > start {
+			maxKey = keys[end-1]
+		}
+		level = append(level, tableChild{pgno: pgno, maxKey: maxKey})
+		start, used = end, 0
+	}
+	for i, cell := range cells {
+		size := len(cell) + cellPointerSize
+		if i > start && leafHeaderSize+used+size > pageSize {
+			flush(i)
+		}
+		used += size
+	}
+	if start < len(cells) || len(level) == 0 {
+		flush(len(cells))
+	}
+
+	for len(level) > 1 {
+		level = w.buildTableInteriorLevel(level)
+	}
+	return level[0].pgno
+}
+
+// buildTableInteriorLevel groups children under interior pages. Every child but the last of a
+// group gets a cell; the last is the right-most pointer, so each group needs two children.
+func (w *Writer) buildTableInteriorLevel(children []tableChild) []tableChild {
+	var groups [][]tableChild
+	start, used := 0, 0
+	for i := 1; i < len(children); i++ {
+		// Adding child i turns child i-1 into a cell
+		size := len(tableInteriorCell(children[i-1].pgno, children[i-1].maxKey)) + cellPointerSize
+		if i-1 > start && interiorHeaderSize+used+size > pageSize {
+			groups = append(groups, children[start:i])
+			start, used = i, 0
+			continue
+		}
+		used += size
+	}
+	groups = append(groups, children[start:])
+
+	// A trailing group of one child would have no cells; borrow the previous group's last child
+	if n := len(groups); n > 1 && len(groups[n-1]) == 1 {
+		prev := groups[n-2]
+		groups[n-2] = prev[:len(prev)-1]
+		groups[n-1] = children[len(children)-2:]
+	}
+
+	parents := make([]tableChild, 0, len(groups))
+	for _, group := range groups {
+		cells := make([][]byte, 0, len(group)-1)
+		for _, child := range group[:len(group)-1] {
+			cells = append(cells, tableInteriorCell(child.pgno, child.maxKey))
+		}
+		last := group[len(group)-1]
+		pgno := w.allocate()
+		w.setPage(pgno, buildPage(pageTableInterior, cells, last.pgno, 0))
+		parents = append(parents, tableChild{pgno: pgno, maxKey: last.maxKey})
+	}
+	return parents
+}
+
+// buildIndexTree packs sorted index entries into a b-tree and returns its root page. Unlike table
+// b-trees, interior cells hold entries of their own: each entry that separates two pages moves up
+// a level and is stored only there.
+func (w *Writer) buildIndexTree(entries []indexPayload) uint32 {
+	var children []uint32
+	var separators []indexPayload
+
+	var cells [][]byte
+	used := 0
+	flush := func() {
+		pgno := w.allocate()
+		w.setPage(pgno, buildPage(pageIndexLeaf, cells, 0, 0))
+		children = append(children, pgno)
+		cells, used = nil, 0
+	}
+	for i := 0; i < len(entries); i++ {
+		size := len(entries[i]) + cellPointerSize
+		if len(cells) > 0 && leafHeaderSize+used+size > pageSize {
+			flush()
+			if i < len(entries)-1 {
+				// The entry after a full leaf separates it from the next one
+				separators = append(separators, entries[i])
+				continue
+			}
+		}
+		cells = append(cells, entries[i])
+		used += size
+	}
+	if len(cells) > 0 || len(children) == 0 {
+		flush()
+	}
+
+	for len(children) > 1 {
+		children, separators = w.buildIndexInteriorLevel(children, separators)
+	}
+	return children[0]
+}
+
+// buildIndexInteriorLevel builds one interior level over children, where separators[i] sorts
+// between children[i] and children[i+1]. It returns the new pages and the separators promoted
+// between them.
+func (w *Writer) buildIndexInteriorLevel(children []uint32, separators []indexPayload) ([]uint32, []indexPayload) {
+	var parents []uint32
+	var promoted []indexPayload
+
+	start := 0
+	for {
+		// Fill a page with cells for children[start:end], pointing right at children[end]
+		var cells [][]byte
+		used := 0
+		end := start
+		for end < len(separators) {
+			cell := indexInteriorCell(children[end], separators[end])
+			size := len(cell) + cellPointerSize
+			if len(cells) > 0 && interiorHeaderSize+used+size > pageSize {
+				break
+			}
+			cells = append(cells, cell)
+			used += size
+			end++
+		}
+
+		if end == len(separators) {
+			pgno := w.allocate()
+			w.setPage(pgno, buildPage(pageIndexInterior, cells, children[end], 0))
+			parents = append(parents, pgno)
+			return parents, promoted
+		}
+
+		// separators[end] moves up. If only one child would follow it, that page would have no
+		// cells, so end this page one cell earlier.
+		if end+1 == len(separators) {
+			end--
+			cells = cells[:len(cells)-1]
+		}
+		pgno := w.allocate()
+		w.setPage(pgno, buildPage(pageIndexInterior, cells, children[end], 0))
+		parents = append(parents, pgno)
+		promoted = append(promoted, separators[end])
+		start = end + 1
+	}
+}
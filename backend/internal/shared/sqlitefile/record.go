@@ -0,0 +1,194 @@
+package sqlitefile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// appendVarint appends v in SQLite's variable-length integer encoding: big-endian groups of seven
+// bits with the high bit set on all but the last byte, where a ninth byte carries eight bits.
+func appendVarint(b []byte, v uint64) []byte {
+	if v <= 0x7f {
+		return append(b, byte(v))
+	}
+	var tmp [9]byte
+	if v > 0x00ffffffffffffff {
+		tmp[8] = byte(v)
+		v >>= 8
+		for i := 7; i >= 0; i-- {
+			tmp[i] = byte(v&0x7f) | 0x80
+			v >>= 7
+		}
+		return append(b, tmp[:]...)
+	}
+	n := 0
+	for v > 0 {
+		tmp[n] = byte(v & 0x7f)
+		v >>= 7
+		n++
+	}
+	for i := n - 1; i >= 0; i-- {
+		c := tmp[i]
+		if i > 0 {
+			c |= 0x80
+		}
+		b = append(b, c)
+	}
+	return b
+}
+
+// varintLen returns the encoded length of v
+func varintLen(v uint64) int {
+	if v > 0x00ffffffffffffff {
+		return 9
+	}
+	n := 1
+	for v > 0x7f {
+		v >>= 7
+		n++
+	}
+	return n
+}
+
+// normalizeValue converts a column value to one of the types stored in records: nil, int64,
+// float64, string or []byte
+func normalizeValue(v any) (any, error) {
+	switch value := v.(type) {
+	case nil, int64, float64, string, []byte:
+		return value, nil
+	case int:
+		return int64(value), nil
+	case int32:
+		return int64(value), nil
+	case int16:
+		return int64(value), nil
+	case bool:
+		if value {
+			return int64(1), nil
+		}
+		return int64(0), nil
+	default:
+		return nil, fmt.Errorf("sqlitefile: unsupported value type %T", v)
+	}
+}
+
+// serialType returns the record serial type of a normalized value and its encoded body
+func serialType(v any) (uint64, []byte) {
+	switch value := v.(type) {
+	case nil:
+		return 0, nil
+	case int64:
+		switch {
+		case value == 0:
+			return 8, nil
+		case value == 1:
+			return 9, nil
+		case value >= math.MinInt8 && value <= math.MaxInt8:
+			return 1, []byte{byte(value)}
+		case value >= math.MinInt16 && value <= math.MaxInt16:
+			return 2, bigEndian(value, 2)
+		case value >= -1<<23 && value < 1<<23:
+			return 3, bigEndian(value, 3)
+		case value >= math.MinInt32 && value <= math.MaxInt32:
+			return 4, bigEndian(value, 4)
+		case value >= -1<<47 && value < 1<<47:
+			return 5, bigEndian(value, 6)
+		default:
+			return 6, bigEndian(value, 8)
+		}
+	case float64:
+		body := make([]byte, 8)
+		binary.BigEndian.PutUint64(body, math.Float64bits(value))
+		return 7, body
+	case string:
+		return uint64(13 + 2*len(value)), []byte(value)
+	case []byte:
+		return uint64(12 + 2*len(value)), value
+	}
+	panic(fmt.Sprintf("sqlitefile: unnormalized value %T", v))
+}
+
+// bigEndian encodes the low n bytes of a two's complement integer
+func bigEndian(v int64, n int) []byte {
+	body := make([]byte, n)
+	u := uint64(v)
+	for i := n - 1; i >= 0; i-- {
+		body[i] = byte(u)
+		u >>= 8
+	}
+	return body
+}
+
+// encodeRecord encodes normalized values in the record format: a header of serial types followed
+// by the value bodies
+func encodeRecord(values []any) []byte {
+	types := make([]byte, 0, len(values))
+	var body []byte
+	for _, v := range values {
+		t, b := serialType(v)
+		types = appendVarint(types, t)
+		body = append(body, b...)
+	}
+
+	// The header size counts its own varint
+	headerSize := len(types) + 1
+	for varintLen(uint64(headerSize)) != headerSize-len(types) {
+		headerSize = len(types) + varintLen(uint64(headerSize))
+	}
+
+	record := make([]byte, 0, headerSize+len(body))
+	record = appendVarint(record, uint64(headerSize))
+	record = append(record, types...)
+	return append(record, body...)
+}
+
+// compareValues orders normalized values like SQLite with the BINARY collation: NULLs first, then
+// numbers, then text, then blobs
+func compareValues(a, b any) int {
+	ca, cb := valueClass(a), valueClass(b)
+	if ca != cb {
+		return ca - cb
+	}
+	switch av := a.(type) {
+	case int64:
+		if bv, ok := b.(int64); ok {
+			return compareOrdered(av, bv)
+		}
+		return compareOrdered(float64(av), b.(float64))
+	case float64:
+		if bv, ok := b.(int64); ok {
+			return compareOrdered(av, float64(bv))
+		}
+		return compareOrdered(av, b.(float64))
+	case string:
+		return bytes.Compare([]byte(av), []byte(b.(string)))
+	case []byte:
+		return bytes.Compare(av, b.([]byte))
+	}
+	return 0
+}
+
+func valueClass(v any) int {
+	switch v.(type) {
+	case nil:
+		return 0
+	case int64, float64:
+		return 1
+	case string:
+		return 2
+	default:
+		return 3
+	}
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,203 @@
+// Package sqlitefile writes SQLite database files without a SQLite driver. It builds a complete,
+// read-only snapshot in memory: tables and indexes are declared, rows are inserted, and WriteTo
+// lays out the b-trees. It is meant for exchange formats that happen to be SQLite files, such as
+// Anki packages, not as a general database engine.
+package sqlitefile
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+const (
+	// pageSize is the size of every database page; no bytes are reserved at the end of pages
+	pageSize = 4096
+	// headerSize is the size of the database header at the start of page 1
+	headerSize = 100
+	// sqliteVersion is the library version recorded in the header, 3.45.0
+	sqliteVersion = 3045000
+)
+
+// ErrSchemaTooLarge is returned when the schema does not fit on the first page
+var ErrSchemaTooLarge = errors.New("sqlitefile: schema does not fit on the first page")
+
+// Writer builds a SQLite database file in memory
+type Writer struct {
+	objects []schemaObject
+	pages   [][]byte
+}
+
+// schemaObject is a table or an index, in the order it is recorded in sqlite_master
+type schemaObject struct {
+	table *Table
+	index *index
+}
+
+// Table is a rowid table of a database being written
+type Table struct {
+	name string
+	sql  string
+	rows []row
+}
+
+// row is a table row with normalized column values
+type row struct {
+	rowid  int64
+	values []any
+}
+
+// index is an index over columns of a table
+type index struct {
+	name    string
+	table   *Table
+	sql     string
+	columns []int
+}
+
+// NewWriter creates an empty database
+func NewWriter() *Writer {
+	return &Writer{}
+}
+
+// CreateTable declares a table with its CREATE TABLE statement. The statement is stored as the
+// schema; the writer does not parse it, so column values must already have the types the
+// declared affinities would give them.
+func (w *Writer) CreateTable(name, sql string) *Table {
+	table := &Table{name: name, sql: sql}
+	w.objects = append(w.objects, schemaObject{table: table})
+	return table
+}
+
+// CreateIndex declares an index with its CREATE INDEX statement over the given zero-based
+// column positions of table
+func (w *Writer) CreateIndex(name string, table *Table, sql string, columns ...int) {
+	idx := &index{name: name, table: table, sql: sql, columns: columns}
+	w.objects = append(w.objects, schemaObject{index: idx})
+}
+
+// Insert adds a row. Values are nil, integers, float64, string or []byte, one per column. A column
+// declared INTEGER PRIMARY KEY aliases the rowid and must be given as nil.
+func (t *Table) Insert(rowid int64, values ...any) error {
+	normalized := make([]any, len(values))
+	for i, v := range values {
+		value, err := normalizeValue(v)
+		if err != nil {
+			return fmt.Errorf("insert into %s: %w", t.name, err)
+		}
+		normalized[i] = value
+	}
+	t.rows = append(t.rows, row{rowid: rowid, values: normalized})
+	return nil
+}
+
+// WriteTo lays out the database and writes the file to out. Rows are sorted by rowid; duplicate
+// rowids are an error.
+func (w *Writer) WriteTo(out io.Writer) (int64, error) {
+	// Page 1 holds the header and sqlite_master; it is filled in once the root pages are known
+	w.pages = [][]byte{nil}
+
+	master := make([][]byte, 0, len(w.objects))
+	for i, obj := range w.objects {
+		var kind, name, tableName, sql string
+		var root uint32
+		var err error
+		if obj.table != nil {
+			kind, name, tableName, sql = "table", obj.table.name, obj.table.name, obj.table.sql
+			root, err = w.writeTable(obj.table)
+		} else {
+			kind, name, tableName, sql = "index", obj.index.name, obj.index.table.name, obj.index.sql
+			root, err = w.writeIndex(obj.index)
+		}
+		if err != nil {
+			return 0, err
+		}
+		record := encodeRecord([]any{kind, name, tableName, int64(root), sql})
+		master = append(master, w.tableLeafCell(int64(i+1), record))
+	}
+
+	if headerSize+8+cellsSize(master) > pageSize {
+		return 0, ErrSchemaTooLarge
+	}
+	page := buildPage(pageTableLeaf, master, 0, headerSize)
+	w.writeHeader(page)
+	w.pages[0] = page
+
+	var written int64
+	for _, p := range w.pages {
+		n, err := out.Write(p)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// writeHeader fills in the database header of page 1
+func (w *Writer) writeHeader(page []byte) {
+	copy(page, "SQLite format 3\x00")
+	binary.BigEndian.PutUint16(page[16:], pageSize)
+	page[18] = 1                             // legacy rollback journal write version
+	page[19] = 1                             // legacy rollback journal read version
+	page[20] = 0                             // reserved bytes per page
+	page[21] = 64                            // maximum embedded payload fraction
+	page[22] = 32                            // minimum embedded payload fraction
+	page[23] = 32                            // leaf payload fraction
+	binary.BigEndian.PutUint32(page[24:], 1) // file change counter
+	binary.BigEndian.PutUint32(page[28:], uint32(len(w.pages)))
+	binary.BigEndian.PutUint32(page[40:], 1) // schema cookie
+	binary.BigEndian.PutUint32(page[44:], 4) // schema format
+	binary.BigEndian.PutUint32(page[56:], 1) // UTF-8 text encoding
+	binary.BigEndian.PutUint32(page[92:], 1) // version-valid-for, equal to the change counter
+	binary.BigEndian.PutUint32(page[96:], sqliteVersion)
+}
+
+// writeTable writes the b-tree of a table and returns its root page
+func (w *Writer) writeTable(t *Table) (uint32, error) {
+	slices.SortStableFunc(t.rows, func(a, b row) int { return compareOrdered(a.rowid, b.rowid) })
+	for i := 1; i < len(t.rows); i++ {
+		if t.rows[i].rowid == t.rows[i-1].rowid {
+			return 0, fmt.Errorf("sqlitefile: duplicate rowid %d in %s", t.rows[i].rowid, t.name)
+		}
+	}
+
+	cells := make([][]byte, len(t.rows))
+	keys := make([]int64, len(t.rows))
+	for i, r := range t.rows {
+		cells[i] = w.tableLeafCell(r.rowid, encodeRecord(r.values))
+		keys[i] = r.rowid
+	}
+	return w.buildTableTree(cells, keys), nil
+}
+
+// writeIndex writes the b-tree of an index and returns its root page
+func (w *Writer) writeIndex(idx *index) (uint32, error) {
+	entries := make([][]any, len(idx.table.rows))
+	for i, r := range idx.table.rows {
+		entry := make([]any, 0, len(idx.columns)+1)
+		for _, col := range idx.columns {
+			if col < 0 || col >= len(r.values) {
+				return 0, fmt.Errorf("sqlitefile: index %s column %d out of range", idx.name, col)
+			}
+			entry = append(entry, r.values[col])
+		}
+		entries[i] = append(entry, r.rowid)
+	}
+	slices.SortStableFunc(entries, func(a, b []any) int {
+		for i := range a {
+			if c := compareValues(a[i], b[i]); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+
+	payloads := make([]indexPayload, len(entries))
+	for i, entry := range entries {
+		payloads[i] = w.indexPayload(encodeRecord(entry))
+	}
+	return w.buildIndexTree(payloads), nil
+}
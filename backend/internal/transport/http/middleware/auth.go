@@ -51,11 +51,51 @@ func AuthMiddleware(jwtManager *auth.JWTManager) gin.HandlerFunc {
 			return
 		}
 
-		// Store claims in context
-		c.Set("user_id", claims.UserID)
-		c.Set("username", claims.Username)
-		c.Set("claims", claims)
+		setClaims(c, claims)
+		c.Next()
+	}
+}
 
+// OptionalAuthMiddleware creates a Gin middleware for routes open to everyone that personalize
+// their response for logged-in users. A valid bearer token sets the same context values as
+// AuthMiddleware; a missing or invalid one lets the request through anonymously.
+func OptionalAuthMiddleware(jwtManager *auth.JWTManager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, found := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if found {
+			if claims, err := jwtManager.ValidateToken(token); err == nil {
+				setClaims(c, claims)
+			}
+		}
 		c.Next()
 	}
 }
+
+// setClaims stores the claims of a validated token in the context
+func setClaims(c *gin.Context, claims *auth.Claims) {
+	c.Set("user_id", claims.UserID)
+	c.Set("username", claims.Username)
+	c.Set("role", claims.Role)
+	c.Set("claims", claims)
+}
+
+// RequireRole creates a Gin middleware that only lets through users with one of the given roles.
+// It must run after AuthMiddleware.
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := c.GetString("role")
+		for _, allowed := range roles {
+			if role == allowed {
+				c.Next()
+				return
+			}
+		}
+
+		c.JSON(http.StatusForbidden, response.NewError(
+			"FORBIDDEN",
+			"Không có quyền truy cập",
+			nil,
+		))
+		c.Abort()
+	}
+}
@@ -3,7 +3,7 @@
  */
 
 import { httpClient } from '@/shared/api/http-client';
-import type { Language, Topic, Level, Word, WordDetail, WordSearchResponse } from '../model/dictionary.types';
+import type { Language, Topic, Level, WordDetail, WordSearchResponse, WordSearchResult, WordSuggestResponse, ReverseLookupResponse, TextSearchHit, TextSearchResponse, TextSearchSource, CharacterDetail, CharacterSearchResult, CharacterSearchResponse, ChineseScript, WordGraph, WordGraphEdgeType, WordBrowseFilters, WordBrowseItem, WordBrowseResponse } from '../model/dictionary.types';
 
 export interface ApiResponse<T> {
   success: boolean;
@@ -68,7 +68,7 @@ export const dictionaryEndpoints = {
       limit: limit.toString(),
       offset: offset.toString(),
     });
-    const response = await httpClient.get<PaginatedApiResponse<Word[]>>(
+    const response = await httpClient.get<PaginatedApiResponse<WordSearchResult[]>>(
       `/dictionary/search?${params.toString()}`
     );
     // Transform response to match WordSearchResponse interface
@@ -78,14 +78,175 @@ export const dictionaryEndpoints = {
     };
   },
 
+  /**
+   * Search definitions and example sentences
+   */
+  searchText: async (
+    query: string,
+    languageId: number,
+    sources: TextSearchSource[] = [],
+    limit: number = 20,
+    offset: number = 0
+  ): Promise<TextSearchResponse> => {
+    const params = new URLSearchParams({
+      q: query,
+      languageId: languageId.toString(),
+      limit: limit.toString(),
+      offset: offset.toString(),
+    });
+    if (sources.length > 0) {
+      params.set('sources', sources.join(','));
+    }
+    const response = await httpClient.get<PaginatedApiResponse<TextSearchHit[]>>(
+      `/dictionary/search/text?${params.toString()}`
+    );
+    return {
+      hits: response.data || [],
+      pagination: response.pagination,
+    };
+  },
+
+  /**
+   * Autocomplete suggestions for a search box
+   */
+  suggestWords: async (
+    query: string,
+    languageId: number,
+    limit: number = 10
+  ): Promise<WordSuggestResponse> => {
+    const params = new URLSearchParams({
+      q: query,
+      languageId: languageId.toString(),
+      limit: limit.toString(),
+    });
+    const response = await httpClient.get<ApiResponse<WordSuggestResponse>>(
+      `/dictionary/suggest?${params.toString()}`
+    );
+    return response.data;
+  },
+
+  /**
+   * Reverse lookup: find the source words a word translates
+   */
+  reverseLookup: async (
+    query: string,
+    languageId: number,
+    sourceLanguageId?: number,
+    limit: number = 50
+  ): Promise<ReverseLookupResponse> => {
+    const params = new URLSearchParams({
+      q: query,
+      languageId: languageId.toString(),
+      limit: limit.toString(),
+    });
+    if (sourceLanguageId) {
+      params.set('sourceLanguageId', sourceLanguageId.toString());
+    }
+    const response = await httpClient.get<ApiResponse<ReverseLookupResponse>>(
+      `/dictionary/reverse?${params.toString()}`
+    );
+    return response.data;
+  },
+
   /**
    * Get word detail by ID
    */
-  getWordDetail: async (wordId: number): Promise<WordDetail> => {
+  getWordDetail: async (wordId: number, script?: ChineseScript): Promise<WordDetail> => {
+    const query = script ? `?script=${script}` : '';
     const response = await httpClient.get<ApiResponse<WordDetail>>(
-      `/dictionary/words/${wordId}`
+      `/dictionary/words/${wordId}${query}`
     );
     return response.data;
   },
-};
 
+  /**
+   * Get the graph of words reachable from a word through relations, translations and shared characters
+   */
+  getWordGraph: async (wordId: number, depth: number = 2, types?: WordGraphEdgeType[]): Promise<WordGraph> => {
+    const params = new URLSearchParams({ depth: depth.toString() });
+    if (types && types.length > 0) {
+      params.set('types', types.join(','));
+    }
+    const response = await httpClient.get<ApiResponse<WordGraph>>(
+      `/dictionary/words/${wordId}/graph?${params.toString()}`
+    );
+    return response.data;
+  },
+
+  /**
+   * Get a character with its readings and the words containing it
+   */
+  getCharacter: async (literal: string, wordsLimit: number = 50): Promise<CharacterDetail> => {
+    const params = new URLSearchParams({ wordsLimit: wordsLimit.toString() });
+    const response = await httpClient.get<ApiResponse<CharacterDetail>>(
+      `/dictionary/characters/${encodeURIComponent(literal)}?${params.toString()}`
+    );
+    return response.data;
+  },
+
+  /**
+   * Browse characters by radical and/or stroke count
+   */
+  searchCharacters: async (
+    filters: { radical?: string; strokes?: number },
+    limit: number = 50,
+    offset: number = 0
+  ): Promise<CharacterSearchResponse> => {
+    const params = new URLSearchParams({
+      limit: limit.toString(),
+      offset: offset.toString(),
+    });
+    if (filters.radical) {
+      params.set('radical', filters.radical);
+    }
+    if (filters.strokes) {
+      params.set('strokes', filters.strokes.toString());
+    }
+    const response = await httpClient.get<PaginatedApiResponse<CharacterSearchResult[]>>(
+      `/dictionary/characters?${params.toString()}`
+    );
+    return {
+      characters: response.data || [],
+      pagination: response.pagination,
+    };
+  },
+
+  /**
+   * Browse words by language, topic, level and part of speech
+   */
+  browseWords: async (
+    filters: WordBrowseFilters,
+    limit: number = 20,
+    offset: number = 0
+  ): Promise<WordBrowseResponse> => {
+    const params = new URLSearchParams({
+      limit: limit.toString(),
+      offset: offset.toString(),
+    });
+    if (filters.languageId) {
+      params.set('languageId', filters.languageId.toString());
+    }
+    if (filters.topicId) {
+      params.set('topicId', filters.topicId.toString());
+    }
+    if (filters.levelId) {
+      params.set('levelId', filters.levelId.toString());
+    }
+    if (filters.pos) {
+      params.set('pos', filters.pos);
+    }
+    if (filters.translationLanguageId) {
+      params.set('translationLanguageId', filters.translationLanguageId.toString());
+    }
+    if (filters.sort) {
+      params.set('sort', filters.sort);
+    }
+    const response = await httpClient.get<PaginatedApiResponse<WordBrowseItem[]>>(
+      `/dictionary/words?${params.toString()}`
+    );
+    return {
+      words: response.data || [],
+      pagination: response.pagination,
+    };
+  },
+};
@@ -4,7 +4,7 @@
 
 import { useQuery } from '@tanstack/react-query';
 import { dictionaryEndpoints } from './dictionary.endpoints';
-import type { Language, Topic, Level, WordDetail, WordSearchResponse } from '../model/dictionary.types';
+import type { Language, Topic, Level, WordDetail, WordSearchResponse, WordSuggestResponse, ReverseLookupResponse, TextSearchResponse, TextSearchSource, CharacterDetail, CharacterSearchResponse, ChineseScript, WordGraph, WordGraphEdgeType, WordBrowseFilters, WordBrowseResponse } from '../model/dictionary.types';
 
 export const dictionaryQueries = {
   /**
@@ -18,8 +18,22 @@ export const dictionaryQueries = {
       [...dictionaryQueries.keys.all, 'levels', languageId] as const,
     search: (query: string, languageId: number, limit?: number, offset?: number) =>
       [...dictionaryQueries.keys.all, 'search', query, languageId, limit, offset] as const,
-    wordDetail: (wordId: number) =>
-      [...dictionaryQueries.keys.all, 'word', wordId] as const,
+    searchText: (query: string, languageId: number, sources?: TextSearchSource[], limit?: number, offset?: number) =>
+      [...dictionaryQueries.keys.all, 'searchText', query, languageId, sources, limit, offset] as const,
+    suggest: (query: string, languageId: number, limit?: number) =>
+      [...dictionaryQueries.keys.all, 'suggest', query, languageId, limit] as const,
+    reverse: (query: string, languageId: number, sourceLanguageId?: number) =>
+      [...dictionaryQueries.keys.all, 'reverse', query, languageId, sourceLanguageId] as const,
+    wordDetail: (wordId: number, script?: ChineseScript) =>
+      [...dictionaryQueries.keys.all, 'word', wordId, script] as const,
+    wordGraph: (wordId: number, depth?: number, types?: WordGraphEdgeType[]) =>
+      [...dictionaryQueries.keys.all, 'wordGraph', wordId, depth, types] as const,
+    browse: (filters: WordBrowseFilters, limit?: number, offset?: number) =>
+      [...dictionaryQueries.keys.all, 'browse', filters, limit, offset] as const,
+    character: (literal: string, wordsLimit?: number) =>
+      [...dictionaryQueries.keys.all, 'character', literal, wordsLimit] as const,
+    characters: (radical?: string, strokes?: number, limit?: number, offset?: number) =>
+      [...dictionaryQueries.keys.all, 'characters', radical, strokes, limit, offset] as const,
   },
 
   /**
@@ -71,15 +85,114 @@ export const dictionaryQueries = {
     });
   },
 
+  /**
+   * Search definitions and example sentences
+   */
+  useSearchText: (
+    query: string,
+    languageId: number,
+    sources: TextSearchSource[] = [],
+    limit: number = 20,
+    offset: number = 0,
+    enabled: boolean = true
+  ) => {
+    return useQuery<TextSearchResponse>({
+      queryKey: dictionaryQueries.keys.searchText(query, languageId, sources, limit, offset),
+      queryFn: () => dictionaryEndpoints.searchText(query, languageId, sources, limit, offset),
+      enabled: enabled && query.trim().length > 0 && !!languageId,
+      staleTime: 30 * 1000, // 30 seconds
+    });
+  },
+
+  /**
+   * Autocomplete suggestions
+   */
+  useSuggestWords: (
+    query: string,
+    languageId: number,
+    limit: number = 10,
+    enabled: boolean = true
+  ) => {
+    return useQuery<WordSuggestResponse>({
+      queryKey: dictionaryQueries.keys.suggest(query, languageId, limit),
+      queryFn: () => dictionaryEndpoints.suggestWords(query, languageId, limit),
+      enabled: enabled && query.trim().length > 0 && !!languageId,
+      staleTime: 60 * 1000, // 1 minute
+    });
+  },
+
+  /**
+   * Reverse translation lookup
+   */
+  useReverseLookup: (
+    query: string,
+    languageId: number,
+    sourceLanguageId?: number,
+    enabled: boolean = true
+  ) => {
+    return useQuery<ReverseLookupResponse>({
+      queryKey: dictionaryQueries.keys.reverse(query, languageId, sourceLanguageId),
+      queryFn: () => dictionaryEndpoints.reverseLookup(query, languageId, sourceLanguageId),
+      enabled: enabled && query.trim().length > 0 && !!languageId,
+      staleTime: 30 * 1000, // 30 seconds
+    });
+  },
+
   /**
    * Get word detail by ID
    */
-  useWordDetail: (wordId: number) => {
+  useWordDetail: (wordId: number, script?: ChineseScript) => {
     return useQuery<WordDetail>({
-      queryKey: dictionaryQueries.keys.wordDetail(wordId),
-      queryFn: () => dictionaryEndpoints.getWordDetail(wordId),
+      queryKey: dictionaryQueries.keys.wordDetail(wordId, script),
+      queryFn: () => dictionaryEndpoints.getWordDetail(wordId, script),
       enabled: !!wordId && wordId > 0,
     });
   },
-};
 
+  /**
+   * Get the word graph around a word
+   */
+  useWordGraph: (wordId: number, depth: number = 2, types?: WordGraphEdgeType[]) => {
+    return useQuery<WordGraph>({
+      queryKey: dictionaryQueries.keys.wordGraph(wordId, depth, types),
+      queryFn: () => dictionaryEndpoints.getWordGraph(wordId, depth, types),
+      enabled: !!wordId && wordId > 0,
+    });
+  },
+
+  /**
+   * Get character detail
+   */
+  useCharacter: (literal: string, wordsLimit: number = 50) => {
+    return useQuery<CharacterDetail>({
+      queryKey: dictionaryQueries.keys.character(literal, wordsLimit),
+      queryFn: () => dictionaryEndpoints.getCharacter(literal, wordsLimit),
+      enabled: literal.trim().length > 0,
+    });
+  },
+
+  /**
+   * Browse words by language, topic, level and part of speech
+   */
+  useBrowseWords: (filters: WordBrowseFilters, limit: number = 20, offset: number = 0) => {
+    return useQuery<WordBrowseResponse>({
+      queryKey: dictionaryQueries.keys.browse(filters, limit, offset),
+      queryFn: () => dictionaryEndpoints.browseWords(filters, limit, offset),
+    });
+  },
+
+  /**
+   * Browse characters by radical and/or stroke count
+   */
+  useSearchCharacters: (
+    filters: { radical?: string; strokes?: number },
+    limit: number = 50,
+    offset: number = 0
+  ) => {
+    return useQuery<CharacterSearchResponse>({
+      queryKey: dictionaryQueries.keys.characters(filters.radical, filters.strokes, limit, offset),
+      queryFn: () => dictionaryEndpoints.searchCharacters(filters, limit, offset),
+      enabled: !!filters.radical || !!filters.strokes,
+    });
+  },
+};
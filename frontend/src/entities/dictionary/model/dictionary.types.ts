@@ -87,6 +87,7 @@ export interface SenseDetail {
   part_of_speech_name?: string;
   definition: string;
   definition_language_id: number;
+  definition_language_name?: string;
   level_id?: number;
   level_name?: string;
   note?: string;
@@ -99,6 +100,15 @@ export interface WordDetail {
   senses: SenseDetail[];
   pronunciations: Pronunciation[];
   relations?: WordRelation[];
+  script_forms?: ScriptForms; // Chinese words whose spellings differ by script
+  display_lemma?: string; // lemma in the script requested with ?script=
+}
+
+export type ChineseScript = 'simplified' | 'traditional';
+
+export interface ScriptForms {
+  simplified: string;
+  traditional: string;
 }
 
 export interface PaginationMetadata {
@@ -118,8 +128,172 @@ export interface PaginatedResponse<T> {
   pagination: PaginationMetadata;
 }
 
+export type WordMatchType = 'exact' | 'prefix' | 'normalized' | 'substring' | 'fuzzy';
+
+export interface WordSearchResult extends Word {
+  match_type: WordMatchType;
+  score: number; // similarity to the query, 0..1
+}
+
 export interface WordSearchResponse {
-  words: Word[];
+  words: WordSearchResult[];
   pagination: PaginationMetadata;
 }
 
+export type TextSearchSource = 'definition' | 'example' | 'example_translation';
+
+export interface TextSearchHit {
+  source: TextSearchSource;
+  word_id: number;
+  word_language_id: number;
+  lemma: string;
+  romanization?: string;
+  sense_id: number;
+  sense_order: number;
+  example_id?: number;
+  snippet: string; // HTML-escaped, matches wrapped in <mark>
+  rank: number;
+}
+
+export interface TextSearchResponse {
+  hits: TextSearchHit[];
+  pagination: PaginationMetadata;
+}
+
+export type SuggestMatchField = 'lemma' | 'search_key' | 'romanization';
+
+export interface WordSuggestion {
+  word_id: number;
+  language_id: number;
+  lemma: string;
+  romanization?: string;
+  frequency_rank?: number;
+  matched_on: SuggestMatchField;
+}
+
+export interface ReverseTranslation {
+  source_word: Word;
+  sense: Sense;
+  priority?: number;
+  translated_word_id: number;
+  translated_lemma: string;
+}
+
+export interface ReverseLookupGroup {
+  language: Language;
+  results: ReverseTranslation[];
+}
+
+export interface ReverseLookupResponse {
+  query: string;
+  groups: ReverseLookupGroup[];
+}
+
+export interface WordSuggestResponse {
+  suggestions: WordSuggestion[];
+  source: 'index' | 'database';
+}
+
+export interface Character {
+  id: number;
+  literal: string;
+  simplified?: string;
+  traditional?: string;
+  script_code: string;
+  strokes?: number;
+  radical?: string;
+  level_id?: number;
+}
+
+export interface CharacterReading {
+  id: number;
+  character_id: number;
+  language_id: number;
+  reading: string;
+  reading_type?: string;
+  note?: string;
+}
+
+export interface CharacterReadingGroup {
+  language: Language;
+  readings: CharacterReading[];
+}
+
+export interface CharacterWord {
+  word: Word;
+  char_order: number;
+}
+
+export interface CharacterDetail {
+  character: Character;
+  level?: Level;
+  readings: CharacterReadingGroup[];
+  words: CharacterWord[];
+  words_total: number;
+}
+
+export interface CharacterSearchResult extends Character {
+  readings: CharacterReading[];
+}
+
+export interface CharacterSearchResponse {
+  characters: CharacterSearchResult[];
+  pagination: PaginationMetadata;
+}
+
+export type WordGraphEdgeType = 'synonym' | 'antonym' | 'related' | 'translation' | 'shared_character';
+
+export interface WordGraphNode {
+  id: number;
+  label: string;
+  language_id: number;
+  romanization?: string;
+  frequency_rank?: number;
+  depth: number; // hops from the root word
+}
+
+export interface WordGraphEdge {
+  id: string;
+  source: number;
+  target: number;
+  type: WordGraphEdgeType;
+  note?: string;
+  priority?: number;
+  character_id?: number;
+  character?: string;
+}
+
+export interface WordGraph {
+  root_id: number;
+  depth: number;
+  nodes: WordGraphNode[];
+  edges: WordGraphEdge[];
+  truncated: boolean;
+}
+
+export type WordSort = 'frequency' | 'alpha';
+
+export interface WordBrowseFilters {
+  languageId?: number;
+  topicId?: number;
+  levelId?: number;
+  pos?: string; // part of speech code
+  translationLanguageId?: number;
+  sort?: WordSort;
+}
+
+export interface WordGloss {
+  sense_id: number;
+  definition: string;
+  translation_word_id?: number;
+  translation?: string;
+}
+
+export interface WordBrowseItem extends Word {
+  gloss?: WordGloss;
+}
+
+export interface WordBrowseResponse {
+  words: WordBrowseItem[];
+  pagination: PaginationMetadata;
+}
@@ -11,12 +11,15 @@ export interface User {
   is_active: boolean;
 }
 
+export type ChineseScript = 'simplified' | 'traditional';
+
 export interface UserProfile {
   user_id: number;
   display_name?: string;
   avatar_url?: string;
   birth_day?: string; // YYYY-MM-DD format
   bio?: string;
+  preferred_script?: ChineseScript;
   created_at: string;
   updated_at: string;
 }
@@ -45,13 +48,17 @@ export interface LoginResponse {
   user_id: number;
   email?: string;
   username?: string;
+  role?: UserRole;
 }
 
+export type UserRole = 'learner' | 'editor' | 'admin';
+
 export interface UpdateProfileRequest {
   display_name?: string;
   avatar_url?: string;
   birth_day?: string; // YYYY-MM-DD format
   bio?: string;
+  preferred_script?: ChineseScript;
 }
 
 export interface UpdateProfileResponse {
@@ -60,4 +67,5 @@ export interface UpdateProfileResponse {
   avatar_url?: string;
   birth_day?: string;
   bio?: string;
+  preferred_script?: ChineseScript;
 }
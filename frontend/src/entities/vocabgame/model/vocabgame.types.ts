@@ -8,7 +8,7 @@ export interface VocabGameSession {
   mode: 'level'; // Always 'level' now
   source_language_id: number;
   target_language_id: number;
-  topic_id?: number; // Kept for backward compatibility, but not used for filtering
+  topic_ids: number[]; // Empty means all topics
   level_id?: number; // Required
   total_questions: number;
   correct_questions: number;
@@ -89,6 +89,41 @@ export interface SubmitAnswerRequest {
   response_time_ms?: number;
 }
 
+export interface SessionReviewOption {
+  id: number;
+  option_label: 'A' | 'B' | 'C' | 'D';
+  target_word_id: number;
+  word_text: string;
+}
+
+export interface SessionQuestionReview {
+  question_id: number;
+  question_order: number;
+  question_type: string;
+  source_word_id: number;
+  source_word_text: string;
+  correct_option: SessionReviewOption;
+  chosen_option: SessionReviewOption | null; // null when unanswered
+  is_correct: boolean;
+  response_time_ms?: number;
+  answered_at?: string;
+  sense?: {
+    id: number;
+    sense_order: number;
+    definition: string;
+  };
+  example?: {
+    id: number;
+    content: string;
+    translations: { language: string; content: string }[];
+  };
+}
+
+export interface VocabGameSessionReview {
+  session: VocabGameSession;
+  questions: SessionQuestionReview[];
+}
+
 export interface SessionStatistics {
   session_id: number;
   total_questions: number;
@@ -34,6 +34,8 @@ DATA_INIT=false
 DATA_WORD_EN=false
 DATA_WORD_VI=false
 DATA_WORD_ZH=false
+DRY_RUN=false
+VALIDATE=false
 HELP=false
 
 show_help() {
@@ -51,11 +53,16 @@ show_help() {
     echo "  --data-word-en         Run data migration with --word-en flag (English words only)"
     echo "  --data-word-vi         Run data migration with --word-vi flag (Vietnamese words only)"
     echo "  --data-word-zh         Run data migration with --word-zh flag (Chinese words only)"
+    echo "  --dry-run              Run data migration in a transaction and roll it back"
+    echo "  --validate             Validate the word seed files before the data migration"
+    echo "  --bulk                 Seed word files with COPY and parallel workers (large files)"
+    echo "  --force                Re-apply every word file entry, not only new or changed ones"
+    echo "  --prune                Delete words whose entries were removed from their word file"
     echo "  --help, -h             Show this help message"
     echo ""
     echo "Default behavior (no flags):"
     echo "  - Run schema migration"
-    echo "  - Then run all data migrations (init + word-en + word-vi + word-zh)"
+    echo "  - Then run all data migrations (init + every word file, new or changed entries only)"
     echo ""
     echo "Examples:"
     echo "  $0 dev                                 # Schema + all data for dev (default)"
@@ -65,6 +72,8 @@ show_help() {
     echo "  $0 dev --data-init                     # Data init only for dev"
     echo "  $0 prod --data-word-en                 # English words only for prod"
     echo "  $0 dev --data-init --data-word-en      # Init + English words for dev"
+    echo "  $0 prod --data-only --validate --dry-run # Check the seed against prod without writing"
+    echo "  $0 dev --data-only --bulk              # All data for dev through the bulk path"
     echo ""
     echo "Note:"
     echo "  Database connection is configured from deploy/env/{ENV}/backend.env"
@@ -100,6 +109,26 @@ while [[ $# -gt 0 ]]; do
             DATA_WORD_ZH=true
             shift
             ;;
+        --dry-run)
+            DRY_RUN=true
+            shift
+            ;;
+        --validate)
+            VALIDATE=true
+            shift
+            ;;
+        --bulk)
+            BULK=true
+            shift
+            ;;
+        --force)
+            FORCE=true
+            shift
+            ;;
+        --prune)
+            PRUNE=true
+            shift
+            ;;
         --help|-h)
             HELP=true
             shift
@@ -229,6 +258,22 @@ else
     fi
 fi
 
+if [ "$DRY_RUN" = true ]; then
+    DATA_FLAGS="$DATA_FLAGS --dry-run"
+fi
+
+if [ "$BULK" = true ]; then
+    DATA_FLAGS="$DATA_FLAGS --bulk"
+fi
+
+if [ "$FORCE" = true ]; then
+    DATA_FLAGS="$DATA_FLAGS --force"
+fi
+
+if [ "$PRUNE" = true ]; then
+    DATA_FLAGS="$DATA_FLAGS --prune"
+fi
+
 # Run Schema Migration
 if [ "$RUN_SCHEMA" = true ]; then
     echo -e "${BLUE}━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━${NC}"
@@ -257,8 +302,18 @@ if [ "$RUN_DATA" = true ]; then
     
     cd "$BACKEND_DIR"
     
+    if [ "$VALIDATE" = true ]; then
+        echo -e "${YELLOW}Running: go run ./cmd/migration/data validate${NC}"
+        echo ""
+        if ! go run ./cmd/migration/data validate; then
+            echo -e "${RED}Seed file validation failed!${NC}"
+            exit 1
+        fi
+        echo ""
+    fi
+    
     # Build the command
-    DATA_CMD="go run cmd/migration/data/main.go"
+    DATA_CMD="go run ./cmd/migration/data"
     if [ -n "$DATA_FLAGS" ]; then
         DATA_CMD="$DATA_CMD $DATA_FLAGS"
     fi